@@ -2,13 +2,26 @@ package main
 
 import (
 	"context"
+	"errors"
 	"os"
+	"sync"
+	"time"
 	_ "time/tzdata"
 
 	"tempo/config"
 	"tempo/container"
+	"tempo/denylist"
+	"tempo/event"
+	"tempo/jwtkey"
+	"tempo/mailer"
+	"tempo/model"
+	"tempo/moderation"
+	"tempo/password"
+	"tempo/ratelimit"
 	"tempo/repository/mysqlrepo"
+	"tempo/sitemap"
 	"tempo/storage"
+	"tempo/usecase"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -46,6 +59,9 @@ func loadConfig() {
 func registerCommands(appProvider AppProvider) *cobra.Command {
 	rootCmd.AddCommand(Server(appProvider))
 	rootCmd.AddCommand(Migrate(appProvider))
+	rootCmd.AddCommand(UserRole(appProvider))
+	rootCmd.AddCommand(OutboxRelay(appProvider))
+	rootCmd.AddCommand(NewsReadingBackfill(appProvider))
 
 	return rootCmd
 }
@@ -55,7 +71,8 @@ type AppProvider interface {
 }
 
 type buildOptions struct {
-	MySql bool
+	MySql    bool
+	EventBus bool
 }
 
 type defaultAppProvider struct {
@@ -68,15 +85,131 @@ func (defaultAppProvider) BuildContainer(ctx context.Context, options buildOptio
 	appContainer := container.NewContainer()
 	appContainer.SetConfig(cfg)
 
+	if cfg.EmailVerification.Secret == cfg.JwtSecret {
+		return nil, nil, errors.New("EMAIL_VERIFICATION_SECRET must differ from JWT_SECRET")
+	}
+
+	hasher, err := password.NewFromConfig(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	appContainer.SetPasswordHasher(hasher)
+
+	jwtKeys, err := jwtkey.NewFromConfig(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	appContainer.SetJwtKeys(jwtKeys)
+
+	mail, err := mailer.NewFromConfig(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	appContainer.SetMailer(mail)
+
 	if options.MySql {
 		db = storage.GetMySqlDb()
 		appContainer.SetDb(db)
 
 		userRepo := mysqlrepo.NewUserRepository(db)
 		appContainer.SetUserRepo(userRepo)
+
+		newsRepo := mysqlrepo.NewNewsRepository(db)
+		appContainer.SetNewsRepo(newsRepo)
+
+		bookmarkRepo := mysqlrepo.NewBookmarkRepository(db)
+		appContainer.SetBookmarkRepo(bookmarkRepo)
+
+		followRepo := mysqlrepo.NewFollowRepository(db)
+		appContainer.SetFollowRepo(followRepo)
+
+		notificationRepo := mysqlrepo.NewNotificationRepository(db)
+		appContainer.SetNotificationRepo(notificationRepo)
+
+		webhookRepo := mysqlrepo.NewWebhookRepository(db)
+		appContainer.SetWebhookRepo(webhookRepo)
+
+		outboxRepo := mysqlrepo.NewOutboxRepository(db)
+		appContainer.SetOutboxRepo(outboxRepo)
+
+		moderationRepo := mysqlrepo.NewModerationRepository(db)
+		appContainer.SetModerationRepo(moderationRepo)
+
+		featuredRepo := mysqlrepo.NewFeaturedRepository(db)
+		appContainer.SetFeaturedRepo(featuredRepo)
+
+		collectionRepo := mysqlrepo.NewCollectionRepository(db)
+		appContainer.SetCollectionRepo(collectionRepo)
+
+		newsAuthorRepo := mysqlrepo.NewNewsAuthorRepository(db)
+		appContainer.SetNewsAuthorRepo(newsAuthorRepo)
+
+		newsReportRepo := mysqlrepo.NewNewsReportRepository(db)
+		appContainer.SetNewsReportRepo(newsReportRepo)
+
+		refreshTokenRepo := mysqlrepo.NewRefreshTokenRepository(db)
+		appContainer.SetRefreshTokenRepo(refreshTokenRepo)
+
+		rateLimitRepo := mysqlrepo.NewRateLimitRepository(db)
+		appContainer.SetRateLimitRepo(rateLimitRepo)
+
+		passwordResetRepo := mysqlrepo.NewPasswordResetRepository(db)
+		appContainer.SetPasswordResetRepo(passwordResetRepo)
+
+		revokedTokenRepo := mysqlrepo.NewRevokedTokenRepository(db)
+		appContainer.SetDenylist(denylist.NewFromConfig(cfg, revokedTokenRepo, userRepo))
+
+		sitemapRepo := mysqlrepo.NewSitemapRepository(db)
+		appContainer.SetSitemap(sitemap.NewFromConfig(cfg, sitemapRepo))
+
+		pipeline, err := moderation.NewFromConfig(cfg, userRepo)
+		if err != nil {
+			storage.CloseDB(db)
+			return nil, nil, err
+		}
+		appContainer.SetModeration(pipeline)
+	}
+
+	var bus *event.AsyncBus
+	var stopWorkers context.CancelFunc
+	var workers sync.WaitGroup
+	if options.EventBus {
+		bus = event.NewAsyncBus(cfg.EventBus.Workers, cfg.EventBus.QueueSize)
+		appContainer.SetEventBus(bus)
+
+		usecase.NewNotification(appContainer).Subscribe(bus)
+		webhookUseCase := usecase.NewWebhook(appContainer)
+		webhookUseCase.Subscribe(bus)
+		usecase.NewPasswordReset(appContainer).Subscribe(bus)
+		usecase.NewEmailVerification(appContainer).Subscribe(bus)
+
+		newsStream := event.NewStream(cfg.NewsStream.BufferSize, cfg.NewsStream.ClientBufferSize)
+		newsStream.Listen(bus, model.EventNewsCreated, model.EventNewsUpdated)
+		appContainer.SetNewsStream(newsStream)
+		bus.Start()
+
+		var workerCtx context.Context
+		workerCtx, stopWorkers = context.WithCancel(ctx)
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			webhookUseCase.Run(workerCtx)
+		}()
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			ratelimit.Purge(workerCtx, appContainer.RateLimitRepo(), time.Duration(cfg.RateLimit.PurgeIntervalSeconds)*time.Second)
+		}()
 	}
 
 	deferFn := func() {
+		if stopWorkers != nil {
+			stopWorkers()
+			workers.Wait()
+		}
+		if bus != nil {
+			bus.Close()
+		}
 		if db != nil {
 			storage.CloseDB(db)
 		}
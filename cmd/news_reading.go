@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"tempo/helper"
+	"tempo/usecase"
+
+	"github.com/segmentio/ksuid"
+	"github.com/spf13/cobra"
+)
+
+var (
+	newsReadingAll       bool
+	newsReadingBatchSize int
+)
+
+func NewsReadingBackfill(appProvider AppProvider) *cobra.Command {
+	cliCommand := &cobra.Command{
+		Use:   "news-reading-backfill",
+		Short: "Compute the excerpt, word count and reading time of the news written before they were computed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := helper.ContextWithRequestId(context.Background(), ksuid.New().String())
+
+			if newsReadingBatchSize <= 0 {
+				return fmt.Errorf("the batch size must be positive")
+			}
+
+			app, closeResourcesFn, err := appProvider.BuildContainer(ctx, buildOptions{
+				MySql: true,
+			})
+			if err != nil {
+				return err
+			}
+			if closeResourcesFn != nil {
+				defer closeResourcesFn()
+			}
+
+			count, err := usecase.NewNews(app).BackfillReading(ctx, newsReadingAll, newsReadingBatchSize)
+			fmt.Printf("Updated the reading metadata of %d news\n", count)
+			return err
+		},
+	}
+
+	cliCommand.Flags().BoolVarP(&newsReadingAll, "all", "a", false, "Compute them again for every news, after changing the reading settings")
+	cliCommand.Flags().IntVarP(&newsReadingBatchSize, "batch-size", "b", 500, "How many news are updated at once")
+	return cliCommand
+}
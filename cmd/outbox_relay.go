@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"tempo/config"
+	"tempo/helper"
+	"tempo/outbox"
+	"tempo/usecase"
+
+	"github.com/segmentio/ksuid"
+	"github.com/spf13/cobra"
+)
+
+var (
+	outboxSink       string
+	outboxFilePath   string
+	outboxHttpUrl    string
+	outboxBatchSize  int
+	outboxReplayFrom int64
+)
+
+func OutboxRelay(appProvider AppProvider) *cobra.Command {
+	cliCommand := &cobra.Command{
+		Use:   "outbox-relay",
+		Short: "Publish the outbox events to a sink, or replay them from an offset",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			ctx = helper.ContextWithRequestId(ctx, ksuid.New().String())
+			logger := helper.GetLogger(ctx).WithField("method", "outbox-relay")
+
+			cfg := config.Instance()
+			sink, err := newOutboxSink(cfg)
+			if err != nil {
+				return err
+			}
+			defer sink.Close()
+
+			app, closeResourcesFn, err := appProvider.BuildContainer(ctx, buildOptions{
+				MySql: true,
+			})
+			if err != nil {
+				return err
+			}
+			if closeResourcesFn != nil {
+				defer closeResourcesFn()
+			}
+
+			outboxUseCase := usecase.NewOutbox(app)
+			if outboxReplayFrom >= 0 {
+				count, err := outboxUseCase.Replay(ctx, sink, outboxReplayFrom, outboxBatchSize)
+				if err != nil {
+					return err
+				}
+
+				logger.Infof("Replayed %d outbox events from offset %d", count, outboxReplayFrom)
+				return nil
+			}
+
+			interval := time.Duration(cfg.Outbox.PollIntervalMs) * time.Millisecond
+			commitLag := time.Duration(cfg.Outbox.CommitLagMs) * time.Millisecond
+			return outboxUseCase.Relay(ctx, sink, outboxBatchSize, interval, commitLag)
+		},
+	}
+
+	cfg := config.Instance()
+	cliCommand.Flags().StringVarP(&outboxSink, "sink", "s", cfg.Outbox.Sink, "Where to publish the events: stdout, file or http")
+	cliCommand.Flags().StringVar(&outboxFilePath, "file", cfg.Outbox.FilePath, "The NDJSON file of the file sink")
+	cliCommand.Flags().StringVar(&outboxHttpUrl, "url", cfg.Outbox.HttpUrl, "The endpoint of the http sink")
+	cliCommand.Flags().IntVarP(&outboxBatchSize, "batch-size", "b", cfg.Outbox.BatchSize, "How many events are published at once")
+	cliCommand.Flags().Int64VarP(&outboxReplayFrom, "replay-from", "r", -1, "Publish again every event from this offset then exit")
+	return cliCommand
+}
+
+func newOutboxSink(cfg config.Config) (outbox.Sink, error) {
+	switch outboxSink {
+	case "stdout":
+		return outbox.NewWriterSink(os.Stdout), nil
+	case "file":
+		return outbox.NewFileSink(outboxFilePath)
+	case "http":
+		if outboxHttpUrl == "" {
+			return nil, fmt.Errorf("the http sink need an url")
+		}
+		return outbox.NewHttpSink(outboxHttpUrl, time.Duration(cfg.Outbox.HttpTimeoutSeconds)*time.Second), nil
+	default:
+		return nil, fmt.Errorf("unknown outbox sink %q", outboxSink)
+	}
+}
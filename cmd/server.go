@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"tempo/controller"
+	"tempo/controller/rpc"
 	"tempo/helper"
 
 	"github.com/segmentio/ksuid"
@@ -13,13 +14,14 @@ import (
 func Server(appProvider AppProvider) *cobra.Command {
 	cliCommand := &cobra.Command{
 		Use:   "server",
-		Short: "Run the REST API server",
+		Short: "Run the REST API server, and the gRPC one when enabled",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := helper.ContextWithRequestId(context.Background(), ksuid.New().String())
 			logger := helper.GetLogger(ctx).WithField("method", "server")
 
 			app, closeResourcesFn, err := appProvider.BuildContainer(ctx, buildOptions{
-				MySql: true,
+				MySql:    true,
+				EventBus: true,
 			})
 			if err != nil {
 				panic(err)
@@ -28,6 +30,17 @@ func Server(appProvider AppProvider) *cobra.Command {
 				defer closeResourcesFn()
 			}
 
+			// Start gRPC Server
+			if app.Config().Service.GrpcEnabled {
+				grpcServer := rpc.NewServer(app)
+				defer grpcServer.Stop()
+				go func() {
+					if err := grpcServer.Start(); err != nil {
+						logger.WithError(err).Error("Error starting grpc server")
+					}
+				}()
+			}
+
 			// Start Http Server
 			err = controller.NewHttpServer(app).Start()
 			if err != nil {
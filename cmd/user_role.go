@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+
+	"github.com/segmentio/ksuid"
+	"github.com/spf13/cobra"
+)
+
+var (
+	userRoleEmail string
+	userRoleName  string
+)
+
+func UserRole(appProvider AppProvider) *cobra.Command {
+	cliCommand := &cobra.Command{
+		Use:   "user-role",
+		Short: "Set the role of a user, the user must login again for it to apply",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := helper.ContextWithRequestId(context.Background(), ksuid.New().String())
+
+			role := model.UserRole(userRoleName)
+			if !role.IsValid() {
+				return fmt.Errorf("unknown role %q", userRoleName)
+			}
+
+			app, closeResourcesFn, err := appProvider.BuildContainer(ctx, buildOptions{
+				MySql: true,
+			})
+			if err != nil {
+				return err
+			}
+			if closeResourcesFn != nil {
+				defer closeResourcesFn()
+			}
+
+			user, err := app.UserRepo().Get(ctx, repository.UserGetFilter{
+				Email: &userRoleEmail,
+			})
+			if err != nil {
+				return err
+			}
+
+			_, err = app.UserRepo().Update(ctx, *user.Id, &model.User{
+				Role: &role,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("User %s is now %s\n", userRoleEmail, role)
+			return nil
+		},
+	}
+
+	cliCommand.Flags().StringVarP(&userRoleEmail, "email", "e", "", "The email of the user")
+	cliCommand.Flags().StringVarP(&userRoleName, "role", "r", string(model.UserRoleAdmin), "The role to set, user or admin")
+	_ = cliCommand.MarkFlagRequired("email")
+	return cliCommand
+}
@@ -1,6 +1,9 @@
 package config
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
 	"sync"
 
 	"github.com/jinzhu/configor"
@@ -22,15 +25,197 @@ type DBConfig struct {
 	Debug              bool `default:"false" env:"DB_DEBUG"`
 }
 
+// SiteConfig is the public site, serving a news at NewsPath formatted with its id and proxying the sitemaps and the
+// share pages to the service
+type SiteConfig struct {
+	BaseUrl  string `default:"http://localhost:8080" env:"SITE_BASE_URL"`
+	NewsPath string `default:"/news/%s" env:"SITE_NEWS_PATH"`
+	Name     string `default:"Tempo" env:"SITE_NAME"`
+	Language string `default:"en" env:"SITE_LANGUAGE"`
+	// TimeZone is the IANA time zone the archive months start at midnight in
+	TimeZone string `default:"UTC" env:"SITE_TIME_ZONE"`
+}
+
+// Url return the absolute url of the path on the site
+func (s SiteConfig) Url(path string) string {
+	return strings.TrimSuffix(s.BaseUrl, "/") + path
+}
+
+// NewsUrl return the absolute url of the news on the site
+func (s SiteConfig) NewsUrl(id string) string {
+	return s.Url(fmt.Sprintf(s.NewsPath, url.PathEscape(id)))
+}
+
+// NewsId return the id of the news from its url on the site, or from the path of its share page
+func (s SiteConfig) NewsId(rawUrl string) (string, bool) {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return "", false
+	}
+	base, err := url.Parse(s.BaseUrl)
+	if err != nil || !strings.EqualFold(u.Host, base.Host) {
+		return "", false
+	}
+
+	path := strings.TrimPrefix(u.Path, strings.TrimSuffix(base.Path, "/"))
+	for _, pattern := range []string{s.NewsPath, "/share/news/%s"} {
+		prefix, suffix, found := strings.Cut(pattern, "%s")
+		if !found || !strings.HasPrefix(path, prefix) || !strings.HasSuffix(path, suffix) {
+			continue
+		}
+		id := strings.TrimSuffix(strings.TrimPrefix(path, prefix), suffix)
+		if id != "" && !strings.Contains(id, "/") {
+			return id, true
+		}
+	}
+
+	return "", false
+}
+
 type Config struct {
 	Service struct {
-		Host string `default:"0.0.0.0" env:"SERVICE_HOST"`
-		Port string `default:"8080" env:"SERVICE_PORT"`
-		Path struct {
+		Host        string `default:"0.0.0.0" env:"SERVICE_HOST"`
+		Port        string `default:"8080" env:"SERVICE_PORT"`
+		GrpcEnabled bool   `default:"true" env:"SERVICE_GRPC_ENABLED"`
+		GrpcPort    string `default:"9090" env:"SERVICE_GRPC_PORT"`
+		Path        struct {
 			V1 string `default:"/v1" env:"SERVICE_PATH_API"`
 		}
 	}
-	DB        DBConfig
+	DB       DBConfig
+	EventBus struct {
+		Workers   int `default:"4" env:"EVENT_BUS_WORKERS"`
+		QueueSize int `default:"1000" env:"EVENT_BUS_QUEUE_SIZE"`
+	}
+	Webhook struct {
+		TimeoutSeconds       int `default:"10" env:"WEBHOOK_TIMEOUT_SECONDS"`
+		MaxAttempts          int `default:"8" env:"WEBHOOK_MAX_ATTEMPTS"`
+		BackoffSeconds       int `default:"30" env:"WEBHOOK_BACKOFF_SECONDS"`
+		MaxBackoffSeconds    int `default:"21600" env:"WEBHOOK_MAX_BACKOFF_SECONDS"`
+		RetryIntervalSeconds int `default:"15" env:"WEBHOOK_RETRY_INTERVAL_SECONDS"`
+		DisableAfterFailures int `default:"20" env:"WEBHOOK_DISABLE_AFTER_FAILURES"`
+		Workers              int `default:"4" env:"WEBHOOK_WORKERS"`
+	}
+	NewsStream struct {
+		BufferSize       int `default:"1000" env:"NEWS_STREAM_BUFFER_SIZE"`
+		ClientBufferSize int `default:"64" env:"NEWS_STREAM_CLIENT_BUFFER_SIZE"`
+		HeartbeatSeconds int `default:"15" env:"NEWS_STREAM_HEARTBEAT_SECONDS"`
+	}
+	Moderation struct {
+		// Checks is the comma separated list of the checks run in order on the submitted news
+		Checks          string  `default:"banned_words,link_limit,spam_score,new_account" env:"MODERATION_CHECKS"`
+		BannedWordsFile string  `env:"MODERATION_BANNED_WORDS_FILE"`
+		LinkFlagAbove   int     `default:"3" env:"MODERATION_LINK_FLAG_ABOVE"`
+		LinkRejectAbove int     `default:"10" env:"MODERATION_LINK_REJECT_ABOVE"`
+		SpamFlagScore   float64 `default:"0.5" env:"MODERATION_SPAM_FLAG_SCORE"`
+		SpamRejectScore float64 `default:"0.8" env:"MODERATION_SPAM_REJECT_SCORE"`
+		NewAccountHours int     `default:"24" env:"MODERATION_NEW_ACCOUNT_HOURS"`
+	}
+	NewsDuplicate struct {
+		Enabled bool `default:"true" env:"NEWS_DUPLICATE_ENABLED"`
+		// MaxDistance is the number of fingerprint bits two news can differ by and still be near duplicates
+		MaxDistance int `default:"8" env:"NEWS_DUPLICATE_MAX_DISTANCE"`
+		// Reject refuse a near duplicate news instead of only returning the ids it duplicates
+		Reject bool `default:"false" env:"NEWS_DUPLICATE_REJECT"`
+	}
+	NewsReport struct {
+		// HideThreshold is the number of open reports that hide a news until a moderator resolve them, 0 never hiding it
+		HideThreshold int `default:"5" env:"NEWS_REPORT_HIDE_THRESHOLD"`
+	}
+	Site    SiteConfig
+	Sitemap struct {
+		ChunkSize      int `default:"50000" env:"SITEMAP_CHUNK_SIZE"`
+		RefreshSeconds int `default:"60" env:"SITEMAP_REFRESH_SECONDS"`
+		// NewsHours is how far back the Google News sitemap go, Google only taking the news of the last 48 hours
+		NewsHours int `default:"48" env:"SITEMAP_NEWS_HOURS"`
+	}
+	NewsReading struct {
+		ExcerptLength  int `default:"280" env:"NEWS_EXCERPT_LENGTH"`
+		WordsPerMinute int `default:"230" env:"NEWS_WORDS_PER_MINUTE"`
+		// CharactersPerMinute is the reading speed of the scripts read by character, like Chinese or Japanese
+		CharactersPerMinute int `default:"500" env:"NEWS_CHARACTERS_PER_MINUTE"`
+	}
+	Share struct {
+		// DefaultImageUrl is the preview image of the news without image in their description
+		DefaultImageUrl string `env:"SHARE_DEFAULT_IMAGE_URL"`
+		CacheSeconds    int    `default:"3600" env:"SHARE_CACHE_SECONDS"`
+	}
+	Graphql struct {
+		MaxDepth      int `default:"6" env:"GRAPHQL_MAX_DEPTH"`
+		MaxComplexity int `default:"300" env:"GRAPHQL_MAX_COMPLEXITY"`
+	}
+	Outbox struct {
+		Sink               string `default:"stdout" env:"OUTBOX_SINK"`
+		FilePath           string `default:"./outbox.ndjson" env:"OUTBOX_FILE_PATH"`
+		HttpUrl            string `env:"OUTBOX_HTTP_URL"`
+		HttpTimeoutSeconds int    `default:"10" env:"OUTBOX_HTTP_TIMEOUT_SECONDS"`
+		BatchSize          int    `default:"100" env:"OUTBOX_BATCH_SIZE"`
+		PollIntervalMs     int    `default:"1000" env:"OUTBOX_POLL_INTERVAL_MS"`
+		// CommitLagMs delay the relay of an event so the transactions that took a lower offset are committed first
+		CommitLagMs int `default:"5000" env:"OUTBOX_COMMIT_LAG_MS"`
+	}
+	Password struct {
+		// Algorithm hash the new passwords, argon2id or bcrypt. The hashes of the other algorithms are still verified
+		// and replaced at the next login
+		Algorithm     string `default:"argon2id" env:"PASSWORD_ALGORITHM"`
+		Argon2Memory  uint32 `default:"65536" env:"PASSWORD_ARGON2_MEMORY_KIB"`
+		Argon2Time    uint32 `default:"3" env:"PASSWORD_ARGON2_TIME"`
+		Argon2Threads uint32 `default:"4" env:"PASSWORD_ARGON2_THREADS"`
+		BcryptCost    int    `default:"12" env:"PASSWORD_BCRYPT_COST"`
+	}
+	Token struct {
+		AccessSeconds int `default:"300" env:"TOKEN_ACCESS_SECONDS"`
+		// RefreshSeconds is how long a refresh token can be used, each refresh issuing a new one for as long
+		RefreshSeconds int `default:"2592000" env:"TOKEN_REFRESH_SECONDS"`
+		// DenylistRefreshSeconds is how long a logout on another instance can take to reject the access token here
+		DenylistRefreshSeconds int `default:"5" env:"TOKEN_DENYLIST_REFRESH_SECONDS"`
+	}
+	Jwt struct {
+		// Algorithm sign the new tokens, HS256 with the JwtSecret, or RS256, ES256 or EdDSA with the PrivateKeyFile
+		Algorithm      string `default:"HS256" env:"JWT_ALGORITHM"`
+		PrivateKeyFile string `env:"JWT_PRIVATE_KEY_FILE"`
+		// KeyId is the kid of the private key, put in the header of the tokens it signs
+		KeyId string `env:"JWT_KEY_ID"`
+		// PublicKeyFiles is the comma separated list of kid=path of the PEM public keys verifying the tokens besides the
+		// private key. A new key is listed before it signs, the previous one stay listed until its tokens expired
+		PublicKeyFiles string `env:"JWT_PUBLIC_KEY_FILES"`
+		// VerifyHmac accept the tokens without kid signed with the JwtSecret while an asymmetric key sign the new ones
+		VerifyHmac bool `default:"false" env:"JWT_VERIFY_HMAC"`
+	}
+	Mail struct {
+		// Driver send the emails, log writing them to stdout or smtp
+		Driver       string `default:"log" env:"MAIL_DRIVER"`
+		From         string `default:"Tempo <no-reply@localhost>" env:"MAIL_FROM"`
+		SmtpHost     string `env:"MAIL_SMTP_HOST"`
+		SmtpPort     int    `default:"587" env:"MAIL_SMTP_PORT"`
+		SmtpUsername string `env:"MAIL_SMTP_USERNAME"`
+		SmtpPassword string `env:"MAIL_SMTP_PASSWORD"`
+	}
+	EmailVerification struct {
+		// Secret sign the verification tokens, it must differ from the JwtSecret so one never sign the other
+		Secret     string `required:"true" env:"EMAIL_VERIFICATION_SECRET"`
+		TokenHours int    `default:"48" env:"EMAIL_VERIFICATION_TOKEN_HOURS"`
+		// Path is the page of the site verifying the token it is formatted with
+		Path string `default:"/verify-email?token=%s" env:"EMAIL_VERIFICATION_PATH"`
+		// ResendLimit is the number of verification emails resent to an address per ResendWindowSeconds
+		ResendLimit         int  `default:"3" env:"EMAIL_VERIFICATION_RESEND_LIMIT"`
+		ResendWindowSeconds int  `default:"3600" env:"EMAIL_VERIFICATION_RESEND_WINDOW_SECONDS"`
+		RequiredForLogin    bool `default:"false" env:"EMAIL_VERIFICATION_REQUIRED_FOR_LOGIN"`
+		RequiredForNews     bool `default:"false" env:"EMAIL_VERIFICATION_REQUIRED_FOR_NEWS"`
+	}
+	PasswordReset struct {
+		TokenMinutes int `default:"30" env:"PASSWORD_RESET_TOKEN_MINUTES"`
+		// Path is the page of the site resetting the password with the token it is formatted with
+		Path string `default:"/reset-password?token=%s" env:"PASSWORD_RESET_PATH"`
+		// EmailLimit and IpLimit are the number of reset requests per WindowSeconds for an email, and from an IP
+		EmailLimit    int `default:"3" env:"PASSWORD_RESET_EMAIL_LIMIT"`
+		IpLimit       int `default:"20" env:"PASSWORD_RESET_IP_LIMIT"`
+		WindowSeconds int `default:"3600" env:"PASSWORD_RESET_WINDOW_SECONDS"`
+	}
+	RateLimit struct {
+		// PurgeIntervalSeconds is how often the ended windows are deleted
+		PurgeIntervalSeconds int `default:"3600" env:"RATE_LIMIT_PURGE_INTERVAL_SECONDS"`
+	}
 	LogLevel  string `default:"INFO" env:"LOG_LEVEL"`
 	JwtSecret string `required:"true" env:"JWT_SECRET"`
 }
@@ -2,18 +2,47 @@ package container
 
 import (
 	"tempo/config"
+	"tempo/denylist"
+	"tempo/event"
+	"tempo/jwtkey"
+	"tempo/mailer"
+	"tempo/moderation"
+	"tempo/password"
 	"tempo/repository"
+	"tempo/sitemap"
 
 	"gorm.io/gorm"
 )
 
 type Container struct {
-	db     *gorm.DB
-	config config.Config
+	db       *gorm.DB
+	config   config.Config
+	eventBus event.Bus
+
+	newsStream *event.Stream
+	moderation *moderation.Pipeline
+	sitemap    *sitemap.Cache
+	hasher     password.Hasher
+	denylist   *denylist.Denylist
+	jwtKeys    *jwtkey.Keyring
+	mailer     mailer.Mailer
 
 	// repo
-	userRepo repository.User
-	newsRepo repository.News
+	userRepo          repository.User
+	newsRepo          repository.News
+	bookmarkRepo      repository.Bookmark
+	followRepo        repository.Follow
+	notificationRepo  repository.Notification
+	webhookRepo       repository.Webhook
+	outboxRepo        repository.Outbox
+	moderationRepo    repository.Moderation
+	featuredRepo      repository.Featured
+	collectionRepo    repository.Collection
+	newsAuthorRepo    repository.NewsAuthor
+	newsReportRepo    repository.NewsReport
+	refreshTokenRepo  repository.RefreshToken
+	rateLimitRepo     repository.RateLimit
+	passwordResetRepo repository.PasswordReset
 }
 
 func NewContainer() *Container {
@@ -36,6 +65,70 @@ func (c *Container) SetConfig(config config.Config) {
 	c.config = config
 }
 
+func (c *Container) EventBus() event.Bus {
+	return c.eventBus
+}
+
+func (c *Container) SetEventBus(eventBus event.Bus) {
+	c.eventBus = eventBus
+}
+
+func (c *Container) NewsStream() *event.Stream {
+	return c.newsStream
+}
+
+func (c *Container) SetNewsStream(newsStream *event.Stream) {
+	c.newsStream = newsStream
+}
+
+func (c *Container) Moderation() *moderation.Pipeline {
+	return c.moderation
+}
+
+func (c *Container) SetModeration(moderation *moderation.Pipeline) {
+	c.moderation = moderation
+}
+
+func (c *Container) Sitemap() *sitemap.Cache {
+	return c.sitemap
+}
+
+func (c *Container) SetSitemap(sitemap *sitemap.Cache) {
+	c.sitemap = sitemap
+}
+
+func (c *Container) Denylist() *denylist.Denylist {
+	return c.denylist
+}
+
+func (c *Container) SetDenylist(denylist *denylist.Denylist) {
+	c.denylist = denylist
+}
+
+func (c *Container) JwtKeys() *jwtkey.Keyring {
+	return c.jwtKeys
+}
+
+func (c *Container) SetJwtKeys(jwtKeys *jwtkey.Keyring) {
+	c.jwtKeys = jwtKeys
+}
+
+func (c *Container) Mailer() mailer.Mailer {
+	return c.mailer
+}
+
+func (c *Container) SetMailer(mailer mailer.Mailer) {
+	c.mailer = mailer
+}
+
+func (c *Container) PasswordHasher() password.Hasher {
+	return c.hasher
+}
+
+func (c *Container) SetPasswordHasher(hasher password.Hasher) {
+	c.hasher = hasher
+}
+
 func (c *Container) UserRepo() repository.User {
 	return c.userRepo
 }
@@ -51,3 +144,107 @@ func (c *Container) NewsRepo() repository.News {
 func (c *Container) SetNewsRepo(newsRepo repository.News) {
 	c.newsRepo = newsRepo
 }
+
+func (c *Container) BookmarkRepo() repository.Bookmark {
+	return c.bookmarkRepo
+}
+
+func (c *Container) SetBookmarkRepo(bookmarkRepo repository.Bookmark) {
+	c.bookmarkRepo = bookmarkRepo
+}
+
+func (c *Container) FollowRepo() repository.Follow {
+	return c.followRepo
+}
+
+func (c *Container) SetFollowRepo(followRepo repository.Follow) {
+	c.followRepo = followRepo
+}
+
+func (c *Container) NotificationRepo() repository.Notification {
+	return c.notificationRepo
+}
+
+func (c *Container) SetNotificationRepo(notificationRepo repository.Notification) {
+	c.notificationRepo = notificationRepo
+}
+
+func (c *Container) WebhookRepo() repository.Webhook {
+	return c.webhookRepo
+}
+
+func (c *Container) SetWebhookRepo(webhookRepo repository.Webhook) {
+	c.webhookRepo = webhookRepo
+}
+
+func (c *Container) OutboxRepo() repository.Outbox {
+	return c.outboxRepo
+}
+
+func (c *Container) SetOutboxRepo(outboxRepo repository.Outbox) {
+	c.outboxRepo = outboxRepo
+}
+
+func (c *Container) ModerationRepo() repository.Moderation {
+	return c.moderationRepo
+}
+
+func (c *Container) SetModerationRepo(moderationRepo repository.Moderation) {
+	c.moderationRepo = moderationRepo
+}
+
+func (c *Container) FeaturedRepo() repository.Featured {
+	return c.featuredRepo
+}
+
+func (c *Container) SetFeaturedRepo(featuredRepo repository.Featured) {
+	c.featuredRepo = featuredRepo
+}
+
+func (c *Container) CollectionRepo() repository.Collection {
+	return c.collectionRepo
+}
+
+func (c *Container) SetCollectionRepo(collectionRepo repository.Collection) {
+	c.collectionRepo = collectionRepo
+}
+
+func (c *Container) NewsAuthorRepo() repository.NewsAuthor {
+	return c.newsAuthorRepo
+}
+
+func (c *Container) SetNewsAuthorRepo(newsAuthorRepo repository.NewsAuthor) {
+	c.newsAuthorRepo = newsAuthorRepo
+}
+
+func (c *Container) NewsReportRepo() repository.NewsReport {
+	return c.newsReportRepo
+}
+
+func (c *Container) SetNewsReportRepo(newsReportRepo repository.NewsReport) {
+	c.newsReportRepo = newsReportRepo
+}
+
+func (c *Container) RefreshTokenRepo() repository.RefreshToken {
+	return c.refreshTokenRepo
+}
+
+func (c *Container) SetRefreshTokenRepo(refreshTokenRepo repository.RefreshToken) {
+	c.refreshTokenRepo = refreshTokenRepo
+}
+
+func (c *Container) RateLimitRepo() repository.RateLimit {
+	return c.rateLimitRepo
+}
+
+func (c *Container) SetRateLimitRepo(rateLimitRepo repository.RateLimit) {
+	c.rateLimitRepo = rateLimitRepo
+}
+
+func (c *Container) PasswordResetRepo() repository.PasswordReset {
+	return c.passwordResetRepo
+}
+
+func (c *Container) SetPasswordResetRepo(passwordResetRepo repository.PasswordReset) {
+	c.passwordResetRepo = passwordResetRepo
+}
@@ -0,0 +1,175 @@
+package graph
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// defaultListSize is the size assumed for a list field without first argument nor default value
+const defaultListSize = 10
+
+// queryCost compute the depth and complexity of the operation. A field cost 1 plus the cost of its selection, which is
+// multiplied by the requested size for list fields. The introspection fields are not counted, their size is bounded by
+// the schema.
+type queryCost struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+	// err is the first requested list size out of range, which would make the cost meaningless
+	err error
+}
+
+func checkLimits(schema graphql.Schema, doc *ast.Document, operationName string, variables map[string]interface{}, maxDepth int, maxComplexity int) error {
+	cost := &queryCost{
+		fragments: map[string]*ast.FragmentDefinition{},
+		variables: variables,
+	}
+
+	var operations []*ast.OperationDefinition
+	for _, v := range doc.Definitions {
+		switch def := v.(type) {
+		case *ast.FragmentDefinition:
+			cost.fragments[def.Name.Value] = def
+		case *ast.OperationDefinition:
+			if operationName == "" || (def.Name != nil && def.Name.Value == operationName) {
+				operations = append(operations, def)
+			}
+		}
+	}
+
+	for _, v := range operations {
+		root := schema.QueryType()
+		if v.Operation == ast.OperationTypeMutation {
+			root = schema.MutationType()
+		}
+
+		depth, complexity := cost.selectionSet(root, v.SelectionSet, 1)
+		if cost.err != nil {
+			return cost.err
+		}
+		if depth > maxDepth {
+			return fmt.Errorf("query depth %d exceeds the maximum of %d", depth, maxDepth)
+		}
+		if complexity > maxComplexity {
+			return fmt.Errorf("query complexity %d exceeds the maximum of %d", complexity, maxComplexity)
+		}
+	}
+
+	return nil
+}
+
+func (q *queryCost) selectionSet(parent *graphql.Object, set *ast.SelectionSet, depth int) (int, int) {
+	if parent == nil || set == nil {
+		return 0, 0
+	}
+
+	maxDepth, complexity := 0, 0
+	for _, v := range set.Selections {
+		d, c := 0, 0
+		switch selection := v.(type) {
+		case *ast.Field:
+			d, c = q.field(parent, selection, depth)
+		case *ast.InlineFragment:
+			d, c = q.selectionSet(parent, selection.SelectionSet, depth)
+		case *ast.FragmentSpread:
+			if fragment, ok := q.fragments[selection.Name.Value]; ok {
+				d, c = q.selectionSet(parent, fragment.SelectionSet, depth)
+			}
+		}
+
+		if d > maxDepth {
+			maxDepth = d
+		}
+		complexity += c
+	}
+
+	return maxDepth, complexity
+}
+
+func (q *queryCost) field(parent *graphql.Object, field *ast.Field, depth int) (int, int) {
+	if strings.HasPrefix(field.Name.Value, "__") {
+		return 0, 0
+	}
+
+	def, ok := parent.Fields()[field.Name.Value]
+	if !ok {
+		return depth, 1
+	}
+	if field.SelectionSet == nil {
+		return depth, 1
+	}
+
+	childType, isList := unwrapType(def.Type)
+	child, _ := childType.(*graphql.Object)
+	childDepth, childComplexity := q.selectionSet(child, field.SelectionSet, depth+1)
+	if isList {
+		childComplexity *= q.listSize(def, field)
+	}
+	if childDepth < depth {
+		childDepth = depth
+	}
+
+	return childDepth, 1 + childComplexity
+}
+
+func (q *queryCost) listSize(def *graphql.FieldDefinition, field *ast.Field) int {
+	for _, v := range field.Arguments {
+		if v.Name.Value != "first" {
+			continue
+		}
+
+		switch value := v.Value.(type) {
+		case *ast.IntValue:
+			if n, err := strconv.ParseFloat(value.Value, 64); err == nil {
+				return q.boundListSize(n)
+			}
+		case *ast.Variable:
+			switch n := q.variables[value.Name.Value].(type) {
+			case int:
+				return q.boundListSize(float64(n))
+			case float64:
+				return q.boundListSize(n)
+			}
+		}
+	}
+
+	for _, v := range def.Args {
+		if v.Name() == "first" {
+			if n, ok := v.DefaultValue.(int); ok {
+				return q.boundListSize(float64(n))
+			}
+		}
+	}
+
+	return defaultListSize
+}
+
+// boundListSize return the requested list size, recording an error for a size the resolvers would reject
+func (q *queryCost) boundListSize(n float64) int {
+	if n < 1 || n > maxListSize {
+		if q.err == nil {
+			q.err = fmt.Errorf("first must be between 1 and %d", maxListSize)
+		}
+		return maxListSize
+	}
+
+	return int(n)
+}
+
+func unwrapType(t graphql.Type) (graphql.Type, bool) {
+	isList := false
+	for {
+		switch v := t.(type) {
+		case *graphql.NonNull:
+			t = v.OfType
+		case *graphql.List:
+			isList = true
+			t = v.OfType
+		default:
+			return t, isList
+		}
+	}
+}
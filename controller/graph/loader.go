@@ -0,0 +1,90 @@
+package graph
+
+import (
+	"context"
+	"sync"
+
+	"tempo/model"
+	"tempo/repository"
+	"tempo/usecase"
+)
+
+type loaderKey struct{}
+
+// userLoader batch the user lookups of a request, the ids asked while a level of the query is resolved are fetched
+// with a single query when the first of their thunks is called
+type userLoader struct {
+	userUseCase *usecase.User
+
+	mu      sync.Mutex
+	pending []string
+	users   map[string]*model.User
+	errs    map[string]error
+}
+
+func newUserLoader(userUseCase *usecase.User) *userLoader {
+	return &userLoader{
+		userUseCase: userUseCase,
+		users:       map[string]*model.User{},
+		errs:        map[string]error{},
+	}
+}
+
+func withUserLoader(ctx context.Context, loader *userLoader) context.Context {
+	return context.WithValue(ctx, loaderKey{}, loader)
+}
+
+func getUserLoader(ctx context.Context) *userLoader {
+	loader, _ := ctx.Value(loaderKey{}).(*userLoader)
+	return loader
+}
+
+// Load queue the id and return the thunk resolving to the user, nil when it does not exist
+func (l *userLoader) Load(ctx context.Context, id string) func() (interface{}, error) {
+	l.mu.Lock()
+	if _, ok := l.users[id]; !ok && !l.isPending(id) {
+		l.pending = append(l.pending, id)
+	}
+	l.mu.Unlock()
+
+	return func() (interface{}, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		if l.isPending(id) {
+			l.flush(ctx)
+		}
+		if err := l.errs[id]; err != nil {
+			return nil, err
+		}
+		if user := l.users[id]; user != nil {
+			return user, nil
+		}
+		return nil, nil
+	}
+}
+
+func (l *userLoader) isPending(id string) bool {
+	for _, v := range l.pending {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *userLoader) flush(ctx context.Context) {
+	ids := l.pending
+	l.pending = nil
+
+	users, err := l.userUseCase.List(ctx, repository.UserListFilter{Ids: ids})
+	for _, id := range ids {
+		l.users[id] = nil
+		if err != nil {
+			l.errs[id] = err
+		}
+	}
+	for i := range users {
+		l.users[*users[i].Id] = &users[i]
+	}
+}
@@ -0,0 +1,323 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"tempo/container"
+	"tempo/controller/middleware"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/usecase"
+
+	"github.com/graphql-go/graphql"
+)
+
+// maxListSize bound the first argument of the list fields
+const maxListSize = 50
+
+// resolverError expose the code of the usecase errors in the extensions of the graphql error
+type resolverError struct {
+	err model.Error
+}
+
+func (e resolverError) Error() string {
+	return e.err.Message
+}
+
+func (e resolverError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code": e.err.Code,
+	}
+}
+
+func newSchema(appContainer *container.Container) (graphql.Schema, error) {
+	var userType, newsType *graphql.Object
+
+	userType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":       &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+				"fullName": &graphql.Field{Type: graphql.String},
+				"email": &graphql.Field{
+					Type:        graphql.String,
+					Description: "Only visible to the user itself",
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						user := p.Source.(*model.User)
+						viewer, ok := getViewer(p.Context)
+						if !ok || viewer.Id == nil || *viewer.Id != *user.Id {
+							return nil, nil
+						}
+						return user.Email, nil
+					},
+				},
+				"createdAt": &graphql.Field{Type: graphql.DateTime},
+				"news": &graphql.Field{
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(newsType))),
+					Description: "Published news of the user, newest first",
+					Args: graphql.FieldConfigArgument{
+						"first": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 10},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						user := p.Source.(*model.User)
+						first, _ := p.Args["first"].(int)
+						if first < 1 || first > maxListSize {
+							return nil, toGraphError(p.Context, model.NewParameterError(helper.Pointer("first must be between 1 and 50")))
+						}
+
+						newsUseCase := usecase.NewNews(appContainer)
+						res, err := newsUseCase.List(p.Context, repository.NewsListFilter{
+							UserIds: []string{*user.Id},
+							Limit:   first,
+						})
+						if err != nil {
+							return nil, toGraphError(p.Context, err)
+						}
+
+						news := make([]*model.News, 0, len(res))
+						for i := range res {
+							news = append(news, &res[i])
+						}
+						return news, nil
+					},
+				},
+			}
+		}),
+	})
+
+	newsType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "News",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":             &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+				"title":          &graphql.Field{Type: graphql.String},
+				"description":    &graphql.Field{Type: graphql.String},
+				"excerpt":        &graphql.Field{Type: graphql.String},
+				"wordCount":      &graphql.Field{Type: graphql.Int},
+				"readingMinutes": &graphql.Field{Type: graphql.Int},
+				"publishedAt":    &graphql.Field{Type: graphql.DateTime},
+				"createdAt":      &graphql.Field{Type: graphql.DateTime},
+				"updatedAt":      &graphql.Field{Type: graphql.DateTime},
+				"author": &graphql.Field{
+					Type: userType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						news := p.Source.(*model.News)
+						if news.UserId == nil {
+							return nil, nil
+						}
+
+						thunk := getUserLoader(p.Context).Load(p.Context, *news.UserId)
+						return func() (interface{}, error) {
+							res, err := thunk()
+							if err != nil {
+								return nil, toGraphError(p.Context, err)
+							}
+							return res, nil
+						}, nil
+					},
+				},
+			}
+		}),
+	})
+
+	authPayloadType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "AuthPayload",
+		Fields: graphql.Fields{
+			"token":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"refreshToken": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"user":         &graphql.Field{Type: graphql.NewNonNull(userType)},
+		},
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"viewer": &graphql.Field{
+				Type:        userType,
+				Description: "The authenticated user",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					viewer, ok := getViewer(p.Context)
+					if !ok {
+						return nil, toGraphError(p.Context, model.NewUnauthorizedError())
+					}
+
+					return getUserLoader(p.Context).Load(p.Context, *viewer.Id), nil
+				},
+			},
+			"news": &graphql.Field{
+				Type: newsType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					id, _ := p.Args["id"].(string)
+					var viewer *model.User
+					if v, ok := getViewer(p.Context); ok {
+						viewer = &v
+					}
+
+					newsUseCase := usecase.NewNews(appContainer)
+					res, err := newsUseCase.Get(p.Context, &id, viewer)
+					if err != nil {
+						return nil, toGraphError(p.Context, err)
+					}
+					return res, nil
+				},
+			},
+			"author": &graphql.Field{
+				Type: userType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					id, _ := p.Args["id"].(string)
+					return getUserLoader(p.Context).Load(p.Context, id), nil
+				},
+			},
+		},
+	})
+
+	mutationType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"register": &graphql.Field{
+				Type: graphql.NewNonNull(userType),
+				Args: graphql.FieldConfigArgument{
+					"email":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"fullName": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"password": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					userUseCase := usecase.NewUser(appContainer)
+					res, err := userUseCase.Register(p.Context, model.User{
+						Email:    stringArg(p, "email"),
+						FullName: stringArg(p, "fullName"),
+						Password: stringArg(p, "password"),
+					})
+					if err != nil {
+						return nil, toGraphError(p.Context, err)
+					}
+					return res, nil
+				},
+			},
+			"login": &graphql.Field{
+				Type: graphql.NewNonNull(authPayloadType),
+				Args: graphql.FieldConfigArgument{
+					"email":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"password": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					userUseCase := usecase.NewUser(appContainer)
+					res, err := userUseCase.Login(p.Context, &model.User{
+						Email:    stringArg(p, "email"),
+						Password: stringArg(p, "password"),
+					})
+					if err != nil {
+						return nil, toGraphError(p.Context, err)
+					}
+
+					config := appContainer.Config()
+					token, err := middleware.GenerateJwt(*res, appContainer.JwtKeys(), time.Duration(config.Token.AccessSeconds)*time.Second)
+					if err != nil {
+						return nil, toGraphError(p.Context, err)
+					}
+
+					refreshTokenUseCase := usecase.NewRefreshToken(appContainer)
+					refreshToken, err := refreshTokenUseCase.Issue(p.Context, res.Id)
+					if err != nil {
+						return nil, toGraphError(p.Context, err)
+					}
+
+					return map[string]interface{}{
+						"token":        *token,
+						"refreshToken": *refreshToken,
+						"user":         res,
+					}, nil
+				},
+			},
+			"addNews": &graphql.Field{
+				Type: graphql.NewNonNull(newsType),
+				Args: graphql.FieldConfigArgument{
+					"title":       &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"description": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					viewer, ok := getViewer(p.Context)
+					if !ok {
+						return nil, toGraphError(p.Context, model.NewUnauthorizedError())
+					}
+
+					newsUseCase := usecase.NewNews(appContainer)
+					res, err := newsUseCase.Add(p.Context, &model.News{
+						UserId:      viewer.Id,
+						Title:       stringArg(p, "title"),
+						Description: stringArg(p, "description"),
+					})
+					if err != nil {
+						return nil, toGraphError(p.Context, err)
+					}
+					return res, nil
+				},
+			},
+			"updateNews": &graphql.Field{
+				Type: graphql.NewNonNull(newsType),
+				Args: graphql.FieldConfigArgument{
+					"id":          &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"title":       &graphql.ArgumentConfig{Type: graphql.String},
+					"description": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					viewer, ok := getViewer(p.Context)
+					if !ok {
+						return nil, toGraphError(p.Context, model.NewUnauthorizedError())
+					}
+
+					newsUseCase := usecase.NewNews(appContainer)
+					res, err := newsUseCase.Update(p.Context, stringArg(p, "id"), viewer.Id, &model.News{
+						Title:       stringArg(p, "title"),
+						Description: stringArg(p, "description"),
+					})
+					if err != nil {
+						return nil, toGraphError(p.Context, err)
+					}
+					return res, nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query:    queryType,
+		Mutation: mutationType,
+	})
+}
+
+func getViewer(ctx context.Context) (model.User, bool) {
+	viewer, ok := ctx.Value(helper.ContextKeyJwtData).(model.User)
+	if !ok || viewer.Id == nil {
+		return model.User{}, false
+	}
+	return viewer, true
+}
+
+func stringArg(p graphql.ResolveParams, name string) *string {
+	v, ok := p.Args[name].(string)
+	if !ok {
+		return nil
+	}
+	return &v
+}
+
+// toGraphError keep the message and code of the usecase errors, the others are logged and hidden from the client
+func toGraphError(ctx context.Context, err error) error {
+	var e model.Error
+	if errors.As(err, &e) {
+		return resolverError{e}
+	}
+
+	helper.GetLogger(ctx).WithField("method", "Controller.Graph.Resolve").WithError(err).Warning("error resolve field")
+	return resolverError{model.NewError("internal server error", model.ErrorInternalServer)}
+}
@@ -0,0 +1,72 @@
+package graph
+
+import (
+	"context"
+
+	"tempo/container"
+	"tempo/usecase"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+type Request struct {
+	Query         string
+	OperationName string
+	Variables     map[string]interface{}
+}
+
+type Server struct {
+	appContainer  *container.Container
+	schema        graphql.Schema
+	maxDepth      int
+	maxComplexity int
+}
+
+func NewServer(appContainer *container.Container) (*Server, error) {
+	schema, err := newSchema(appContainer)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := appContainer.Config().Graphql
+	return &Server{
+		appContainer:  appContainer,
+		schema:        schema,
+		maxDepth:      cfg.MaxDepth,
+		maxComplexity: cfg.MaxComplexity,
+	}, nil
+}
+
+// Execute run the request after checking its depth and complexity, the errors are reported in the result
+func (s *Server) Execute(ctx context.Context, req Request) *graphql.Result {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{
+			Body: []byte(req.Query),
+			Name: "GraphQL request",
+		}),
+	})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	validation := graphql.ValidateDocument(&s.schema, doc, nil)
+	if !validation.IsValid {
+		return &graphql.Result{Errors: validation.Errors}
+	}
+
+	if err := checkLimits(s.schema, doc, req.OperationName, req.Variables, s.maxDepth, s.maxComplexity); err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	loader := newUserLoader(usecase.NewUser(s.appContainer))
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        s.schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       withUserLoader(ctx, loader),
+	})
+}
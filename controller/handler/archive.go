@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"tempo/container"
+	"tempo/controller/request"
+	"tempo/controller/response"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/usecase"
+
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Archive struct {
+	appContainer *container.Container
+}
+
+func NewArchive(appContainer *container.Container) *Archive {
+	return &Archive{appContainer: appContainer}
+}
+
+// Months Archive
+// @Summary 	Archive Months
+// @Description Number of news published in each month of the site time zone, the newest month first, without the months nothing was published in
+// @Produce 		json
+// @Success 		200		{object}	[]model.NewsArchiveMonth	"Return the months"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /news/archive [get]
+func (a *Archive) Months(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.ArchiveMonths")
+
+	// Action
+	archiveUseCase := usecase.NewArchive(a.appContainer)
+	res, err := archiveUseCase.Months(c)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error get archive months")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// List Archive
+// @Summary 	Archive List
+// @Description News published in a month of the site time zone, newest first
+// @Produce 		json
+// @Param year path int true "year"
+// @Param month path int true "month, from 1 to 12"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "page size, default 20, max 100"
+// @Success 		200		{object}	response.Page{data=[]model.News}	"Return the news"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the year or the month is not valid"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /news/archive/:year/:month [get]
+func (a *Archive) List(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.ArchiveList")
+
+	// Validation
+	year, err := strconv.Atoi(c.Param("year"))
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusNotFound, model.NewNotFoundError())
+		return
+	}
+	month, err := strconv.Atoi(c.Param("month"))
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusNotFound, model.NewNotFoundError())
+		return
+	}
+
+	var req request.Pagination
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	archiveUseCase := usecase.NewArchive(a.appContainer)
+	res, next, err := archiveUseCase.List(c, year, month, req.Cursor, req.Limit)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error list archive")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, response.Page{
+		Data:       res,
+		NextCursor: next,
+	})
+}
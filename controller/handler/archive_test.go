@@ -0,0 +1,82 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchive_Months(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnTheMonthsWithNews", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Email = helper.Pointer("email@gmail.com")
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+		first := time.Date(2023, time.March, 15, 12, 0, 0, 0, time.UTC)
+
+		newsMock := &mocks.News{}
+		newsMock.On("PublishedRange", mock.Anything).Return(&first, &first, nil).Once()
+		newsMock.On("CountPublished", mock.Anything, mock.Anything).Return([]int64{4}, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsRepo(newsMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/news/archive", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var resBody []model.NewsArchiveMonth
+		err = json.NewDecoder(w.Body).Decode(&resBody)
+		require.NoError(t, err)
+		require.Equal(t, []model.NewsArchiveMonth{{Year: 2023, Month: 3, Count: 4}}, resBody)
+
+		newsMock.AssertExpectations(t)
+	})
+}
+
+func TestArchive_List(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnNotFound_WhenMonthIsNotValid", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Email = helper.Pointer("email@gmail.com")
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/news/archive/2023/13", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"tempo/container"
+	"tempo/controller/middleware"
+	"tempo/controller/request"
+	"tempo/controller/response"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/usecase"
+
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Bookmark struct {
+	appContainer *container.Container
+}
+
+func NewBookmark(appContainer *container.Container) *Bookmark {
+	return &Bookmark{appContainer: appContainer}
+}
+
+// Put Bookmark
+// @Summary 	Bookmark News
+// @Description Bookmark a news for the current user, optionally inside a folder. Bookmarking the same news again moves it to the given folder
+// @Accept 			json
+// @Produce 		json
+// @Param newsId path string true "news id"
+// @Param 			body 	body 		request.Bookmark 		false 	" "
+// @Success 		200		{object}	model.Bookmark			"Return the bookmark model"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the news is not found"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /me/bookmarks/:newsId [put]
+func (w *Bookmark) Put(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.PutBookmark")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation
+	newsId := c.Param("newsId")
+
+	// the body is optional, an empty one bookmark the news outside of any folder
+	var req request.Bookmark
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+			logger.WithError(err).Warning("bad request error")
+			response.WriteFailResponse(c, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	bookmarkUseCase := usecase.NewBookmark(w.appContainer)
+	res, err := bookmarkUseCase.Put(c, &model.Bookmark{
+		UserId: user.Id,
+		NewsId: &newsId,
+		Folder: req.Folder,
+	})
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error put bookmark")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// Delete Bookmark
+// @Summary 	Delete Bookmark
+// @Description Remove a news from the current user bookmarks
+// @Produce 		json
+// @Param newsId path string true "news id"
+// @Success 		200		{object}	response.SuccessResponse
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the bookmark is not found"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /me/bookmarks/:newsId [delete]
+func (w *Bookmark) Delete(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.DeleteBookmark")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Action
+	newsId := c.Param("newsId")
+	bookmarkUseCase := usecase.NewBookmark(w.appContainer)
+	err = bookmarkUseCase.Delete(c, user.Id, &newsId)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error delete bookmark")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, nil)
+}
+
+// List Bookmark
+// @Summary 	List Bookmark
+// @Description List the current user bookmarks, newest first
+// @Produce 		json
+// @Param folder query string false "only return bookmarks in this folder"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "page size, default 20, max 100"
+// @Success 		200		{object}	response.Page{data=[]model.Bookmark}	"Return the bookmarks"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /me/bookmarks [get]
+func (w *Bookmark) List(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.ListBookmark")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation
+	var req request.Pagination
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	var folder *string
+	if f, ok := c.GetQuery("folder"); ok {
+		folder = &f
+	}
+
+	// Action
+	bookmarkUseCase := usecase.NewBookmark(w.appContainer)
+	res, next, err := bookmarkUseCase.List(c, repository.BookmarkListFilter{
+		UserId: user.Id,
+		Folder: folder,
+		Limit:  req.Limit,
+	}, req.Cursor)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error list bookmark")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, response.Page{
+		Data:       res,
+		NextCursor: next,
+	})
+}
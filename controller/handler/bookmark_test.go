@@ -0,0 +1,247 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"tempo/container"
+	"tempo/controller/request"
+	"tempo/controller/response"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookmark_Put(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnErrorUnAuthorized_WhenRequestTokenIsInvalid", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "PUT", "/me/bookmarks/news-id", nil, map[string]string{
+			"Authorization": "Bearer token",
+			"Content-Type":  "application/json",
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("ShouldReturnErrorNotFound_WhenNewsIsNotExist", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Email = helper.Pointer("email@gmail.com")
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+		newsId := fake.CharactersN(7)
+
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, &newsId).Return(nil, model.NewNotFoundError()).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsRepo(newsMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "PUT", "/me/bookmarks/"+newsId, nil, map[string]string{
+			"Authorization": "Bearer " + token,
+			"Content-Type":  "application/json",
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusNotFound, w.Code)
+		newsMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnBookmark_WhenSuccessPut", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Email = helper.Pointer("email@gmail.com")
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.PublishedAt = helper.Pointer(time.Now())
+			return news
+		})
+		reqBody := request.Bookmark{
+			Folder: helper.Pointer("read later"),
+		}
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(reqBody)
+		require.NoError(t, err)
+
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeNews.Id).Return(&fakeNews, nil).Once()
+		bookmarkMock := &mocks.Bookmark{}
+		bookmarkMock.On("Upsert", mock.Anything, &model.Bookmark{
+			UserId: fakeUser.Id,
+			NewsId: fakeNews.Id,
+			Folder: reqBody.Folder,
+		}).Return(&model.Bookmark{
+			Id:        helper.Pointer(fake.CharactersN(7)),
+			UserId:    fakeUser.Id,
+			NewsId:    fakeNews.Id,
+			Folder:    reqBody.Folder,
+			CreatedAt: helper.Pointer(time.Now()),
+		}, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsRepo(newsMock)
+			appContainer.SetBookmarkRepo(bookmarkMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "PUT", "/me/bookmarks/"+*fakeNews.Id, &buf, map[string]string{
+			"Authorization": "Bearer " + token,
+			"Content-Type":  "application/json",
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+
+		resBody := model.Bookmark{}
+		err = json.NewDecoder(w.Body).Decode(&resBody)
+		require.NoError(t, err)
+		require.Equal(t, *reqBody.Folder, *resBody.Folder)
+		require.Equal(t, *fakeNews.Id, *resBody.News.Id)
+
+		newsMock.AssertExpectations(t)
+		bookmarkMock.AssertExpectations(t)
+	})
+}
+
+func TestBookmark_List(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnErrorUnprocessableEntity_WhenLimitIsTooBig", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, _ := test.FakeJwtToken(t, nil)
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/me/bookmarks", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, map[string]string{
+			"limit": "1000",
+		})
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("ShouldReturnBookmarksOfTheFolder", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Email = helper.Pointer("email@gmail.com")
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+		fakeBookmark := test.FakeBookmark(t, func(bookmark model.Bookmark) model.Bookmark {
+			bookmark.Id = helper.Pointer(fake.CharactersN(7))
+			bookmark.UserId = fakeUser.Id
+			bookmark.CreatedAt = helper.Pointer(time.Now())
+			return bookmark
+		})
+
+		bookmarkMock := &mocks.Bookmark{}
+		bookmarkMock.On("List", mock.Anything, repository.BookmarkListFilter{
+			UserId: fakeUser.Id,
+			Folder: fakeBookmark.Folder,
+			Limit:  21,
+		}).Return([]model.Bookmark{fakeBookmark}, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetBookmarkRepo(bookmarkMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/me/bookmarks", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, map[string]string{
+			"folder": *fakeBookmark.Folder,
+		})
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+
+		resBody := struct {
+			Data       []model.Bookmark `json:"data"`
+			NextCursor *string          `json:"next_cursor"`
+		}{}
+		err = json.NewDecoder(w.Body).Decode(&resBody)
+		require.NoError(t, err)
+		require.Len(t, resBody.Data, 1)
+		require.Equal(t, *fakeBookmark.Id, *resBody.Data[0].Id)
+		require.Nil(t, resBody.NextCursor)
+
+		bookmarkMock.AssertExpectations(t)
+	})
+}
+
+func TestBookmark_Delete(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnErrorNotFound_WhenBookmarkIsNotExist", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Email = helper.Pointer("email@gmail.com")
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+		newsId := fake.CharactersN(7)
+
+		bookmarkMock := &mocks.Bookmark{}
+		bookmarkMock.On("Delete", mock.Anything, *fakeUser.Id, newsId).Return(model.NewNotFoundError()).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetBookmarkRepo(bookmarkMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "DELETE", "/me/bookmarks/"+newsId, nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusNotFound, w.Code)
+
+		resBody := response.ErrorResponse{}
+		err = json.NewDecoder(w.Body).Decode(&resBody)
+		require.NoError(t, err)
+		require.Equal(t, model.NewNotFoundError().Error(), resBody.Message)
+
+		bookmarkMock.AssertExpectations(t)
+	})
+}
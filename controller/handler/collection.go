@@ -0,0 +1,281 @@
+package handler
+
+import (
+	"tempo/container"
+	"tempo/controller/middleware"
+	"tempo/controller/request"
+	"tempo/controller/response"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/usecase"
+
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Collection struct {
+	appContainer *container.Container
+}
+
+func NewCollection(appContainer *container.Container) *Collection {
+	return &Collection{appContainer: appContainer}
+}
+
+// Add Collection
+// @Summary 	Add Collection
+// @Description Add a collection, an ordered series of news of the author
+// @Accept 		json
+// @Produce 		json
+// @Param request body request.Collection true "Request Body"
+// @Success 		200		{object}	model.Collection		"Return the collection"
+// @Failure 		400 	{object}	response.ErrorResponse 	"When request is not valid"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /collections [post]
+func (w *Collection) Add(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.AddCollection")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation
+	var req request.Collection
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	collectionUseCase := usecase.NewCollection(w.appContainer)
+	res, err := collectionUseCase.Add(c, &model.Collection{
+		UserId:      user.Id,
+		Title:       req.Title,
+		Description: req.Description,
+	})
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error add collection")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// Get Collection
+// @Summary 	Get Collection
+// @Description Get a collection with its news in their order, the unpublished news only for their authors and the admins
+// @Produce 		json
+// @Param id path string true "collection id"
+// @Success 		200		{object}	model.Collection		"Return the collection"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the collection is not found"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /collections/:id [get]
+func (w *Collection) Get(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.GetCollection")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Action
+	id := c.Param("id")
+	collectionUseCase := usecase.NewCollection(w.appContainer)
+	res, err := collectionUseCase.Get(c, &id, &user)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error get collection")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// Add Collection Item
+// @Summary 	Add Collection Item
+// @Description Add a news of the author to the collection, at the position or at the end. Author only
+// @Accept 		json
+// @Produce 		json
+// @Param id path string true "collection id"
+// @Param request body request.CollectionItem true "Request Body"
+// @Success 		200		{object}	model.Collection		"Return the collection"
+// @Failure 		400 	{object}	response.ErrorResponse 	"When request is not valid"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the collection or the news is not the user's one"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the collection is not found"
+// @Failure 		409 	{object}	response.ErrorResponse 	"When the news is already in the collection"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /collections/:id/items [post]
+func (w *Collection) AddItem(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.AddCollectionItem")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation
+	var req request.CollectionItem
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	id := c.Param("id")
+	collectionUseCase := usecase.NewCollection(w.appContainer)
+	res, err := collectionUseCase.AddItem(c, &id, user.Id, req.NewsId, req.Position)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error add collection item")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// Remove Collection Item
+// @Summary 	Remove Collection Item
+// @Description Remove a news from the collection. Author only
+// @Produce 		json
+// @Param id path string true "collection id"
+// @Param newsId path string true "news id"
+// @Success 		200		{object}	model.Collection		"Return the collection"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the collection is not the user's one"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the collection or the item is not found"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /collections/:id/items/:newsId [delete]
+func (w *Collection) RemoveItem(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.RemoveCollectionItem")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Action
+	id := c.Param("id")
+	newsId := c.Param("newsId")
+	collectionUseCase := usecase.NewCollection(w.appContainer)
+	res, err := collectionUseCase.RemoveItem(c, &id, user.Id, &newsId)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error remove collection item")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// Reorder Collection Items
+// @Summary 	Reorder Collection Items
+// @Description Set the order of the news of the collection. The version must be the current one of the collection, so a concurrent change is not overwritten. Author only
+// @Accept 		json
+// @Produce 		json
+// @Param id path string true "collection id"
+// @Param request body request.CollectionReorder true "Request Body"
+// @Success 		200		{object}	model.Collection		"Return the collection"
+// @Failure 		400 	{object}	response.ErrorResponse 	"When request is not valid"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the collection is not the user's one"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the collection is not found"
+// @Failure 		409 	{object}	response.ErrorResponse 	"When the collection was changed since the version"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /collections/:id/items [put]
+func (w *Collection) Reorder(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.ReorderCollection")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation
+	var req request.CollectionReorder
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	id := c.Param("id")
+	collectionUseCase := usecase.NewCollection(w.appContainer)
+	res, err := collectionUseCase.Reorder(c, &id, user.Id, *req.Version, req.NewsIds)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error reorder collection")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
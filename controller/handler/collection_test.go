@@ -0,0 +1,126 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"tempo/container"
+	"tempo/controller/request"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollection_Add(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldAddTheCollectionOfTheUser", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, fakeUser := test.FakeJwtToken(t, nil)
+		reqBody := request.Collection{
+			Title: helper.Pointer("The investigation"),
+		}
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(reqBody)
+		require.NoError(t, err)
+
+		collectionMock := &mocks.Collection{}
+		collectionMock.On("Add", mock.Anything, &model.Collection{
+			UserId: fakeUser.Id,
+			Title:  reqBody.Title,
+		}).Return(&model.Collection{
+			Id:      helper.Pointer("collection"),
+			UserId:  fakeUser.Id,
+			Title:   reqBody.Title,
+			Version: helper.Pointer(0),
+		}, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetCollectionRepo(collectionMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/collections", &buf, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var resBody model.Collection
+		err = json.NewDecoder(w.Body).Decode(&resBody)
+		require.NoError(t, err)
+		require.Equal(t, "collection", *resBody.Id)
+
+		collectionMock.AssertExpectations(t)
+	})
+}
+
+func TestCollection_Reorder(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnUnprocessableEntity_WhenVersionIsMissing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, _ := test.FakeJwtToken(t, nil)
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(request.CollectionReorder{NewsIds: []string{"a"}})
+		require.NoError(t, err)
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "PUT", "/collections/collection/items", &buf, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("ShouldReturnConflict_WhenCollectionWasChanged", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, fakeUser := test.FakeJwtToken(t, nil)
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(request.CollectionReorder{
+			Version: helper.Pointer(3),
+			NewsIds: []string{"b", "a"},
+		})
+		require.NoError(t, err)
+
+		collectionMock := &mocks.Collection{}
+		collectionMock.On("Get", mock.Anything, "collection").Return(&model.Collection{
+			Id:     helper.Pointer("collection"),
+			UserId: fakeUser.Id,
+		}, nil).Once()
+		collectionMock.On("Reorder", mock.Anything, "collection", 3, []string{"b", "a"}).
+			Return(nil, model.NewError("the collection was changed, reload it and retry", model.ErrorDuplicate)).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetCollectionRepo(collectionMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "PUT", "/collections/collection/items", &buf, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusConflict, w.Code)
+
+		collectionMock.AssertExpectations(t)
+	})
+}
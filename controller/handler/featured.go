@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"tempo/container"
+	"tempo/controller/middleware"
+	"tempo/controller/request"
+	"tempo/controller/response"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/usecase"
+
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Featured struct {
+	appContainer *container.Container
+}
+
+func NewFeatured(appContainer *container.Container) *Featured {
+	return &Featured{appContainer: appContainer}
+}
+
+// Get Featured News
+// @Summary 	Get Featured News
+// @Description Get the news of a featured slot in their order, without the expired items and the unpublished or deleted news
+// @Produce 		json
+// @Param slot path string true "slot name, like homepage_hero or sidebar"
+// @Success 		200		{array}		model.FeaturedNews		"Return the featured news"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /featured/:slot [get]
+func (f *Featured) Get(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.GetFeatured")
+
+	// Action
+	featuredUseCase := usecase.NewFeatured(f.appContainer)
+	res, err := featuredUseCase.Get(c, c.Param("slot"))
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error get featured news")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// Set Featured News
+// @Summary 	Set Featured News
+// @Description Pin news to a featured slot, replacing its items. The order of the items is their position. Admin only
+// @Accept 		json
+// @Produce 		json
+// @Param slot path string true "slot name, like homepage_hero or sidebar"
+// @Param request body request.FeaturedSet true "Request Body"
+// @Success 		200		{array}		model.FeaturedItem		"Return the items of the slot"
+// @Failure 		400 	{object}	response.ErrorResponse 	"When request is not valid"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not an admin"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /featured/:slot [put]
+func (f *Featured) Set(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.SetFeatured")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation
+	var req request.FeaturedSet
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	items := make([]model.FeaturedItem, 0, len(req.Items))
+	for _, v := range req.Items {
+		items = append(items, model.FeaturedItem{
+			NewsId:    v.NewsId,
+			ExpiresAt: v.ExpiresAt,
+		})
+	}
+
+	featuredUseCase := usecase.NewFeatured(f.appContainer)
+	res, err := featuredUseCase.Set(c, c.Param("slot"), items, user.Id)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error set featured news")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// List Featured Audits
+// @Summary 	List Featured Audits
+// @Description List the changes made to a featured slot, with who made them and the items set, latest first. Admin only
+// @Produce 		json
+// @Param slot path string true "slot name, like homepage_hero or sidebar"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "page size, default 20, max 100"
+// @Success 		200		{object}	response.Page{data=[]model.FeaturedAudit}	"Return the audits"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not an admin"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /featured/:slot/audits [get]
+func (f *Featured) ListAudits(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.ListFeaturedAudits")
+
+	// Validation
+	var req request.Pagination
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	featuredUseCase := usecase.NewFeatured(f.appContainer)
+	res, next, err := featuredUseCase.ListAudits(c, c.Param("slot"), req.Cursor, req.Limit)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error list featured audits")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, response.Page{
+		Data:       res,
+		NextCursor: next,
+	})
+}
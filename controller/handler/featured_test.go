@@ -0,0 +1,151 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"tempo/container"
+	"tempo/controller/request"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeatured_Get(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnTheFeaturedNews", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, _ := test.FakeJwtToken(t, nil)
+		fakeNews := test.FakeNews(t, nil)
+
+		featuredMock := &mocks.Featured{}
+		featuredMock.On("ListNews", mock.Anything, mock.MatchedBy(func(filter repository.FeaturedNewsFilter) bool {
+			return filter.Slot == "sidebar" && !filter.At.IsZero()
+		})).Return([]model.FeaturedNews{{News: fakeNews, Position: 0}}, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetFeaturedRepo(featuredMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/featured/sidebar", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var resBody []model.FeaturedNews
+		err = json.NewDecoder(w.Body).Decode(&resBody)
+		require.NoError(t, err)
+		require.Len(t, resBody, 1)
+		require.Equal(t, *fakeNews.Id, *resBody[0].Id)
+
+		featuredMock.AssertExpectations(t)
+	})
+}
+
+func TestFeatured_Set(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnErrorForbidden_WhenUserIsNotAdmin", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, _ := test.FakeJwtToken(t, nil)
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(request.FeaturedSet{Items: []request.FeaturedSetItem{}})
+		require.NoError(t, err)
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "PUT", "/featured/sidebar", &buf, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("ShouldReturnUnprocessableEntity_WhenItemsAreMissing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Role = helper.Pointer(model.UserRoleAdmin)
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "PUT", "/featured/sidebar", bytes.NewBufferString("{}"), map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("ShouldPinTheNews_WhenUserIsAdmin", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Role = helper.Pointer(model.UserRoleAdmin)
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+		fakeNews := test.FakeNews(t, nil)
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(request.FeaturedSet{Items: []request.FeaturedSetItem{{NewsId: fakeNews.Id}}})
+		require.NoError(t, err)
+
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeNews.Id).Return(&fakeNews, nil).Once()
+		featuredMock := &mocks.Featured{}
+		featuredMock.On("Replace", mock.Anything, "homepage_hero", []model.FeaturedItem{{NewsId: fakeNews.Id}}, *fakeUser.Id).
+			Return([]model.FeaturedItem{{
+				Slot:     helper.Pointer("homepage_hero"),
+				NewsId:   fakeNews.Id,
+				Position: helper.Pointer(0),
+				PinnedBy: fakeUser.Id,
+			}}, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsRepo(newsMock)
+			appContainer.SetFeaturedRepo(featuredMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "PUT", "/featured/homepage_hero", &buf, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var resBody []model.FeaturedItem
+		err = json.NewDecoder(w.Body).Decode(&resBody)
+		require.NoError(t, err)
+		require.Len(t, resBody, 1)
+		require.Equal(t, *fakeUser.Id, *resBody[0].PinnedBy)
+
+		newsMock.AssertExpectations(t)
+		featuredMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"tempo/container"
+	"tempo/controller/middleware"
+	"tempo/controller/request"
+	"tempo/controller/response"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/usecase"
+
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Follow struct {
+	appContainer *container.Container
+}
+
+func NewFollow(appContainer *container.Container) *Follow {
+	return &Follow{appContainer: appContainer}
+}
+
+// Follow User
+// @Summary 	Follow User
+// @Description Follow an author, their news will show up in the feed of the current user
+// @Produce 		json
+// @Param id path string true "user id"
+// @Success 		200		{object}	model.Follow			"Return the follow model"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the user is not found"
+// @Failure 		409 	{object}	response.ErrorResponse 	"When the user is already followed"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /users/:id/follow [post]
+func (w *Follow) Follow(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.Follow")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Action
+	followeeId := c.Param("id")
+	followUseCase := usecase.NewFollow(w.appContainer)
+	res, err := followUseCase.Add(c, &model.Follow{
+		FollowerId: user.Id,
+		FolloweeId: &followeeId,
+	})
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error follow")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// Unfollow User
+// @Summary 	Unfollow User
+// @Description Stop following an author
+// @Produce 		json
+// @Param id path string true "user id"
+// @Success 		200		{object}	response.SuccessResponse
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the user is not followed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /users/:id/follow [delete]
+func (w *Follow) Unfollow(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.Unfollow")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Action
+	followeeId := c.Param("id")
+	followUseCase := usecase.NewFollow(w.appContainer)
+	err = followUseCase.Delete(c, user.Id, &followeeId)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error unfollow")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, nil)
+}
+
+// List Following
+// @Summary 	List Following
+// @Description List the users followed by the current user, most recently followed first
+// @Produce 		json
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "page size, default 20, max 100"
+// @Success 		200		{object}	response.Page{data=[]model.Follow}	"Return the follows, user is the followed user"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /me/following [get]
+func (w *Follow) ListFollowing(c *gin.Context) {
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	w.list(c, "Controller.Handler.ListFollowing", repository.FollowListFilter{FollowerId: user.Id})
+}
+
+// List Followers
+// @Summary 	List Followers
+// @Description List the users following the current user, most recent follower first
+// @Produce 		json
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "page size, default 20, max 100"
+// @Success 		200		{object}	response.Page{data=[]model.Follow}	"Return the follows, user is the follower"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /me/followers [get]
+func (w *Follow) ListFollowers(c *gin.Context) {
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	w.list(c, "Controller.Handler.ListFollowers", repository.FollowListFilter{FolloweeId: user.Id})
+}
+
+func (w *Follow) list(c *gin.Context, method string, filter repository.FollowListFilter) {
+	logger := helper.GetLogger(c).WithField("method", method)
+
+	// Validation
+	var req request.Pagination
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	filter.Limit = req.Limit
+	followUseCase := usecase.NewFollow(w.appContainer)
+	res, next, err := followUseCase.List(c, filter, req.Cursor)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error list follow")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, response.Page{
+		Data:       res,
+		NextCursor: next,
+	})
+}
+
+// Feed
+// @Summary 	Feed
+// @Description Recent news of the authors followed by the current user, newest first
+// @Produce 		json
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "page size, default 20, max 100"
+// @Success 		200		{object}	response.Page{data=[]model.News}	"Return the news"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /me/feed [get]
+func (w *Follow) Feed(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.Feed")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation
+	var req request.Pagination
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	followUseCase := usecase.NewFollow(w.appContainer)
+	res, next, err := followUseCase.Feed(c, user.Id, req.Cursor, req.Limit)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error get feed")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, response.Page{
+		Data:       res,
+		NextCursor: next,
+	})
+}
@@ -0,0 +1,136 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFollow_Follow(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnErrorUnprocessableEntity_WhenFollowingYourself", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Email = helper.Pointer("email@gmail.com")
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/users/"+*fakeUser.Id+"/follow", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("ShouldReturnErrorDuplicate_WhenAlreadyFollowing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Email = helper.Pointer("email@gmail.com")
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+		followeeId := fake.CharactersN(7)
+
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{
+			Id: &followeeId,
+		}).Return(&model.User{Id: &followeeId}, nil).Once()
+		followMock := &mocks.Follow{}
+		followMock.On("Add", mock.Anything, &model.Follow{
+			FollowerId: fakeUser.Id,
+			FolloweeId: &followeeId,
+		}).Return(nil, model.NewDuplicateError()).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetUserRepo(userMock)
+			appContainer.SetFollowRepo(followMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/users/"+followeeId+"/follow", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusConflict, w.Code)
+		userMock.AssertExpectations(t)
+		followMock.AssertExpectations(t)
+	})
+}
+
+func TestFollow_Feed(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnNewsOfFollowedAuthors", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Email = helper.Pointer("email@gmail.com")
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.PublishedAt = helper.Pointer(time.Now())
+			return news
+		})
+
+		followMock := &mocks.Follow{}
+		followMock.On("GetFolloweeIds", mock.Anything, *fakeUser.Id).Return([]string{*fakeNews.UserId}, nil).Once()
+		newsMock := &mocks.News{}
+		newsMock.On("List", mock.Anything, repository.NewsListFilter{
+			UserIds: []string{*fakeNews.UserId},
+			Limit:   21,
+		}).Return([]model.News{fakeNews}, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetFollowRepo(followMock)
+			appContainer.SetNewsRepo(newsMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/me/feed", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+
+		resBody := struct {
+			Data       []model.News `json:"data"`
+			NextCursor *string      `json:"next_cursor"`
+		}{}
+		err = json.NewDecoder(w.Body).Decode(&resBody)
+		require.NoError(t, err)
+		require.Len(t, resBody.Data, 1)
+		require.Equal(t, *fakeNews.Id, *resBody.Data[0].Id)
+		require.Nil(t, resBody.NextCursor)
+
+		followMock.AssertExpectations(t)
+		newsMock.AssertExpectations(t)
+	})
+}
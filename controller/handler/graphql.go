@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"tempo/container"
+	"tempo/controller/graph"
+	"tempo/controller/request"
+	"tempo/controller/response"
+	"tempo/helper"
+
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Graphql struct {
+	appContainer *container.Container
+	server       *graph.Server
+}
+
+func NewGraphql(appContainer *container.Container) *Graphql {
+	server, err := graph.NewServer(appContainer)
+	if err != nil {
+		// the schema is static, failing to build it is a programming error
+		panic(err)
+	}
+
+	return &Graphql{appContainer: appContainer, server: server}
+}
+
+// Graphql
+// @Summary 	GraphQL
+// @Description Run a GraphQL query or mutation on the users and news. The token is optional, only viewer, addNews and updateNews need it. Errors are reported in the errors field of the result with the http status 200
+// @Accept 		json
+// @Produce 		json
+// @Param request body request.Graphql true "Request Body"
+// @Success 		200		{object}	map[string]interface{}	"Return the data and errors of the request"
+// @Failure 		400 	{object}	response.ErrorResponse 	"When request is not valid"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is invalid"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Security 		BearerAuth
+// @Router /graphql [post]
+func (g *Graphql) Serve(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.Graphql")
+
+	// Validation
+	var req request.Graphql
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	res := g.server.Execute(c.Request.Context(), graph.Request{
+		Query:         req.Query,
+		OperationName: req.OperationName,
+		Variables:     req.Variables,
+	})
+
+	response.WriteSuccessResponse(c, res)
+}
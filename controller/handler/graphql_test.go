@@ -0,0 +1,331 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"tempo/config"
+	"tempo/container"
+	"tempo/controller/request"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type graphqlResult struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	} `json:"errors"`
+}
+
+func graphqlBody(t *testing.T, query string, variables map[string]interface{}) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(request.Graphql{
+		Query:     query,
+		Variables: variables,
+	})
+	require.NoError(t, err)
+	return &buf
+}
+
+func TestGraphql_Serve(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnErrorUnAuthorized_WhenTokenIsInvalid", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/graphql", graphqlBody(t, "{ viewer { id } }", nil), map[string]string{
+			"Authorization": "Bearer token",
+			"Content-Type":  "application/json",
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("ShouldReturnErrorUnprocessableEntity_WhenQueryIsMissing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/graphql", graphqlBody(t, "", nil), map[string]string{
+			"Content-Type": "application/json",
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("ShouldReturnUnauthorizedError_WhenViewerIsAnonymous", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/graphql", graphqlBody(t, "{ viewer { id } }", nil), map[string]string{
+			"Content-Type": "application/json",
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		var res graphqlResult
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.Len(t, res.Errors, 1)
+		require.Equal(t, float64(model.ErrorUnauthorized), res.Errors[0].Extensions["code"])
+		require.Nil(t, res.Data["viewer"])
+	})
+
+	t.Run("ShouldReturnViewerWithEmail_WhenAuthenticated", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, fakeUser := test.FakeJwtToken(t, nil)
+
+		userMock := &mocks.User{}
+		userMock.On("List", mock.Anything, repository.UserListFilter{Ids: []string{*fakeUser.Id}}).
+			Return([]model.User{fakeUser}, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetUserRepo(userMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/graphql", graphqlBody(t, "{ viewer { id email fullName } }", nil), map[string]string{
+			"Authorization": "Bearer " + token,
+			"Content-Type":  "application/json",
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		var res graphqlResult
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.Empty(t, res.Errors)
+		viewer := res.Data["viewer"].(map[string]interface{})
+		require.Equal(t, *fakeUser.Id, viewer["id"])
+		require.Equal(t, *fakeUser.Email, viewer["email"])
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldLoadTheAuthorsInOneQuery_WhenSeveralNewsAreRequested", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		author := test.FakeUser(t, nil)
+		otherAuthor := test.FakeUser(t, nil)
+		news := test.FakeNews(t, func(news model.News) model.News {
+			news.UserId = author.Id
+			news.PublishedAt = helper.Pointer(time.Now())
+			return news
+		})
+		otherNews := test.FakeNews(t, func(news model.News) model.News {
+			news.UserId = otherAuthor.Id
+			news.PublishedAt = helper.Pointer(time.Now())
+			return news
+		})
+
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, news.Id).Return(&news, nil).Once()
+		newsMock.On("Get", mock.Anything, otherNews.Id).Return(&otherNews, nil).Once()
+		userMock := &mocks.User{}
+		userMock.On("List", mock.Anything, mock.MatchedBy(func(filter repository.UserListFilter) bool {
+			return len(filter.Ids) == 2
+		})).Return([]model.User{author, otherAuthor}, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsRepo(newsMock)
+			appContainer.SetUserRepo(userMock)
+			return appContainer
+		})
+		query := `query ($a: ID!, $b: ID!) {
+			a: news(id: $a) { title author { fullName email } }
+			b: news(id: $b) { title author { fullName } }
+		}`
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/graphql", graphqlBody(t, query, map[string]interface{}{
+			"a": *news.Id,
+			"b": *otherNews.Id,
+		}), map[string]string{
+			"Content-Type": "application/json",
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		var res graphqlResult
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.Empty(t, res.Errors)
+		a := res.Data["a"].(map[string]interface{})["author"].(map[string]interface{})
+		require.Equal(t, *author.FullName, a["fullName"])
+		require.Nil(t, a["email"])
+		b := res.Data["b"].(map[string]interface{})["author"].(map[string]interface{})
+		require.Equal(t, *otherAuthor.FullName, b["fullName"])
+		newsMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldAddNewsOfTheViewer", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, fakeUser := test.FakeJwtToken(t, nil)
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.UserId = fakeUser.Id
+			return news
+		})
+
+		newsMock := &mocks.News{}
+		addedNews := &model.News{
+			UserId:      fakeUser.Id,
+			Title:       fakeNews.Title,
+			Description: fakeNews.Description,
+		}
+		addedNews.Fingerprint = helper.Pointer(addedNews.ComputeFingerprint())
+		reading := config.Instance().NewsReading
+		addedNews.ComputeReading(reading.ExcerptLength, reading.WordsPerMinute, reading.CharactersPerMinute)
+		newsMock.On("ListSimilar", mock.Anything, mock.Anything).Return([]model.NewsDuplicate{}, nil).Once()
+		newsMock.On("Add", mock.Anything, addedNews).Return(&fakeNews, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsRepo(newsMock)
+			return appContainer
+		})
+		query := `mutation ($title: String!, $description: String!) {
+			addNews(title: $title, description: $description) { id title }
+		}`
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/graphql", graphqlBody(t, query, map[string]interface{}{
+			"title":       *fakeNews.Title,
+			"description": *fakeNews.Description,
+		}), map[string]string{
+			"Authorization": "Bearer " + token,
+			"Content-Type":  "application/json",
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		var res graphqlResult
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.Empty(t, res.Errors)
+		require.Equal(t, *fakeNews.Id, res.Data["addNews"].(map[string]interface{})["id"])
+		newsMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldRejectTheQuery_WhenTooDeep", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		router := test.SetupHttpHandler(t, nil)
+		query := `{ author(id: "1") { news(first: 1) { author { news(first: 1) { author { news(first: 1) { id } } } } } } }`
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/graphql", graphqlBody(t, query, nil), map[string]string{
+			"Content-Type": "application/json",
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		var res graphqlResult
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.Len(t, res.Errors, 1)
+		require.Contains(t, res.Errors[0].Message, "depth")
+		require.Nil(t, res.Data)
+	})
+
+	t.Run("ShouldRejectTheQuery_WhenTooComplex", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		router := test.SetupHttpHandler(t, nil)
+		query := `query ($first: Int) { author(id: "1") { news(first: $first) { author { news(first: $first) { id title } } } } }`
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/graphql", graphqlBody(t, query, map[string]interface{}{
+			"first": 50,
+		}), map[string]string{
+			"Content-Type": "application/json",
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		var res graphqlResult
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.Len(t, res.Errors, 1)
+		require.Contains(t, res.Errors[0].Message, "complexity")
+	})
+
+	t.Run("ShouldRejectTheQuery_WhenFirstIsOutOfRange", func(t *testing.T) {
+		t.Parallel()
+		query := `query ($first: Int) { author(id: "1") { news(first: $first) { author { news(first: $first) { id title } } } } }`
+
+		for _, first := range []interface{}{-1000, 1e12} {
+			// INIT
+			router := test.SetupHttpHandler(t, nil)
+
+			// CODE UNDER TEST
+			w, err := performRequest(router, "POST", "/graphql", graphqlBody(t, query, map[string]interface{}{
+				"first": first,
+			}), map[string]string{
+				"Content-Type": "application/json",
+			}, nil)
+			require.NoError(t, err)
+
+			// EXPECTATION
+			require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+			var res graphqlResult
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			require.Len(t, res.Errors, 1, first)
+			require.Equal(t, "first must be between 1 and 50", res.Errors[0].Message)
+			require.Nil(t, res.Data)
+		}
+	})
+
+	t.Run("ShouldReturnParameterError_WhenRegisterIsInvalid", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		router := test.SetupHttpHandler(t, nil)
+		query := `mutation { register(email: "email@gmail.com", fullName: "a", password: "password") { id } }`
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/graphql", graphqlBody(t, query, nil), map[string]string{
+			"Content-Type": "application/json",
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		var res graphqlResult
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.Len(t, res.Errors, 1)
+		require.Equal(t, float64(model.ErrorUnprocessableEntity), res.Errors[0].Extensions["code"])
+		require.Nil(t, res.Data)
+	})
+}
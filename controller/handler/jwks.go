@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"tempo/container"
+	"tempo/controller/response"
+
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+)
+
+// jwksMaxAgeSeconds let the verifiers cache the keys, a new key being published before it signs any token
+const jwksMaxAgeSeconds = 300
+
+type Jwks struct {
+	appContainer *container.Container
+}
+
+func NewJwks(appContainer *container.Container) *Jwks {
+	return &Jwks{appContainer: appContainer}
+}
+
+// Keys Jwks
+// @Summary 	JSON Web Key Set
+// @Description Get the public keys verifying the jwt tokens, a token is verified by the key of its kid header
+// @Produce 		json
+// @Success 		200		{object}	jwtkey.JSONWebKeySet	"Return the public keys"
+// @Router /.well-known/jwks.json [get]
+func (j *Jwks) Keys(c *gin.Context) {
+	c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", jwksMaxAgeSeconds))
+	response.WriteSuccessResponse(c, j.appContainer.JwtKeys().Jwks())
+}
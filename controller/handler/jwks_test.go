@@ -0,0 +1,52 @@
+package handler_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"testing"
+
+	"tempo/container"
+	"tempo/helper/test"
+	"tempo/jwtkey"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJwks_Keys(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnThePublicKeys", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		_, private, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		der, err := x509.MarshalPKCS8PrivateKey(private)
+		require.NoError(t, err)
+		signing, err := jwtkey.ParsePrivateKey("key-1", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+		require.NoError(t, err)
+		keys, err := jwtkey.New(signing)
+		require.NoError(t, err)
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetJwtKeys(keys)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/.well-known/jwks.json", nil, nil, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "public, max-age=300", w.Header().Get("Cache-Control"))
+
+		resBody := jwtkey.JSONWebKeySet{}
+		err = json.NewDecoder(w.Body).Decode(&resBody)
+		require.NoError(t, err)
+		require.Equal(t, keys.Jwks(), resBody)
+	})
+}
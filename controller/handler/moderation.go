@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"tempo/container"
+	"tempo/controller/middleware"
+	"tempo/controller/request"
+	"tempo/controller/response"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/usecase"
+
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Moderation struct {
+	appContainer *container.Container
+}
+
+func NewModeration(appContainer *container.Container) *Moderation {
+	return &Moderation{appContainer: appContainer}
+}
+
+// List Moderation Items
+// @Summary 	List Moderation Items
+// @Description List the news flagged by the moderation checks, oldest first. Admin only
+// @Produce 		json
+// @Param status query string false "pending, approved or removed"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "page size, default 20, max 100"
+// @Success 		200		{object}	response.Page{data=[]model.ModerationItem}	"Return the moderation items"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not an admin"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /moderation/items [get]
+func (m *Moderation) List(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.ListModerationItems")
+
+	// Validation
+	var req request.ModerationList
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	moderationUseCase := usecase.NewModeration(m.appContainer)
+	res, next, err := moderationUseCase.List(c, repository.ModerationListFilter{
+		Status: req.Status,
+		Limit:  req.Limit,
+	}, req.Cursor)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error list moderation items")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, response.Page{
+		Data:       res,
+		NextCursor: next,
+	})
+}
+
+// Resolve Moderation Item
+// @Summary 	Resolve Moderation Item
+// @Description Approve a flagged news, or remove it. Admin only
+// @Accept 		json
+// @Produce 		json
+// @Param id path string true "moderation item id"
+// @Param request body request.ModerationResolve true "Request Body"
+// @Success 		200		{object}	model.ModerationItem	"Return the resolved item"
+// @Failure 		400 	{object}	response.ErrorResponse 	"When request is not valid"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not an admin"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the item is not found"
+// @Failure 		409 	{object}	response.ErrorResponse 	"When the item is already resolved"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /moderation/items/:id/resolve [post]
+func (m *Moderation) Resolve(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.ResolveModerationItem")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation
+	var req request.ModerationResolve
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	id := c.Param("id")
+	moderationUseCase := usecase.NewModeration(m.appContainer)
+	res, err := moderationUseCase.Resolve(c, &id, user.Id, *req.Status, req.Note)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error resolve moderation item")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
@@ -0,0 +1,155 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"tempo/container"
+	"tempo/controller/request"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModeration_List(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnErrorForbidden_WhenUserIsNotAdmin", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, _ := test.FakeJwtToken(t, nil)
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/moderation/items", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("ShouldReturnPendingItems_WhenUserIsAdmin", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Role = helper.Pointer(model.UserRoleAdmin)
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+
+		moderationMock := &mocks.Moderation{}
+		moderationMock.On("List", mock.Anything, repository.ModerationListFilter{
+			Status: helper.Pointer(model.ModerationPending),
+			Limit:  21,
+		}).Return([]model.ModerationItem{{
+			Id:     helper.Pointer("item"),
+			Status: helper.Pointer(model.ModerationPending),
+			Flags: []model.ModerationResult{{
+				Check:   "link_limit",
+				Verdict: model.ModerationFlag,
+				Reason:  "too many links",
+			}},
+		}}, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetModerationRepo(moderationMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/moderation/items", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, map[string]string{
+			"status": "pending",
+		})
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var resBody struct {
+			Data []model.ModerationItem `json:"data"`
+		}
+		err = json.NewDecoder(w.Body).Decode(&resBody)
+		require.NoError(t, err)
+		require.Len(t, resBody.Data, 1)
+		require.Equal(t, "link_limit", resBody.Data[0].Flags[0].Check)
+
+		moderationMock.AssertExpectations(t)
+	})
+}
+
+func TestModeration_Resolve(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnUnprocessableEntity_WhenStatusIsPending", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Role = helper.Pointer(model.UserRoleAdmin)
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(request.ModerationResolve{
+			Status: helper.Pointer(model.ModerationPending),
+		})
+		require.NoError(t, err)
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/moderation/items/item/resolve", &buf, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("ShouldReturnConflict_WhenItemIsAlreadyResolved", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Role = helper.Pointer(model.UserRoleAdmin)
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(request.ModerationResolve{
+			Status: helper.Pointer(model.ModerationRemoved),
+		})
+		require.NoError(t, err)
+
+		moderationMock := &mocks.Moderation{}
+		moderationMock.On("Resolve", mock.Anything, "item", model.ModerationRemoved, *fakeUser.Id, (*string)(nil)).
+			Return(nil, model.NewError("moderation item is already resolved", model.ErrorDuplicate)).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetModerationRepo(moderationMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/moderation/items/item/resolve", &buf, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, model.ErrorDuplicate, w.Code)
+
+		moderationMock.AssertExpectations(t)
+	})
+}
@@ -5,12 +5,17 @@ import (
 	"tempo/controller/middleware"
 	"tempo/controller/request"
 	"tempo/controller/response"
+	"tempo/event"
 	"tempo/helper"
 	"tempo/model"
 	"tempo/usecase"
 
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -29,8 +34,10 @@ func NewNews(appContainer *container.Container) *News {
 // @Accept 			json
 // @Produce 		json
 // @Param 			body 	body 		request.News 			true 	" "
-// @Success 		200		{object}	model.News				"Return the news model"
+// @Success 		200		{object}	model.News				"Return the news model, with the ids of its near duplicates in duplicate_of"
 // @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the email is not verified while it is required"
+// @Failure 		409 	{object}	response.ErrorResponse 	"When the news is a near duplicate and those are rejected"
 // @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
 // @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
 // @Security 		BearerAuth
@@ -59,6 +66,7 @@ func (w *News) Add(c *gin.Context) {
 		UserId:      user.Id,
 		Title:       req.Title,
 		Description: req.Description,
+		Tags:        req.Tags,
 	})
 	if err != nil {
 		var e model.Error
@@ -76,11 +84,13 @@ func (w *News) Add(c *gin.Context) {
 
 // Get News
 // @Summary 	Get News
-// @Description Get News
+// @Description Get News, with its previous and next news in the collections it belong to. A news which is not
+// @Description published is only returned to its authors and the admins
 // @Produce 		json
 // @Param id path string true "news id"
 // @Success 		200		{object}	model.News				"Return the news model"
 // @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the news does not exist or is not published"
 // @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
 // @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
 // @Security 		BearerAuth
@@ -89,7 +99,7 @@ func (w *News) Get(c *gin.Context) {
 	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.Add")
 
 	// auth
-	_, err := middleware.GetJWTData(c)
+	user, err := middleware.GetJWTData(c)
 	if err != nil {
 		response.WriteFailResponse(c, http.StatusUnauthorized, err)
 		return
@@ -104,7 +114,7 @@ func (w *News) Get(c *gin.Context) {
 
 	// Action
 	newsUseCase := usecase.NewNews(w.appContainer)
-	res, err := newsUseCase.Get(c, &id)
+	res, err := newsUseCase.Get(c, &id, &user)
 	if err != nil {
 		var e model.Error
 		if !errors.As(err, &e) {
@@ -119,6 +129,45 @@ func (w *News) Get(c *gin.Context) {
 	response.WriteSuccessResponse(c, res)
 }
 
+// List News Duplicates
+// @Summary 	List News Duplicates
+// @Description List the news that are near duplicates of the news, the closest first. Admin only
+// @Produce 		json
+// @Param id path string true "news id"
+// @Success 		200		{array}		model.NewsDuplicate		"Return the near duplicates with their distance"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not an admin"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the news is not found"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /news/:id/duplicates [get]
+func (w *News) Duplicates(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.Duplicates")
+
+	// Validation
+	id := c.Param("id")
+	if id == "" {
+		response.WriteFailResponse(c, http.StatusBadRequest, errors.New("missing id"))
+		return
+	}
+
+	// Action
+	newsUseCase := usecase.NewNews(w.appContainer)
+	res, err := newsUseCase.Duplicates(c, &id)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error list news duplicates")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
 // Update News
 // @Summary 	Update News
 // @Description Update News
@@ -128,6 +177,7 @@ func (w *News) Get(c *gin.Context) {
 // @Param 			body 	body 		request.News 			true 	" "
 // @Success 		200		{object}	model.News				"Return the news model"
 // @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not an author or an editor of the news"
 // @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
 // @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
 // @Security 		BearerAuth
@@ -136,7 +186,7 @@ func (w *News) Update(c *gin.Context) {
 	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.Update")
 
 	// auth
-	_, err := middleware.GetJWTData(c)
+	user, err := middleware.GetJWTData(c)
 	if err != nil {
 		response.WriteFailResponse(c, http.StatusUnauthorized, err)
 		return
@@ -154,9 +204,10 @@ func (w *News) Update(c *gin.Context) {
 
 	// Action
 	newsUseCase := usecase.NewNews(w.appContainer)
-	res, err := newsUseCase.Update(c, &id, &model.News{
+	res, err := newsUseCase.Update(c, &id, user.Id, &model.News{
 		Title:       req.Title,
 		Description: req.Description,
+		Tags:        req.Tags,
 	})
 	if err != nil {
 		var e model.Error
@@ -171,3 +222,112 @@ func (w *News) Update(c *gin.Context) {
 
 	response.WriteSuccessResponse(c, res)
 }
+
+// Stream News
+// @Summary 	Stream News
+// @Description Push the news.created and news.updated events as server-sent events, the event id is to be sent back in the Last-Event-ID header to resume after a reconnect. A client that falls behind is disconnected and has to reconnect
+// @Produce 		text/event-stream
+// @Param author query string false "only the news of this user id"
+// @Param tag query string false "only the news with this tag"
+// @Param Last-Event-ID header string false "id of the last event received"
+// @Success 		200		{object}	model.Event				"Stream of events, data is the news model"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Failure 		503 	{object}	response.ErrorResponse 	"When the stream is not enabled"
+// @Security 		BearerAuth
+// @Router /news/stream [get]
+func (w *News) Stream(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.Stream")
+
+	// auth
+	_, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation
+	var req request.NewsStream
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var lastEventId int64
+	if v := c.GetHeader("Last-Event-ID"); v != "" {
+		lastEventId, err = strconv.ParseInt(v, 10, 64)
+		if err != nil || lastEventId < 0 {
+			response.WriteFailResponse(c, http.StatusUnprocessableEntity, errors.New("invalid Last-Event-ID"))
+			return
+		}
+	}
+
+	// Action
+	newsUseCase := usecase.NewNews(w.appContainer)
+	replay, ch, unsubscribe, err := newsUseCase.Stream(c, lastEventId, req.Author, req.Tag)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error stream news")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+	defer unsubscribe()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	for _, v := range replay {
+		if err := writeStreamEntry(c, v); err != nil {
+			logger.WithError(err).Warning("error write news event")
+			return
+		}
+	}
+	c.Writer.Flush()
+
+	interval := time.Duration(w.appContainer.Config().NewsStream.HeartbeatSeconds) * time.Second
+	if interval <= 0 {
+		interval = 15 * time.Second
+	}
+	heartbeat := time.NewTicker(interval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case v, ok := <-ch:
+			if !ok {
+				logger.Info("client fell behind the news stream")
+				return
+			}
+			if err := writeStreamEntry(c, v); err != nil {
+				logger.WithError(err).Warning("error write news event")
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(c.Writer, ": ping\n\n"); err != nil {
+				return
+			}
+		}
+		c.Writer.Flush()
+	}
+}
+
+func writeStreamEntry(c *gin.Context, entry event.StreamEntry) error {
+	data, err := json.Marshal(entry.Event)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(c.Writer, "id: %d\nevent: %s\ndata: %s\n\n", entry.Seq, entry.Event.Type, data)
+	return err
+}
@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"tempo/container"
+	"tempo/controller/middleware"
+	"tempo/controller/request"
+	"tempo/controller/response"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/usecase"
+
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NewsAuthor struct {
+	appContainer *container.Container
+}
+
+func NewNewsAuthor(appContainer *container.Container) *NewsAuthor {
+	return &NewsAuthor{appContainer: appContainer}
+}
+
+// List News Authors
+// @Summary 	List News Authors
+// @Description List the owner, co-authors and editors of the news
+// @Produce 		json
+// @Param id path string true "news id"
+// @Success 		200		{object}	[]model.NewsAuthor		"Return the collaborators of the news"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the news is not found"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /news/:id/authors [get]
+func (w *NewsAuthor) List(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.ListNewsAuthors")
+
+	// Action
+	id := c.Param("id")
+	newsAuthorUseCase := usecase.NewNewsAuthor(w.appContainer)
+	res, err := newsAuthorUseCase.List(c, &id)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error list news authors")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// Invite News Author
+// @Summary 	Invite News Author
+// @Description Add a user as a co-author, who get a byline, or as an editor of the news. Owner only
+// @Accept 		json
+// @Produce 		json
+// @Param id path string true "news id"
+// @Param request body request.NewsAuthorInvite true "Request Body"
+// @Success 		200		{object}	model.NewsAuthor		"Return the collaborator"
+// @Failure 		400 	{object}	response.ErrorResponse 	"When request is not valid"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not the owner of the news"
+// @Failure 		409 	{object}	response.ErrorResponse 	"When the user is already a collaborator of the news"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /news/:id/authors [post]
+func (w *NewsAuthor) Invite(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.InviteNewsAuthor")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation
+	var req request.NewsAuthorInvite
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	id := c.Param("id")
+	newsAuthorUseCase := usecase.NewNewsAuthor(w.appContainer)
+	res, err := newsAuthorUseCase.Invite(c, &id, user.Id, req.UserId, *req.Role)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error invite news author")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// Remove News Author
+// @Summary 	Remove News Author
+// @Description Remove a collaborator from the news. The owner can remove anyone else, a collaborator can remove themself
+// @Produce 		json
+// @Param id path string true "news id"
+// @Param userId path string true "user id"
+// @Success 		200		{object}	response.SuccessResponse		"When the collaborator is removed"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not the owner of the news"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the collaborator is not found"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When the collaborator is the owner"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /news/:id/authors/:userId [delete]
+func (w *NewsAuthor) Remove(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.RemoveNewsAuthor")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Action
+	id := c.Param("id")
+	userId := c.Param("userId")
+	newsAuthorUseCase := usecase.NewNewsAuthor(w.appContainer)
+	err = newsAuthorUseCase.Remove(c, &id, user.Id, &userId)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error remove news author")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, nil)
+}
+
+// Transfer News Ownership
+// @Summary 	Transfer News Ownership
+// @Description Make a user the owner of the news, the previous owner stay a co-author. Owner only
+// @Accept 		json
+// @Produce 		json
+// @Param id path string true "news id"
+// @Param request body request.NewsOwnerTransfer true "Request Body"
+// @Success 		200		{object}	[]model.NewsAuthor		"Return the collaborators of the news"
+// @Failure 		400 	{object}	response.ErrorResponse 	"When request is not valid"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not the owner of the news"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /news/:id/owner [put]
+func (w *NewsAuthor) TransferOwnership(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.TransferNewsOwnership")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation
+	var req request.NewsOwnerTransfer
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	id := c.Param("id")
+	newsAuthorUseCase := usecase.NewNewsAuthor(w.appContainer)
+	res, err := newsAuthorUseCase.TransferOwnership(c, &id, user.Id, req.UserId)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error transfer news ownership")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
@@ -0,0 +1,113 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"tempo/container"
+	"tempo/controller/request"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewsAuthor_Invite(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnUnprocessableEntity_WhenRoleIsOwner", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, _ := test.FakeJwtToken(t, nil)
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(request.NewsAuthorInvite{
+			UserId: helper.Pointer("user"),
+			Role:   helper.Pointer(model.NewsAuthorOwner),
+		})
+		require.NoError(t, err)
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/news/news/authors", &buf, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("ShouldReturnForbidden_WhenUserIsNotTheOwner", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, fakeUser := test.FakeJwtToken(t, nil)
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(request.NewsAuthorInvite{
+			UserId: helper.Pointer("user"),
+			Role:   helper.Pointer(model.NewsAuthorEditor),
+		})
+		require.NoError(t, err)
+
+		newsAuthorMock := &mocks.NewsAuthor{}
+		newsAuthorMock.On("Get", mock.Anything, "news", *fakeUser.Id).Return(nil, model.NewNotFoundError()).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsAuthorRepo(newsAuthorMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/news/news/authors", &buf, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusForbidden, w.Code)
+
+		newsAuthorMock.AssertExpectations(t)
+	})
+}
+
+func TestNewsAuthor_List(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnTheCollaborators", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, _ := test.FakeJwtToken(t, nil)
+		newsAuthorMock := &mocks.NewsAuthor{}
+		newsAuthorMock.On("List", mock.Anything, mock.Anything).Return([]model.NewsAuthor{
+			{NewsId: helper.Pointer("news"), UserId: helper.Pointer("owner"), Role: helper.Pointer(model.NewsAuthorOwner)},
+			{NewsId: helper.Pointer("news"), UserId: helper.Pointer("editor"), Role: helper.Pointer(model.NewsAuthorEditor)},
+		}, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsAuthorRepo(newsAuthorMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/news/news/authors", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var resBody []model.NewsAuthor
+		err = json.NewDecoder(w.Body).Decode(&resBody)
+		require.NoError(t, err)
+		require.Len(t, resBody, 2)
+		require.Equal(t, model.NewsAuthorOwner, *resBody[0].Role)
+
+		newsAuthorMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"tempo/container"
+	"tempo/controller/middleware"
+	"tempo/controller/request"
+	"tempo/controller/response"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/usecase"
+
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NewsReport struct {
+	appContainer *container.Container
+}
+
+func NewNewsReport(appContainer *container.Container) *NewsReport {
+	return &NewsReport{appContainer: appContainer}
+}
+
+// Report News
+// @Summary 	Report News
+// @Description Report a news to the moderators. A news reported by too many users is hidden until they review it
+// @Accept 		json
+// @Produce 		json
+// @Param id path string true "news id"
+// @Param request body request.NewsReport true "Request Body"
+// @Success 		200		{object}	model.NewsReport		"Return the report"
+// @Failure 		400 	{object}	response.ErrorResponse 	"When request is not valid"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the news is not found"
+// @Failure 		409 	{object}	response.ErrorResponse 	"When the user already reported the news"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /news/:id/reports [post]
+func (n *NewsReport) Report(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.ReportNews")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation
+	var req request.NewsReport
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	id := c.Param("id")
+	newsReportUseCase := usecase.NewNewsReport(n.appContainer)
+	res, err := newsReportUseCase.Report(c, &model.NewsReport{
+		NewsId: &id,
+		UserId: user.Id,
+		Reason: req.Reason,
+		Note:   req.Note,
+	})
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error report news")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// List Reported News
+// @Summary 	List Reported News
+// @Description List the news with open reports and the reports, the most reported first. Admin only
+// @Produce 		json
+// @Param limit query int false "number of news, default 20, max 100"
+// @Success 		200		{object}	[]model.NewsReportGroup	"Return the reported news"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not an admin"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /moderation/reports [get]
+func (n *NewsReport) Queue(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.ListReportedNews")
+
+	// Validation
+	var req request.NewsReportQueue
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	newsReportUseCase := usecase.NewNewsReport(n.appContainer)
+	res, err := newsReportUseCase.Queue(c, req.Limit)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error list reported news")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// Resolve News Reports
+// @Summary 	Resolve News Reports
+// @Description Close the open reports of a news, dismissing them or unpublishing or deleting the news. Admin only
+// @Accept 		json
+// @Produce 		json
+// @Param newsId path string true "news id"
+// @Param request body request.NewsReportResolve true "Request Body"
+// @Success 		200		{object}	model.NewsReportResolution	"Return the resolution"
+// @Failure 		400 	{object}	response.ErrorResponse 	"When request is not valid"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not an admin"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the news has no open report"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /moderation/reports/:newsId/resolve [post]
+func (n *NewsReport) Resolve(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.ResolveNewsReports")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation
+	var req request.NewsReportResolve
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	newsId := c.Param("newsId")
+	newsReportUseCase := usecase.NewNewsReport(n.appContainer)
+	res, err := newsReportUseCase.Resolve(c, &newsId, user.Id, *req.Action, req.Note)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error resolve news reports")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
@@ -0,0 +1,203 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"tempo/container"
+	"tempo/controller/request"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewsReport_Report(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnUnprocessableEntity_WhenReasonIsUnknown", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, _ := test.FakeJwtToken(t, nil)
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(map[string]string{"reason": "boring"})
+		require.NoError(t, err)
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/news/news/reports", &buf, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("ShouldReturnConflict_WhenUserAlreadyReportedTheNews", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, fakeUser := test.FakeJwtToken(t, nil)
+		fakeNews := test.FakeNews(t, nil)
+		reqBody := request.NewsReport{
+			Reason: helper.Pointer(model.NewsReportSpam),
+		}
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(reqBody)
+		require.NoError(t, err)
+
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeNews.Id).Return(&fakeNews, nil).Once()
+		newsReportMock := &mocks.NewsReport{}
+		newsReportMock.On("Add", mock.Anything, &model.NewsReport{
+			NewsId: fakeNews.Id,
+			UserId: fakeUser.Id,
+			Reason: reqBody.Reason,
+		}, mock.Anything).Return(nil, model.NewError("news is already reported by the user", model.ErrorDuplicate)).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsRepo(newsMock)
+			appContainer.SetNewsReportRepo(newsReportMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/news/"+*fakeNews.Id+"/reports", &buf, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusConflict, w.Code)
+
+		newsMock.AssertExpectations(t)
+		newsReportMock.AssertExpectations(t)
+	})
+}
+
+func TestNewsReport_Queue(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnErrorForbidden_WhenUserIsNotAdmin", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, _ := test.FakeJwtToken(t, nil)
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/moderation/reports", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("ShouldReturnTheReportedNews_WhenUserIsAdmin", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Role = helper.Pointer(model.UserRoleAdmin)
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+
+		newsReportMock := &mocks.NewsReport{}
+		newsReportMock.On("ListGroups", mock.Anything, repository.NewsReportGroupFilter{Limit: 10}).Return([]model.NewsReportGroup{{
+			NewsId:      helper.Pointer("news"),
+			ReportCount: 6,
+			Hidden:      true,
+			Reports: []model.NewsReport{{
+				NewsId: helper.Pointer("news"),
+				Reason: helper.Pointer(model.NewsReportHate),
+			}},
+		}}, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsReportRepo(newsReportMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/moderation/reports", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, map[string]string{
+			"limit": "10",
+		})
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var resBody []model.NewsReportGroup
+		err = json.NewDecoder(w.Body).Decode(&resBody)
+		require.NoError(t, err)
+		require.Len(t, resBody, 1)
+		require.Equal(t, 6, resBody[0].ReportCount)
+		require.True(t, resBody[0].Hidden)
+
+		newsReportMock.AssertExpectations(t)
+	})
+}
+
+func TestNewsReport_Resolve(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldResolveTheReports_WhenUserIsAdmin", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Role = helper.Pointer(model.UserRoleAdmin)
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+		reqBody := request.NewsReportResolve{
+			Action: helper.Pointer(model.NewsReportUnpublish),
+			Note:   helper.Pointer("misleading title"),
+		}
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(reqBody)
+		require.NoError(t, err)
+
+		newsReportMock := &mocks.NewsReport{}
+		newsReportMock.On("Resolve", mock.Anything, "news", model.NewsReportUnpublish, *fakeUser.Id, reqBody.Note).Return(&model.NewsReportResolution{
+			Id:          helper.Pointer("resolution"),
+			NewsId:      helper.Pointer("news"),
+			Action:      reqBody.Action,
+			ReportCount: helper.Pointer(3),
+			ResolvedBy:  fakeUser.Id,
+			Note:        reqBody.Note,
+		}, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsReportRepo(newsReportMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/moderation/reports/news/resolve", &buf, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var resBody model.NewsReportResolution
+		err = json.NewDecoder(w.Body).Decode(&resBody)
+		require.NoError(t, err)
+		require.Equal(t, model.NewsReportUnpublish, *resBody.Action)
+		require.Equal(t, 3, *resBody.ReportCount)
+
+		newsReportMock.AssertExpectations(t)
+	})
+}
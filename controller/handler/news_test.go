@@ -1,14 +1,21 @@
 package handler_test
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"tempo/config"
 	"tempo/container"
 	"tempo/controller/request"
+	"tempo/event"
 	"tempo/helper"
 	"tempo/helper/test"
 	"tempo/model"
@@ -78,11 +85,16 @@ func TestNews_AddNews(t *testing.T) {
 		require.NoError(t, err)
 
 		newsMock := &mocks.News{}
-		newsMock.On("Add", mock.Anything, &model.News{
+		addedNews := &model.News{
 			UserId:      fakeUser.Id,
 			Title:       reqBody.Title,
 			Description: reqBody.Description,
-		}).Return(nil, errors.New("error add")).Once()
+		}
+		addedNews.Fingerprint = helper.Pointer(addedNews.ComputeFingerprint())
+		reading := config.Instance().NewsReading
+		addedNews.ComputeReading(reading.ExcerptLength, reading.WordsPerMinute, reading.CharactersPerMinute)
+		newsMock.On("ListSimilar", mock.Anything, mock.Anything).Return([]model.NewsDuplicate{}, nil).Once()
+		newsMock.On("Add", mock.Anything, addedNews).Return(nil, errors.New("error add")).Once()
 
 		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
 			appContainer.SetNewsRepo(newsMock)
@@ -122,11 +134,16 @@ func TestNews_AddNews(t *testing.T) {
 		require.NoError(t, err)
 
 		newsMock := &mocks.News{}
-		newsMock.On("Add", mock.Anything, &model.News{
+		addedNews := &model.News{
 			UserId:      fakeUser.Id,
 			Title:       reqBody.Title,
 			Description: reqBody.Description,
-		}).Return(&fakeNews, nil).Once()
+		}
+		addedNews.Fingerprint = helper.Pointer(addedNews.ComputeFingerprint())
+		reading := config.Instance().NewsReading
+		addedNews.ComputeReading(reading.ExcerptLength, reading.WordsPerMinute, reading.CharactersPerMinute)
+		newsMock.On("ListSimilar", mock.Anything, mock.Anything).Return([]model.NewsDuplicate{}, nil).Once()
+		newsMock.On("Add", mock.Anything, addedNews).Return(&fakeNews, nil).Once()
 
 		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
 			appContainer.SetNewsRepo(newsMock)
@@ -156,6 +173,35 @@ func TestNews_AddNews(t *testing.T) {
 		require.Nil(t, resBody.UpdatedAt)
 	})
 
+	t.Run("ShouldReturnErrorNotFound_WhenNewsIsHidden", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, fakeUser := test.FakeJwtToken(t, nil)
+		fakeNews := test.FakeNews(t, nil)
+
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeNews.Id).Return(&fakeNews, nil).Once()
+		newsAuthorMock := &mocks.NewsAuthor{}
+		newsAuthorMock.On("Get", mock.Anything, *fakeNews.Id, *fakeUser.Id).Return(nil, model.NewNotFoundError()).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsRepo(newsMock)
+			appContainer.SetNewsAuthorRepo(newsAuthorMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/news/"+*fakeNews.Id, nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusNotFound, w.Code)
+		newsMock.AssertExpectations(t)
+		newsAuthorMock.AssertExpectations(t)
+	})
 }
 
 func TestNews_GetNews(t *testing.T) {
@@ -319,8 +365,16 @@ func TestNews_UpdateNews(t *testing.T) {
 			Title: reqBody.Title,
 		}).Return(nil, errors.New("error update")).Once()
 
+		newsAuthorMock := &mocks.NewsAuthor{}
+		newsAuthorMock.On("Get", mock.Anything, *fakeNews.Id, *fakeUser.Id).Return(&model.NewsAuthor{
+			NewsId: fakeNews.Id,
+			UserId: fakeUser.Id,
+			Role:   helper.Pointer(model.NewsAuthorOwner),
+		}, nil).Once()
+
 		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
 			appContainer.SetNewsRepo(newsMock)
+			appContainer.SetNewsAuthorRepo(newsAuthorMock)
 			return appContainer
 		})
 
@@ -357,13 +411,27 @@ func TestNews_UpdateNews(t *testing.T) {
 		require.NoError(t, err)
 
 		newsMock := &mocks.News{}
-		newsMock.On("Update", mock.Anything, fakeNews.Id, &model.News{
+		updatedNews := &model.News{
 			Title:       reqBody.Title,
 			Description: reqBody.Description,
-		}).Return(&fakeNews, nil).Once()
+		}
+		reading := config.Instance().NewsReading
+		updatedNews.ComputeReading(reading.ExcerptLength, reading.WordsPerMinute, reading.CharactersPerMinute)
+		newsMock.On("Update", mock.Anything, fakeNews.Id, updatedNews).Return(&fakeNews, nil).Once()
+
+		owner := model.NewsAuthor{
+			NewsId:   fakeNews.Id,
+			UserId:   fakeUser.Id,
+			FullName: fakeUser.FullName,
+			Role:     helper.Pointer(model.NewsAuthorOwner),
+		}
+		newsAuthorMock := &mocks.NewsAuthor{}
+		newsAuthorMock.On("Get", mock.Anything, *fakeNews.Id, *fakeUser.Id).Return(&owner, nil).Once()
+		newsAuthorMock.On("List", mock.Anything, mock.Anything).Return([]model.NewsAuthor{owner}, nil).Once()
 
 		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
 			appContainer.SetNewsRepo(newsMock)
+			appContainer.SetNewsAuthorRepo(newsAuthorMock)
 			return appContainer
 		})
 
@@ -388,6 +456,225 @@ func TestNews_UpdateNews(t *testing.T) {
 		require.Equal(t, *fakeNews.Description, *resBody.Description)
 		require.Nil(t, resBody.CreatedAt)
 		require.Nil(t, resBody.UpdatedAt)
+		require.Len(t, resBody.Authors, 1)
+		require.Equal(t, *fakeUser.Id, *resBody.Authors[0].UserId)
 	})
 
 }
+
+func TestNews_Stream(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnErrorServiceUnavailable_WhenStreamIsNotEnabled", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, _ := test.FakeJwtToken(t, nil)
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/news/stream", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	t.Run("ShouldReturnErrorUnprocessableEntity_WhenLastEventIdIsInvalid", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, _ := test.FakeJwtToken(t, nil)
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsStream(event.NewStream(10, 10))
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/news/stream", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+			"Last-Event-ID": "abc",
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("ShouldSendTheEventsAfterLastEventIdOfTheAuthor", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, _ := test.FakeJwtToken(t, nil)
+		authorId := fake.CharactersN(7)
+		news := test.FakeNews(t, func(news model.News) model.News {
+			news.PublishedAt = helper.Pointer(time.Now())
+			news.UserId = &authorId
+			return news
+		})
+		otherNews := test.FakeNews(t, func(news model.News) model.News {
+			news.PublishedAt = helper.Pointer(time.Now())
+			return news
+		})
+
+		stream := event.NewStream(10, 10)
+		stream.Push(event.New(model.EventNewsCreated, &news))
+		stream.Push(event.New(model.EventNewsCreated, &otherNews))
+		stream.Push(event.New(model.EventNewsUpdated, &news))
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsStream(stream)
+			return appContainer
+		})
+		server := httptest.NewServer(router)
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, "GET", server.URL+"/news/stream?author="+authorId, nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Last-Event-ID", "1")
+
+		// CODE UNDER TEST
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+		reader := bufio.NewReader(res.Body)
+		var lines []string
+		for len(lines) < 3 {
+			line, err := reader.ReadString('\n')
+			require.NoError(t, err)
+			lines = append(lines, strings.TrimSpace(line))
+		}
+		require.Equal(t, "id: 3", lines[0])
+		require.Equal(t, "event: news.updated", lines[1])
+		require.Contains(t, lines[2], *news.Id)
+
+		stream.Push(event.New(model.EventNewsUpdated, &otherNews))
+		stream.Push(event.New(model.EventNewsUpdated, &news))
+		var next string
+		for !strings.HasPrefix(next, "id:") {
+			next, err = reader.ReadString('\n')
+			require.NoError(t, err)
+		}
+		require.Equal(t, "id: 5", strings.TrimSpace(next))
+	})
+
+	t.Run("ShouldSendTheEventsOfTheTag", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, _ := test.FakeJwtToken(t, nil)
+		news := test.FakeNews(t, func(news model.News) model.News {
+			news.PublishedAt = helper.Pointer(time.Now())
+			news.Tags = []string{"live"}
+			return news
+		})
+		otherNews := test.FakeNews(t, func(news model.News) model.News {
+			news.PublishedAt = helper.Pointer(time.Now())
+			return news
+		})
+
+		stream := event.NewStream(10, 10)
+		stream.Push(event.New(model.EventNewsCreated, &otherNews))
+		stream.Push(event.New(model.EventNewsCreated, &news))
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsStream(stream)
+			return appContainer
+		})
+		server := httptest.NewServer(router)
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, "GET", server.URL+"/news/stream?tag=live", nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		// CODE UNDER TEST
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		reader := bufio.NewReader(res.Body)
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, "id: 2", strings.TrimSpace(line))
+	})
+}
+
+func TestNews_Duplicates(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnErrorForbidden_WhenUserIsNotAdmin", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, _ := test.FakeJwtToken(t, nil)
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/news/news/duplicates", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("ShouldReturnTheNearDuplicates_WhenUserIsAdmin", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Role = helper.Pointer(model.UserRoleAdmin)
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.Fingerprint = helper.Pointer(uint64(7))
+			return news
+		})
+
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeNews.Id).Return(&fakeNews, nil).Once()
+		newsMock.On("ListSimilar", mock.Anything, mock.Anything).Return([]model.NewsDuplicate{{
+			News:     model.News{Id: helper.Pointer("other")},
+			Distance: 3,
+		}}, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsRepo(newsMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/news/"+*fakeNews.Id+"/duplicates", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var resBody []model.NewsDuplicate
+		err = json.NewDecoder(w.Body).Decode(&resBody)
+		require.NoError(t, err)
+		require.Len(t, resBody, 1)
+		require.Equal(t, "other", *resBody[0].Id)
+		require.Equal(t, 3, resBody[0].Distance)
+
+		newsMock.AssertExpectations(t)
+	})
+}
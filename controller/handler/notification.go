@@ -0,0 +1,237 @@
+package handler
+
+import (
+	"tempo/container"
+	"tempo/controller/middleware"
+	"tempo/controller/request"
+	"tempo/controller/response"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/usecase"
+
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Notification struct {
+	appContainer *container.Container
+}
+
+func NewNotification(appContainer *container.Container) *Notification {
+	return &Notification{appContainer: appContainer}
+}
+
+// List Notifications
+// @Summary 	List Notifications
+// @Description List the notifications of the current user, newest first, along with the unread count
+// @Produce 		json
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "page size, default 20, max 100"
+// @Param unread query bool false "only return the unread notifications"
+// @Success 		200		{object}	response.NotificationPage{data=[]model.Notification}	"Return the notifications"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /me/notifications [get]
+func (w *Notification) List(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.ListNotification")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation
+	var req request.NotificationList
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	notificationUseCase := usecase.NewNotification(w.appContainer)
+	res, next, unread, err := notificationUseCase.List(c, repository.NotificationListFilter{
+		UserId:     user.Id,
+		UnreadOnly: req.Unread,
+		Limit:      req.Limit,
+	}, req.Cursor)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error list notification")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, response.NotificationPage{
+		Page: response.Page{
+			Data:       res,
+			NextCursor: next,
+		},
+		UnreadCount: unread,
+	})
+}
+
+// Mark Notifications Read
+// @Summary 	Mark Notifications Read
+// @Description Mark the given notifications of the current user as read, or all of them when all is set
+// @Accept 		json
+// @Produce 		json
+// @Param request body request.NotificationRead true "Request Body"
+// @Success 		200		{object}	response.NotificationRead	"Return how many notifications were marked read"
+// @Failure 		400 	{object}	response.ErrorResponse 	"When request is not valid"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /me/notifications/read [post]
+func (w *Notification) MarkRead(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.MarkReadNotification")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation
+	var req request.NotificationRead
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	ids := req.Ids
+	if req.All {
+		ids = nil
+	}
+	notificationUseCase := usecase.NewNotification(w.appContainer)
+	count, err := notificationUseCase.MarkRead(c, user.Id, ids)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error mark read notification")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, response.NotificationRead{Updated: count})
+}
+
+// Get Notification Preferences
+// @Summary 	Get Notification Preferences
+// @Description Return whether the current user is notified for each notification type
+// @Produce 		json
+// @Success 		200		{object}	[]model.NotificationPreference	"Return the preferences"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /me/notifications/preferences [get]
+func (w *Notification) GetPreferences(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.GetNotificationPreferences")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Action
+	notificationUseCase := usecase.NewNotification(w.appContainer)
+	res, err := notificationUseCase.GetPreferences(c, user.Id)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error get notification preferences")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// Update Notification Preferences
+// @Summary 	Update Notification Preferences
+// @Description Enable or disable notification types for the current user, types not in the request are left unchanged
+// @Accept 		json
+// @Produce 		json
+// @Param request body request.NotificationPreferences true "Request Body"
+// @Success 		200		{object}	[]model.NotificationPreference	"Return all the preferences"
+// @Failure 		400 	{object}	response.ErrorResponse 	"When request is not valid"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /me/notifications/preferences [put]
+func (w *Notification) UpdatePreferences(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.UpdateNotificationPreferences")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation
+	var req request.NotificationPreferences
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	notificationUseCase := usecase.NewNotification(w.appContainer)
+	res, err := notificationUseCase.UpdatePreferences(c, user.Id, req.Preferences)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error update notification preferences")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
@@ -0,0 +1,132 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotification_List(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnNotificationsWithUnreadCount", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Email = helper.Pointer("email@gmail.com")
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+
+		notificationMock := &mocks.Notification{}
+		notificationMock.On("List", mock.Anything, repository.NotificationListFilter{
+			UserId:     fakeUser.Id,
+			UnreadOnly: true,
+			Limit:      21,
+		}).Return([]model.Notification{{
+			Id:   helper.Pointer("notification"),
+			Type: helper.Pointer(model.NotificationNewsCommented),
+		}}, nil).Once()
+		notificationMock.On("CountUnread", mock.Anything, *fakeUser.Id).Return(int64(1), nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNotificationRepo(notificationMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/me/notifications?unread=true", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+
+		resBody := struct {
+			Data        []model.Notification `json:"data"`
+			NextCursor  *string              `json:"next_cursor"`
+			UnreadCount int64                `json:"unread_count"`
+		}{}
+		err = json.NewDecoder(w.Body).Decode(&resBody)
+		require.NoError(t, err)
+		require.Len(t, resBody.Data, 1)
+		require.Equal(t, int64(1), resBody.UnreadCount)
+		require.Nil(t, resBody.NextCursor)
+
+		notificationMock.AssertExpectations(t)
+	})
+}
+
+func TestNotification_MarkRead(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnErrorUnprocessableEntity_WhenIdsAreMissing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Email = helper.Pointer("email@gmail.com")
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(map[string]interface{}{})
+		require.NoError(t, err)
+
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/me/notifications/read", &buf, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("ShouldMarkAllRead_WhenAllIsSet", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Email = helper.Pointer("email@gmail.com")
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(map[string]interface{}{
+			"all": true,
+		})
+		require.NoError(t, err)
+
+		notificationMock := &mocks.Notification{}
+		notificationMock.On("MarkRead", mock.Anything, *fakeUser.Id, []string(nil)).Return(int64(3), nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNotificationRepo(notificationMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/me/notifications/read", &buf, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		notificationMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"tempo/container"
+	"tempo/controller/request"
+	"tempo/controller/response"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/usecase"
+
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Share struct {
+	appContainer *container.Container
+}
+
+func NewShare(appContainer *container.Container) *Share {
+	return &Share{appContainer: appContainer}
+}
+
+// OEmbed News
+// @Summary 	oEmbed News
+// @Description Get the oEmbed link response of a news from its url on the public site or of its share page
+// @Produce 		json,xml
+// @Param url query string true "url of the news"
+// @Param format query string false "json, the default, or xml"
+// @Success 		200		{object}	response.OEmbed			"Return the oEmbed response"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the url is not a published news of the site"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Failure 		501 	{object}	response.ErrorResponse 	"When the format is not supported"
+// @Router /oembed [get]
+func (s *Share) OEmbed(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.OEmbed")
+
+	// Validation
+	var req request.OEmbed
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if req.Format != "" && req.Format != request.OEmbedFormatJson && req.Format != request.OEmbedFormatXml {
+		response.WriteFailResponse(c, http.StatusNotImplemented, fmt.Errorf("format %q is not supported", req.Format))
+		return
+	}
+
+	// Action
+	shareUseCase := usecase.NewShare(s.appContainer)
+	res, err := shareUseCase.PreviewUrl(c, req.Url)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error get news preview")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	cfg := s.appContainer.Config()
+	embed := response.NewOEmbed(*res, cfg.Site, cfg.Share.CacheSeconds)
+	if req.Format == request.OEmbedFormatXml {
+		body, err := xml.Marshal(embed)
+		if err != nil {
+			logger.WithError(err).Warning("error encode oembed")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+		c.Data(http.StatusOK, "text/xml; charset=utf-8", append([]byte(xml.Header), body...))
+		return
+	}
+
+	response.WriteSuccessResponse(c, embed)
+}
+
+// Page Share News
+// @Summary 	Share News
+// @Description Get the html page of a news with its OpenGraph and Twitter card tags, for the link previews
+// @Produce 		html
+// @Param id path string true "news id"
+// @Success 		200		{string}	string					"Return the share page"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the news is not published"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Router /share/news/:id [get]
+func (s *Share) Page(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.SharePage")
+
+	// Action
+	shareUseCase := usecase.NewShare(s.appContainer)
+	res, err := shareUseCase.Preview(c, c.Param("id"))
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error get news preview")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	site := s.appContainer.Config().Site
+	var buf bytes.Buffer
+	err = response.SharePageTemplate.Execute(&buf, response.SharePage{
+		NewsPreview: *res,
+		SiteName:    site.Name,
+		Language:    site.Language,
+		OEmbedUrl:   site.Url("/oembed?format=json&url=" + url.QueryEscape(res.Url)),
+	})
+	if err != nil {
+		logger.WithError(err).Warning("error render share page")
+		response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Data(http.StatusOK, "text/html; charset=utf-8", buf.Bytes())
+}
@@ -0,0 +1,181 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"tempo/container"
+	"tempo/controller/response"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShare_OEmbed(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnNotImplemented_WhenFormatIsUnknown", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/oembed", nil, nil, map[string]string{
+			"url":    "http://localhost:8080/news/news",
+			"format": "yaml",
+		})
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusNotImplemented, w.Code)
+	})
+
+	t.Run("ShouldReturnNotFound_WhenUrlIsNotOfTheSite", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/oembed", nil, nil, map[string]string{
+			"url": "https://elsewhere.test/news/news",
+		})
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("ShouldReturnTheLink_WhenUrlIsAPublishedNews", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.PublishedAt = helper.Pointer(time.Now().Add(-time.Hour))
+			return news
+		})
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeNews.Id).Return(&fakeNews, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsRepo(newsMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/oembed", nil, nil, map[string]string{
+			"url": "http://localhost:8080/news/" + *fakeNews.Id,
+		})
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		var res response.OEmbed
+		err = json.NewDecoder(w.Body).Decode(&res)
+		require.NoError(t, err)
+		require.Equal(t, "1.0", res.Version)
+		require.Equal(t, "link", res.Type)
+		require.Equal(t, *fakeNews.Title, res.Title)
+
+		newsMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnXml_WhenFormatIsXml", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.PublishedAt = helper.Pointer(time.Now().Add(-time.Hour))
+			return news
+		})
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeNews.Id).Return(&fakeNews, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsRepo(newsMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/oembed", nil, nil, map[string]string{
+			"url":    "http://localhost:8080/share/news/" + *fakeNews.Id,
+			"format": "xml",
+		})
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "text/xml; charset=utf-8", w.Header().Get("Content-Type"))
+		require.Contains(t, w.Body.String(), "<oembed><version>1.0</version><type>link</type>")
+
+		newsMock.AssertExpectations(t)
+	})
+}
+
+func TestShare_Page(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldRenderTheOpenGraphTags_WhenNewsIsPublished", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.Title = helper.Pointer(`Council "approves" <budget>`)
+			news.Description = helper.Pointer("![mayor](https://cdn.tempo.test/mayor.jpg) The council approved the budget.")
+			news.PublishedAt = helper.Pointer(time.Now().Add(-time.Hour))
+			return news
+		})
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeNews.Id).Return(&fakeNews, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsRepo(newsMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/share/news/"+*fakeNews.Id, nil, nil, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+		body := w.Body.String()
+		require.Contains(t, body, `<meta property="og:title" content="Council &#34;approves&#34; &lt;budget&gt;">`)
+		require.Contains(t, body, `<meta property="og:description" content="The council approved the budget.">`)
+		require.Contains(t, body, `<meta property="og:image" content="https://cdn.tempo.test/mayor.jpg">`)
+		require.Contains(t, body, `<meta property="og:url" content="http://localhost:8080/news/`+*fakeNews.Id+`">`)
+		require.Contains(t, body, `<meta name="twitter:card" content="summary_large_image">`)
+
+		newsMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnNotFound_WhenNewsIsNotPublished", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, nil)
+		fakeNews.PublishedAt = nil
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeNews.Id).Return(&fakeNews, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsRepo(newsMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/share/news/"+*fakeNews.Id, nil, nil, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusNotFound, w.Code)
+
+		newsMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"tempo/container"
+	"tempo/controller/response"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/usecase"
+
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const xmlContentType = "application/xml; charset=utf-8"
+
+type Sitemap struct {
+	appContainer *container.Container
+}
+
+func NewSitemap(appContainer *container.Container) *Sitemap {
+	return &Sitemap{appContainer: appContainer}
+}
+
+// Index Sitemap
+// @Summary 	Sitemap Index
+// @Description Get the sitemap index, listing the sitemaps of the published news by chunks of 50k urls and the Google News sitemap
+// @Produce 		xml
+// @Success 		200		{string}	string					"Return the sitemap index"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Router /sitemap.xml [get]
+func (s *Sitemap) Index(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.SitemapIndex")
+
+	// Action
+	sitemapUseCase := usecase.NewSitemap(s.appContainer)
+	res, err := sitemapUseCase.Index(c)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error get sitemap index")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	c.Data(http.StatusOK, xmlContentType, res)
+}
+
+// Chunk Sitemap
+// @Summary 	Sitemap Chunk
+// @Description Get a sitemap of the published news, with their last modification
+// @Produce 		xml
+// @Param chunk path string true "number of the chunk, from 1, with the .xml extension"
+// @Success 		200		{string}	string					"Return the sitemap"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the chunk does not exist"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Router /sitemaps/:chunk [get]
+func (s *Sitemap) Chunk(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.SitemapChunk")
+
+	// Validation
+	number, err := strconv.Atoi(strings.TrimSuffix(c.Param("chunk"), ".xml"))
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusNotFound, model.NewNotFoundError())
+		return
+	}
+
+	// Action
+	sitemapUseCase := usecase.NewSitemap(s.appContainer)
+	res, err := sitemapUseCase.Chunk(c, number)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error get sitemap chunk")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	c.Data(http.StatusOK, xmlContentType, res)
+}
+
+// News Sitemap
+// @Summary 	Google News Sitemap
+// @Description Get the Google News sitemap of the news published in the last 48 hours, the newest first
+// @Produce 		xml
+// @Success 		200		{string}	string					"Return the news sitemap"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Router /sitemap-news.xml [get]
+func (s *Sitemap) News(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.SitemapNews")
+
+	// Action
+	sitemapUseCase := usecase.NewSitemap(s.appContainer)
+	res, err := sitemapUseCase.News(c)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error get news sitemap")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	c.Data(http.StatusOK, xmlContentType, res)
+}
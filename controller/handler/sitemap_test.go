@@ -0,0 +1,67 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"tempo/config"
+	"tempo/container"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository/mocks"
+	"tempo/sitemap"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSitemap_Chunk(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnTheSitemap_WithoutAuthentication", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		sitemapMock := &mocks.Sitemap{}
+		sitemapMock.On("ListEntries", mock.Anything, (*time.Time)(nil)).Return([]model.SitemapEntry{{
+			NewsId:      "news",
+			Title:       "title",
+			PublishedAt: time.Now(),
+			UpdatedAt:   time.Now(),
+			Listed:      true,
+		}}, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetSitemap(sitemap.NewCache(sitemapMock, sitemap.Options{
+				Site:    config.SiteConfig{BaseUrl: "https://tempo.test", NewsPath: "/news/%s", Name: "Tempo", Language: "en"},
+				Refresh: time.Hour,
+			}))
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/sitemaps/1.xml", nil, nil, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "application/xml; charset=utf-8", w.Header().Get("Content-Type"))
+		require.Contains(t, w.Body.String(), "<loc>https://tempo.test/news/news</loc>")
+
+		sitemapMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnNotFound_WhenChunkIsNotANumber", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/sitemaps/latest.xml", nil, nil, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
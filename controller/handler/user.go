@@ -9,9 +9,13 @@ import (
 	"tempo/model"
 	"tempo/usecase"
 
+	"context"
 	"errors"
+	"io"
 	"net/http"
+	"time"
 
+	"github.com/dgrijalva/jwt-go"
 	"github.com/gin-gonic/gin"
 )
 
@@ -79,6 +83,7 @@ func (w *User) Register(c *gin.Context) {
 // @Param 			body 	body 		request.User 			true 	" "
 // @Success 		200		{object}	response.Login			"Return the user model"
 // @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is suspended, or the email is not verified while it is required"
 // @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
 // @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
 // @Router /user/login [post]
@@ -117,18 +122,289 @@ func (w *User) Login(c *gin.Context) {
 		return
 	}
 
-	token, err := middleware.GenerateJwt(*res, config.JwtSecret)
+	token, err := middleware.GenerateJwt(*res, w.appContainer.JwtKeys(), time.Duration(config.Token.AccessSeconds)*time.Second)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	refreshTokenUseCase := usecase.NewRefreshToken(w.appContainer)
+	refreshToken, err := refreshTokenUseCase.Issue(c, res.Id)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error issue refresh token")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, response.Login{
+		Id:           res.Id,
+		JwtToken:     token,
+		RefreshToken: refreshToken,
+	})
+}
+
+// Refresh Token
+// @Summary 	Refresh Token
+// @Description Exchange the refresh token for a new jwt token and the next refresh token, a refresh token is only used once. Using it again revoke all the refresh tokens of the login
+// @Accept 			json
+// @Produce 		json
+// @Param 			body 	body 		request.TokenRefresh 	true 	" "
+// @Success 		200		{object}	response.Login			"Return the tokens"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When the refresh token is unknown, expired, revoked or already used"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is suspended"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Router /user/token/refresh [post]
+func (w *User) RefreshToken(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.RefreshToken")
+	config := w.appContainer.Config()
+
+	// Validation
+	var req request.TokenRefresh
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("missing required field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	refreshTokenUseCase := usecase.NewRefreshToken(w.appContainer)
+	res, refreshToken, err := refreshTokenUseCase.Refresh(c, *req.RefreshToken)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error refresh token")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	token, err := middleware.GenerateJwt(*res, w.appContainer.JwtKeys(), time.Duration(config.Token.AccessSeconds)*time.Second)
 	if err != nil {
 		response.WriteFailResponse(c, http.StatusInternalServerError, err)
 		return
 	}
 
 	response.WriteSuccessResponse(c, response.Login{
-		Id:       res.Id,
-		JwtToken: token,
+		Id:           res.Id,
+		JwtToken:     token,
+		RefreshToken: refreshToken,
 	})
 }
 
+// Verify Email
+// @Summary 	Verify Email
+// @Description Verify the email of the user with the token of the link sent to it
+// @Accept 			json
+// @Produce 		json
+// @Param 			body 	body 		request.EmailVerify 	true 	" "
+// @Success 		200		{object}	model.User				"Return the user model"
+// @Failure 		400 	{object}	response.ErrorResponse 	"When the token is invalid, expired or of a previous email"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Router /user/verify-email [post]
+func (w *User) VerifyEmail(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.VerifyEmail")
+
+	// Validation
+	var req request.EmailVerify
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("missing required field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	emailVerificationUseCase := usecase.NewEmailVerification(w.appContainer)
+	res, err := emailVerificationUseCase.Verify(c, *req.Token)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error verify email")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// Resend Verification Email
+// @Summary 	Resend Verification Email
+// @Description Send the verification email again. The response is the same whether the email is registered or not
+// @Accept 			json
+// @Produce 		json
+// @Param 			body 	body 		request.EmailVerificationResend 	true 	" "
+// @Success 		200		{object}	response.SuccessResponse
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		429 	{object}	response.ErrorResponse 	"When too many emails were sent to the address"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Router /user/verify-email/resend [post]
+func (w *User) ResendVerificationEmail(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.ResendVerificationEmail")
+
+	// Validation
+	var req request.EmailVerificationResend
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("missing required field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	emailVerificationUseCase := usecase.NewEmailVerification(w.appContainer)
+	err := emailVerificationUseCase.Resend(c, *req.Email)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error resend verification email")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, nil)
+}
+
+// ValidateToken reject the token revoked at a logout, of a suspended user, or issued before the password was reset.
+// The role of the token is replaced with the current one, so a demoted admin lose the access at once
+func (w *User) ValidateToken(ctx context.Context, claim *middleware.JWTData) error {
+	userUseCase := usecase.NewUser(w.appContainer)
+	return userUseCase.ValidateToken(ctx, &claim.User, claim.StandardClaims.Id, claim.IssuedTime())
+}
+
+// Logout
+// @Summary 	Logout
+// @Description Revoke the jwt token until it expires. When the refresh token of the login is given, it can no more be used either
+// @Accept 			json
+// @Produce 		json
+// @Param 			body 	body 		request.Logout 			false 	" "
+// @Success 		200		{object}	response.SuccessResponse
+// @Failure 		400 	{object}	response.ErrorResponse 	"When the request payload is invalid json"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /user/logout [post]
+func (w *User) Logout(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.Logout")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+	claims, err := middleware.GetJWTClaims(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation, the body is optional
+	var req request.Logout
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+			logger.WithError(err).Warning("bad request error")
+			response.WriteFailResponse(c, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	// Action
+	sessionUseCase := usecase.NewSession(w.appContainer)
+	err = sessionUseCase.Logout(c, *user.Id, revokedToken(user, claims), req.RefreshToken)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error logout")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, nil)
+}
+
+// Logout Everywhere
+// @Summary 	Logout Everywhere
+// @Description Revoke every jwt token and refresh token of the user, on every device
+// @Produce 		json
+// @Success 		200		{object}	response.SuccessResponse
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /user/logout/all [post]
+func (w *User) LogoutAll(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.LogoutAll")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+	claims, err := middleware.GetJWTClaims(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Action
+	sessionUseCase := usecase.NewSession(w.appContainer)
+	err = sessionUseCase.LogoutAll(c, *user.Id, revokedToken(user, claims))
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error logout all")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, nil)
+}
+
+func revokedToken(user model.User, claims jwt.StandardClaims) model.RevokedToken {
+	return model.RevokedToken{
+		Jti:       &claims.Id,
+		UserId:    user.Id,
+		ExpiresAt: helper.Pointer(time.Unix(claims.ExpiresAt, 0)),
+	}
+}
+
 // Updater User
 // @Summary 	Updater User
 // @Description Updater User, return the updated user
@@ -178,3 +454,147 @@ func (w *User) UpdateUser(c *gin.Context) {
 
 	response.WriteSuccessResponse(c, res)
 }
+
+// Change Password
+// @Summary 	Change Password
+// @Description Change the password of the user, the other tokens of the user are rejected after. The token used must be replaced by a new login
+// @Accept 			json
+// @Produce 		json
+// @Param 			body 	body 		request.UserPassword 	true 	" "
+// @Success 		200		{object}	model.User			"Return the user model"
+// @Failure 		400 	{object}	response.ErrorResponse 	"When the current password is invalid"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /user/password [put]
+func (w *User) ChangePassword(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.ChangePassword")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation
+	var req request.UserPassword
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	userUseCase := usecase.NewUser(w.appContainer)
+	res, err := userUseCase.ChangePassword(c, user.Id, *req.CurrentPassword, *req.NewPassword)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error change password")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// Forgot Password
+// @Summary 	Forgot Password
+// @Description Email a link to set a new password. The response is the same whether the email is registered or not
+// @Accept 			json
+// @Produce 		json
+// @Param 			body 	body 		request.PasswordForgot 	true 	" "
+// @Success 		200		{object}	response.SuccessResponse
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		429 	{object}	response.ErrorResponse 	"When too many resets were asked for the email or from the IP"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Router /user/password/forgot [post]
+func (w *User) ForgotPassword(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.ForgotPassword")
+
+	// Validation
+	var req request.PasswordForgot
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("missing required field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	passwordResetUseCase := usecase.NewPasswordReset(w.appContainer)
+	err := passwordResetUseCase.Forgot(c, *req.Email, c.ClientIP())
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error forgot password")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, nil)
+}
+
+// Reset Password
+// @Summary 	Reset Password
+// @Description Set a new password with the token of the emailed link, every session of the user is logged out
+// @Accept 			json
+// @Produce 		json
+// @Param 			body 	body 		request.PasswordReset 	true 	" "
+// @Success 		200		{object}	model.User				"Return the user model"
+// @Failure 		400 	{object}	response.ErrorResponse 	"When the token is invalid, expired or already used"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Router /user/password/reset [post]
+func (w *User) ResetPassword(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.ResetPassword")
+
+	// Validation
+	var req request.PasswordReset
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	passwordResetUseCase := usecase.NewPasswordReset(w.appContainer)
+	res, err := passwordResetUseCase.Reset(c, *req.Token, *req.NewPassword)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error reset password")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
@@ -0,0 +1,272 @@
+package handler
+
+import (
+	"tempo/container"
+	"tempo/controller/middleware"
+	"tempo/controller/request"
+	"tempo/controller/response"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/usecase"
+
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UserAdmin struct {
+	appContainer *container.Container
+}
+
+func NewUserAdmin(appContainer *container.Container) *UserAdmin {
+	return &UserAdmin{appContainer: appContainer}
+}
+
+// List Users
+// @Summary 	List Users
+// @Description List the users, the newest first. Admin only
+// @Produce 		json
+// @Param q query string false "part of the email or the full name"
+// @Param suspended query bool false "only the suspended users, or the active ones"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "page size, default 20, max 100"
+// @Success 		200		{object}	response.Page{data=[]model.User}	"Return the users"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not an admin"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /admin/users [get]
+func (w *UserAdmin) List(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.ListUsers")
+
+	// Validation
+	var req request.UserAdminList
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	userAdminUseCase := usecase.NewUserAdmin(w.appContainer)
+	res, next, err := userAdminUseCase.List(c, repository.UserListFilter{
+		Query:     req.Query,
+		Suspended: req.Suspended,
+		Limit:     req.Limit,
+	}, req.Cursor)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error list users")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, response.Page{
+		Data:       res,
+		NextCursor: next,
+	})
+}
+
+// Get User
+// @Summary 	Get User
+// @Description Get a user with the latest actions of the admins on the account. Admin only
+// @Produce 		json
+// @Param id path string true "user id"
+// @Success 		200		{object}	model.UserDetail		"Return the user"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not an admin"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the user is not found"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /admin/users/:id [get]
+func (w *UserAdmin) Get(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.GetUser")
+
+	// Action
+	id := c.Param("id")
+	userAdminUseCase := usecase.NewUserAdmin(w.appContainer)
+	res, err := userAdminUseCase.Get(c, &id)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error get user")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// Suspend User
+// @Summary 	Suspend User
+// @Description Suspend a user, who can not login and whose tokens are rejected until unsuspended. Admin only
+// @Accept 		json
+// @Produce 		json
+// @Param id path string true "user id"
+// @Param request body request.UserSuspension true "Request Body"
+// @Success 		200		{object}	model.User				"Return the user"
+// @Failure 		400 	{object}	response.ErrorResponse 	"When request is not valid"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not an admin"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the user is not found"
+// @Failure 		409 	{object}	response.ErrorResponse 	"When the user is already suspended"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /admin/users/:id/suspend [post]
+func (w *UserAdmin) Suspend(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.SuspendUser")
+
+	// auth
+	admin, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation
+	var req request.UserSuspension
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	id := c.Param("id")
+	userAdminUseCase := usecase.NewUserAdmin(w.appContainer)
+	res, err := userAdminUseCase.Suspend(c, &id, admin.Id, *req.Reason)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error suspend user")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// Unsuspend User
+// @Summary 	Unsuspend User
+// @Description Lift the suspension of a user. Admin only
+// @Accept 		json
+// @Produce 		json
+// @Param id path string true "user id"
+// @Param request body request.UserSuspension true "Request Body"
+// @Success 		200		{object}	model.User				"Return the user"
+// @Failure 		400 	{object}	response.ErrorResponse 	"When request is not valid"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not an admin"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the user is not found"
+// @Failure 		409 	{object}	response.ErrorResponse 	"When the user is not suspended"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /admin/users/:id/unsuspend [post]
+func (w *UserAdmin) Unsuspend(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.UnsuspendUser")
+
+	// auth
+	admin, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation
+	var req request.UserSuspension
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	id := c.Param("id")
+	userAdminUseCase := usecase.NewUserAdmin(w.appContainer)
+	res, err := userAdminUseCase.Unsuspend(c, &id, admin.Id, *req.Reason)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error unsuspend user")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// Reset User Password
+// @Summary 	Reset User Password
+// @Description Replace the password of a user by a random one and revoke their sessions, the user is emailed a link to choose a new password. Admin only
+// @Produce 		json
+// @Param id path string true "user id"
+// @Success 		200		{object}	model.User				"Return the user"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not an admin"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the user is not found"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /admin/users/:id/reset-password [post]
+func (w *UserAdmin) ResetPassword(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.ResetUserPassword")
+
+	// auth
+	admin, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Action
+	id := c.Param("id")
+	userAdminUseCase := usecase.NewUserAdmin(w.appContainer)
+	res, err := userAdminUseCase.ResetPassword(c, &id, admin.Id)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error reset user password")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
@@ -0,0 +1,181 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"tempo/container"
+	"tempo/controller/request"
+	"tempo/denylist"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserAdmin_List(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnErrorForbidden_WhenUserIsNotAdmin", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, _ := test.FakeJwtToken(t, nil)
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/admin/users", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("ShouldReturnUnauthorized_WhenUserIsSuspended", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Role = helper.Pointer(model.UserRoleAdmin)
+			user.SuspendedAt = helper.Pointer(time.Now())
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+
+		revokedTokenMock := &mocks.RevokedToken{}
+		revokedTokenMock.On("List", mock.Anything, mock.Anything, (*time.Time)(nil)).Return([]model.RevokedToken{}, nil).Once()
+		revokedTokenMock.On("DeleteExpired", mock.Anything, mock.Anything).Return(nil).Once()
+		userMock := &mocks.User{}
+		userMock.On("ListRestricted", mock.Anything, mock.Anything).Return([]model.User{fakeUser}, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetUserRepo(userMock)
+			appContainer.SetDenylist(denylist.New(revokedTokenMock, userMock, time.Hour, time.Hour))
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/admin/users", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+
+		revokedTokenMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnForbidden_WhenTheAdminWasDemoted", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Role = helper.Pointer(model.UserRoleAdmin)
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeUser)
+
+		revokedTokenMock := &mocks.RevokedToken{}
+		revokedTokenMock.On("List", mock.Anything, mock.Anything, (*time.Time)(nil)).Return([]model.RevokedToken{}, nil).Once()
+		revokedTokenMock.On("DeleteExpired", mock.Anything, mock.Anything).Return(nil).Once()
+		userMock := &mocks.User{}
+		userMock.On("ListRestricted", mock.Anything, mock.Anything).Return([]model.User{}, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetUserRepo(userMock)
+			appContainer.SetDenylist(denylist.New(revokedTokenMock, userMock, time.Hour, time.Hour))
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "GET", "/admin/users", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusForbidden, w.Code)
+
+		revokedTokenMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+}
+
+func TestUserAdmin_Suspend(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnUnprocessableEntity_WhenReasonIsMissing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeAdmin := test.FakeUser(t, func(user model.User) model.User {
+			user.Role = helper.Pointer(model.UserRoleAdmin)
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeAdmin)
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(request.UserSuspension{})
+		require.NoError(t, err)
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/admin/users/user/suspend", &buf, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("ShouldSuspendTheUser_WhenUserIsAdmin", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeAdmin := test.FakeUser(t, func(user model.User) model.User {
+			user.Role = helper.Pointer(model.UserRoleAdmin)
+			return user
+		})
+		token, _ := test.FakeJwtToken(t, &fakeAdmin)
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.SuspendedAt = helper.Pointer(time.Now())
+			user.SuspendedReason = helper.Pointer("spam")
+			user.SuspendedBy = fakeAdmin.Id
+			return user
+		})
+		var buf bytes.Buffer
+		err := json.NewEncoder(&buf).Encode(request.UserSuspension{Reason: helper.Pointer("spam")})
+		require.NoError(t, err)
+
+		userMock := &mocks.User{}
+		userMock.On("Suspend", mock.Anything, *fakeUser.Id, *fakeAdmin.Id, "spam").Return(&fakeUser, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetUserRepo(userMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/admin/users/"+*fakeUser.Id+"/suspend", &buf, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		var res model.User
+		err = json.NewDecoder(w.Body).Decode(&res)
+		require.NoError(t, err)
+		require.Equal(t, "spam", *res.SuspendedReason)
+
+		userMock.AssertExpectations(t)
+	})
+}
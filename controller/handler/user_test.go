@@ -2,19 +2,27 @@ package handler_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
 	"tempo/container"
 	"tempo/controller/request"
 	"tempo/controller/response"
+	"tempo/denylist"
+	"tempo/event"
 	"tempo/helper"
 	"tempo/helper/test"
 	"tempo/model"
+	"tempo/password"
 	"tempo/repository"
 	"tempo/repository/mocks"
+	"tempo/usecase"
 
 	"github.com/icrowley/fake"
 	"github.com/stretchr/testify/mock"
@@ -71,8 +79,10 @@ func TestUser_Register(t *testing.T) {
 			require.Equal(t, *fakeUser.Email, *u.Email)
 			require.Equal(t, *fakeUser.FullName, *u.FullName)
 
-			password := helper.Pointer(helper.Hash(*u.PasswordSalt, *fakeUser.Password))
-			require.Equal(t, *password, *u.Password)
+			ok, err := password.Argon2id{}.Verify(*fakeUser.Password, *u.Password)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Nil(t, u.PasswordSalt)
 			return true
 		})).Return(nil, errors.New("error insert")).Once()
 
@@ -120,8 +130,10 @@ func TestUser_Register(t *testing.T) {
 			require.Equal(t, *fakeUser.Email, *u.Email)
 			require.Equal(t, *fakeUser.FullName, *u.FullName)
 
-			password := helper.Pointer(helper.Hash(*u.PasswordSalt, *fakeUser.Password))
-			require.Equal(t, *password, *u.Password)
+			ok, err := password.Argon2id{}.Verify(*fakeUser.Password, *u.Password)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Nil(t, u.PasswordSalt)
 			return true
 		})).Return(&fakeUser, nil).Once()
 
@@ -227,9 +239,16 @@ func TestUser_Login(t *testing.T) {
 		userMock.On("Get", mock.Anything, repository.UserGetFilter{
 			Email: fakeUser.Email,
 		}).Return(&fakeUser, nil).Once()
+		// the legacy SHA-1 hash is upgraded at the login
+		userMock.On("UpgradePassword", mock.Anything, *fakeUser.Id, *fakeUser.Password, mock.Anything).Return(nil).Once()
+		refreshTokenMock := &mocks.RefreshToken{}
+		refreshTokenMock.On("Add", mock.Anything, mock.MatchedBy(func(token *model.RefreshToken) bool {
+			return *token.UserId == *fakeUser.Id && token.FamilyId == nil
+		})).Return(&model.RefreshToken{}, nil).Once()
 
 		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
 			appContainer.SetUserRepo(userMock)
+			appContainer.SetRefreshTokenRepo(refreshTokenMock)
 			return appContainer
 		})
 
@@ -240,6 +259,7 @@ func TestUser_Login(t *testing.T) {
 
 		// EXPECTATION
 		require.Equal(t, http.StatusOK, w.Code)
+		userMock.AssertExpectations(t)
 
 		resBody := response.Login{}
 		err = json.NewDecoder(w.Body).Decode(&resBody)
@@ -247,6 +267,487 @@ func TestUser_Login(t *testing.T) {
 
 		require.Equal(t, *fakeUser.Id, *resBody.Id)
 		require.NotNil(t, resBody.JwtToken)
+		require.NotNil(t, resBody.RefreshToken)
+		refreshTokenMock.AssertExpectations(t)
+	})
+}
+
+func TestUser_RefreshToken(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnError_WhenRefreshTokenIsMissing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/user/token/refresh", strings.NewReader(`{}`), nil, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("ShouldReturnErrorUnauthenticated_WhenRefreshTokenIsReused", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		used := model.RefreshToken{
+			Id:        helper.Pointer("used"),
+			UserId:    helper.Pointer("user"),
+			FamilyId:  helper.Pointer("family"),
+			ExpiresAt: helper.Pointer(time.Now().Add(time.Hour)),
+			UsedAt:    helper.Pointer(time.Now()),
+		}
+		refreshTokenMock := &mocks.RefreshToken{}
+		refreshTokenMock.On("GetByHash", mock.Anything, helper.Sha256("token")).Return(&used, nil).Once()
+		refreshTokenMock.On("RevokeFamily", mock.Anything, "family").Return(nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetRefreshTokenRepo(refreshTokenMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/user/token/refresh", strings.NewReader(`{"refresh_token":"token"}`), nil, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+		refreshTokenMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnTheNextTokens", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, nil)
+		current := model.RefreshToken{
+			Id:        helper.Pointer("current"),
+			UserId:    fakeUser.Id,
+			FamilyId:  helper.Pointer("family"),
+			ExpiresAt: helper.Pointer(time.Now().Add(time.Hour)),
+			CreatedAt: helper.Pointer(time.Now()),
+		}
+		refreshTokenMock := &mocks.RefreshToken{}
+		refreshTokenMock.On("GetByHash", mock.Anything, helper.Sha256("token")).Return(&current, nil).Once()
+		refreshTokenMock.On("Rotate", mock.Anything, "current", mock.Anything).Return(&model.RefreshToken{}, nil).Once()
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Id: fakeUser.Id}).Return(&fakeUser, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetUserRepo(userMock)
+			appContainer.SetRefreshTokenRepo(refreshTokenMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/user/token/refresh", strings.NewReader(`{"refresh_token":"token"}`), nil, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+
+		resBody := response.Login{}
+		err = json.NewDecoder(w.Body).Decode(&resBody)
+		require.NoError(t, err)
+
+		require.Equal(t, *fakeUser.Id, *resBody.Id)
+		require.NotNil(t, resBody.JwtToken)
+		require.NotNil(t, resBody.RefreshToken)
+		require.NotEqual(t, "token", *resBody.RefreshToken)
+		refreshTokenMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+}
+
+func TestUser_Logout(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldRejectTheToken_AfterTheLogout", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, fakeUser := test.FakeJwtToken(t, nil)
+		revokedTokenMock := &mocks.RevokedToken{}
+		revokedTokenMock.On("List", mock.Anything, mock.Anything, (*time.Time)(nil)).Return([]model.RevokedToken{}, nil).Once()
+		revokedTokenMock.On("DeleteExpired", mock.Anything, mock.Anything).Return(nil).Once()
+		revokedTokenMock.On("Add", mock.Anything, mock.MatchedBy(func(revoked *model.RevokedToken) bool {
+			return *revoked.Jti != "" && *revoked.UserId == *fakeUser.Id && revoked.ExpiresAt.After(time.Now())
+		})).Return(nil).Once()
+		userMock := &mocks.User{}
+		userMock.On("ListRestricted", mock.Anything, mock.Anything).Return([]model.User{}, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetDenylist(denylist.New(revokedTokenMock, userMock, time.Hour, time.Hour))
+			return appContainer
+		})
+		headers := map[string]string{
+			"Authorization": "Bearer " + token,
+		}
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/user/logout", nil, headers, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+		again, err := performRequest(router, "POST", "/user/logout", nil, headers, nil)
+		require.NoError(t, err)
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, http.StatusUnauthorized, again.Code)
+		revokedTokenMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldRevokeTheRefreshTokens_WhenRefreshTokenIsGiven", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, fakeUser := test.FakeJwtToken(t, nil)
+		revokedTokenMock := &mocks.RevokedToken{}
+		revokedTokenMock.On("List", mock.Anything, mock.Anything, (*time.Time)(nil)).Return([]model.RevokedToken{}, nil).Once()
+		revokedTokenMock.On("DeleteExpired", mock.Anything, mock.Anything).Return(nil).Once()
+		revokedTokenMock.On("Add", mock.Anything, mock.Anything).Return(nil).Once()
+		refreshTokenMock := &mocks.RefreshToken{}
+		refreshTokenMock.On("GetByHash", mock.Anything, helper.Sha256("refresh")).Return(&model.RefreshToken{
+			UserId:   fakeUser.Id,
+			FamilyId: helper.Pointer("family"),
+		}, nil).Once()
+		refreshTokenMock.On("RevokeFamily", mock.Anything, "family").Return(nil).Once()
+		userMock := &mocks.User{}
+		userMock.On("ListRestricted", mock.Anything, mock.Anything).Return([]model.User{}, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetDenylist(denylist.New(revokedTokenMock, userMock, time.Hour, time.Hour))
+			appContainer.SetRefreshTokenRepo(refreshTokenMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/user/logout", strings.NewReader(`{"refresh_token":"refresh"}`), map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		revokedTokenMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+		refreshTokenMock.AssertExpectations(t)
+	})
+}
+
+func TestUser_LogoutAll(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldRevokeEveryTokenOfTheUser", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, fakeUser := test.FakeJwtToken(t, nil)
+		revokedTokenMock := &mocks.RevokedToken{}
+		revokedTokenMock.On("List", mock.Anything, mock.Anything, (*time.Time)(nil)).Return([]model.RevokedToken{}, nil).Once()
+		revokedTokenMock.On("DeleteExpired", mock.Anything, mock.Anything).Return(nil).Once()
+		revokedTokenMock.On("Add", mock.Anything, mock.Anything).Return(nil).Once()
+		refreshTokenMock := &mocks.RefreshToken{}
+		refreshTokenMock.On("RevokeUser", mock.Anything, *fakeUser.Id).Return(nil).Once()
+		userMock := &mocks.User{}
+		userMock.On("ListRestricted", mock.Anything, mock.Anything).Return([]model.User{}, nil).Once()
+		userMock.On("RevokeTokens", mock.Anything, *fakeUser.Id).Return(nil).Once()
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Id: fakeUser.Id}).Return(&model.User{
+			Id:               fakeUser.Id,
+			TokensValidAfter: helper.Pointer(time.Now()),
+		}, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetDenylist(denylist.New(revokedTokenMock, userMock, time.Hour, time.Hour))
+			appContainer.SetRefreshTokenRepo(refreshTokenMock)
+			appContainer.SetUserRepo(userMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/user/logout/all", nil, map[string]string{
+			"Authorization": "Bearer " + token,
+		}, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		revokedTokenMock.AssertExpectations(t)
+		refreshTokenMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+}
+
+func TestUser_VerifyEmail(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnErrorUnprocessableEntity_WhenTokenIsMissing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/user/verify-email", strings.NewReader(`{}`), nil, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("ShouldReturnErrorBadRequest_WhenTokenIsInvalid", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/user/verify-email", strings.NewReader(`{"token":"invalid.token"}`), nil, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("ShouldVerifyTheEmail_WhenTokenIsTheMailedOne", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, nil)
+		verified := fakeUser
+		verified.EmailVerifiedAt = helper.Pointer(time.Now())
+		userMock := &mocks.User{}
+		userMock.On("VerifyEmail", mock.Anything, *fakeUser.Id, *fakeUser.Email).Return(&verified, nil).Once()
+
+		mailer := &test.Mailer{}
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetUserRepo(userMock)
+			appContainer.SetMailer(mailer)
+			require.NoError(t, usecase.NewEmailVerification(appContainer).Send(context.Background(), &fakeUser))
+			return appContainer
+		})
+		require.Len(t, mailer.Messages(), 1)
+		_, link, _ := strings.Cut(mailer.Messages()[0].Body, "token=")
+		token, err := url.QueryUnescape(strings.Fields(link)[0])
+		require.NoError(t, err)
+		reqBody, err := json.Marshal(request.EmailVerify{Token: &token})
+		require.NoError(t, err)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/user/verify-email", bytes.NewReader(reqBody), nil, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		var res model.User
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.True(t, res.IsEmailVerified())
+
+		userMock.AssertExpectations(t)
+	})
+}
+
+func TestUser_ResendVerificationEmail(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnErrorTooManyRequests_WhenTheLimitIsExceeded", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		rateLimitMock := &mocks.RateLimit{}
+		rateLimitMock.On("Hit", mock.Anything, mock.Anything, mock.Anything).Return(100, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetRateLimitRepo(rateLimitMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/user/verify-email/resend", strings.NewReader(`{"email":"email@gmail.com"}`), nil, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusTooManyRequests, w.Code)
+		rateLimitMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnSuccess_WhenEmailIsUnknown", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		rateLimitMock := &mocks.RateLimit{}
+		rateLimitMock.On("Hit", mock.Anything, mock.Anything, mock.Anything).Return(1, nil).Once()
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Email: helper.Pointer("email@gmail.com")}).
+			Return(nil, model.NewNotFoundError()).Once()
+
+		mailer := &test.Mailer{}
+		bus := event.NewAsyncBus(1, 10)
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetRateLimitRepo(rateLimitMock)
+			appContainer.SetUserRepo(userMock)
+			appContainer.SetMailer(mailer)
+			appContainer.SetEventBus(bus)
+			usecase.NewEmailVerification(appContainer).Subscribe(bus)
+			return appContainer
+		})
+		bus.Start()
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/user/verify-email/resend", strings.NewReader(`{"email":"email@gmail.com"}`), nil, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+		bus.Close()
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Empty(t, mailer.Messages())
+		rateLimitMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+}
+
+func TestUser_ForgotPassword(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnErrorUnprocessableEntity_WhenEmailIsInvalid", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/user/password/forgot", strings.NewReader(`{"email":"email"}`), nil, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("ShouldReturnSuccess_WhenEmailIsUnknown", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		rateLimitMock := &mocks.RateLimit{}
+		rateLimitMock.On("Hit", mock.Anything, mock.Anything, mock.Anything).Return(1, nil).Twice()
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Email: helper.Pointer("email@gmail.com")}).
+			Return(nil, model.NewNotFoundError()).Once()
+
+		mailer := &test.Mailer{}
+		bus := event.NewAsyncBus(1, 10)
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetRateLimitRepo(rateLimitMock)
+			appContainer.SetUserRepo(userMock)
+			appContainer.SetMailer(mailer)
+			appContainer.SetEventBus(bus)
+			usecase.NewPasswordReset(appContainer).Subscribe(bus)
+			return appContainer
+		})
+		bus.Start()
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/user/password/forgot", strings.NewReader(`{"email":"email@gmail.com"}`), nil, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+		bus.Close()
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Empty(t, mailer.Messages())
+		rateLimitMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnErrorTooManyRequests_WhenTheLimitIsExceeded", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		rateLimitMock := &mocks.RateLimit{}
+		rateLimitMock.On("Hit", mock.Anything, mock.Anything, mock.Anything).Return(100, nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetRateLimitRepo(rateLimitMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/user/password/forgot", strings.NewReader(`{"email":"email@gmail.com"}`), nil, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusTooManyRequests, w.Code)
+		rateLimitMock.AssertExpectations(t)
+	})
+}
+
+func TestUser_ResetPassword(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnErrorUnprocessableEntity_WhenNewPasswordIsTooShort", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		router := test.SetupHttpHandler(t, nil)
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/user/password/reset", strings.NewReader(`{"token":"token","new_password":"short"}`), nil, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("ShouldReturnErrorBadRequest_WhenTokenIsUnknown", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		passwordResetMock := &mocks.PasswordReset{}
+		passwordResetMock.On("GetByHash", mock.Anything, helper.Sha256("token")).Return(nil, model.NewNotFoundError()).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetPasswordResetRepo(passwordResetMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/user/password/reset", strings.NewReader(`{"token":"token","new_password":"new password"}`), nil, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		passwordResetMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldSetTheNewPassword", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, nil)
+		passwordResetMock := &mocks.PasswordReset{}
+		passwordResetMock.On("GetByHash", mock.Anything, helper.Sha256("token")).Return(&model.PasswordReset{
+			Id:        helper.Pointer("reset"),
+			UserId:    fakeUser.Id,
+			ExpiresAt: helper.Pointer(time.Now().Add(time.Minute)),
+		}, nil).Once()
+		passwordResetMock.On("Use", mock.Anything, "reset").Return(nil).Once()
+		userMock := &mocks.User{}
+		userMock.On("SetPassword", mock.Anything, *fakeUser.Id, mock.Anything, (*string)(nil)).Return(&fakeUser, nil).Once()
+		refreshTokenMock := &mocks.RefreshToken{}
+		refreshTokenMock.On("RevokeUser", mock.Anything, *fakeUser.Id).Return(nil).Once()
+
+		router := test.SetupHttpHandler(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetPasswordResetRepo(passwordResetMock)
+			appContainer.SetUserRepo(userMock)
+			appContainer.SetRefreshTokenRepo(refreshTokenMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		w, err := performRequest(router, "POST", "/user/password/reset", strings.NewReader(`{"token":"token","new_password":"new password"}`), nil, nil)
+		require.NoError(t, err)
+		defer printOnFailed(t)(w.Body.String())
+
+		// EXPECTATION
+		require.Equal(t, http.StatusOK, w.Code)
+		passwordResetMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+		refreshTokenMock.AssertExpectations(t)
 	})
 }
 
@@ -308,6 +809,9 @@ func TestUser_UpdateUser(t *testing.T) {
 		require.NoError(t, err)
 
 		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{
+			Id: fakeUser.Id,
+		}).Return(&fakeUser, nil).Once()
 		userMock.On("Get", mock.Anything, repository.UserGetFilter{
 			Email: fakeUser.Email,
 		}).Return(&fakeUser, nil).Once()
@@ -348,6 +852,9 @@ func TestUser_UpdateUser(t *testing.T) {
 		require.NoError(t, err)
 
 		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{
+			Id: fakeUser.Id,
+		}).Return(&fakeUser, nil).Once()
 		userMock.On("Get", mock.Anything, repository.UserGetFilter{
 			Email: fakeUser.Email,
 		}).Return(&fakeUser, nil).Once()
@@ -0,0 +1,318 @@
+package handler
+
+import (
+	"tempo/container"
+	"tempo/controller/middleware"
+	"tempo/controller/request"
+	"tempo/controller/response"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/usecase"
+
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Webhook struct {
+	appContainer *container.Container
+}
+
+func NewWebhook(appContainer *container.Container) *Webhook {
+	return &Webhook{appContainer: appContainer}
+}
+
+// Add Webhook
+// @Summary 	Add Webhook
+// @Description Register an endpoint receiving the subscribed events, deliveries are signed with the X-Tempo-Signature header. Admin only
+// @Accept 		json
+// @Produce 		json
+// @Param request body request.Webhook true "Request Body"
+// @Success 		200		{object}	model.Webhook			"Return the webhook, the secret is only returned here"
+// @Failure 		400 	{object}	response.ErrorResponse 	"When request is not valid"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not an admin"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /webhooks [post]
+func (w *Webhook) Add(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.AddWebhook")
+
+	// auth
+	user, err := middleware.GetJWTData(c)
+	if err != nil {
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Validation
+	var req request.Webhook
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	// Action
+	webhookUseCase := usecase.NewWebhook(w.appContainer)
+	res, err := webhookUseCase.Add(c, &model.Webhook{
+		Url:        req.Url,
+		EventTypes: req.EventTypes,
+		Secret:     req.Secret,
+		CreatedBy:  user.Id,
+	})
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error add webhook")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// List Webhooks
+// @Summary 	List Webhooks
+// @Description List the registered webhooks. Admin only
+// @Produce 		json
+// @Success 		200		{object}	[]model.Webhook			"Return the webhooks"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not an admin"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /webhooks [get]
+func (w *Webhook) List(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.ListWebhook")
+
+	// Action
+	webhookUseCase := usecase.NewWebhook(w.appContainer)
+	res, err := webhookUseCase.List(c)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error list webhook")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// Get Webhook
+// @Summary 	Get Webhook
+// @Description Get a webhook. Admin only
+// @Produce 		json
+// @Param id path string true "webhook id"
+// @Success 		200		{object}	model.Webhook			"Return the webhook"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not an admin"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the webhook is not found"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /webhooks/:id [get]
+func (w *Webhook) Get(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.GetWebhook")
+
+	// Action
+	id := c.Param("id")
+	webhookUseCase := usecase.NewWebhook(w.appContainer)
+	res, err := webhookUseCase.Get(c, &id)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error get webhook")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// Update Webhook
+// @Summary 	Update Webhook
+// @Description Update a webhook, setting active to true re-enable it after it was disabled for failing. Admin only
+// @Accept 		json
+// @Produce 		json
+// @Param id path string true "webhook id"
+// @Param request body request.Webhook true "Request Body"
+// @Success 		200		{object}	model.Webhook			"Return the webhook"
+// @Failure 		400 	{object}	response.ErrorResponse 	"When request is not valid"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not an admin"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the webhook is not found"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /webhooks/:id [put]
+func (w *Webhook) Update(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.UpdateWebhook")
+
+	// Validation
+	var req request.Webhook
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	// Action
+	id := c.Param("id")
+	webhookUseCase := usecase.NewWebhook(w.appContainer)
+	res, err := webhookUseCase.Update(c, &id, &model.Webhook{
+		Url:        req.Url,
+		EventTypes: req.EventTypes,
+		Secret:     req.Secret,
+		Active:     req.Active,
+	})
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error update webhook")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
+
+// Delete Webhook
+// @Summary 	Delete Webhook
+// @Description Delete a webhook and its delivery log. Admin only
+// @Produce 		json
+// @Param id path string true "webhook id"
+// @Success 		200		{object}	response.SuccessResponse
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not an admin"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the webhook is not found"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /webhooks/:id [delete]
+func (w *Webhook) Delete(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.DeleteWebhook")
+
+	// Action
+	id := c.Param("id")
+	webhookUseCase := usecase.NewWebhook(w.appContainer)
+	err := webhookUseCase.Delete(c, &id)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error delete webhook")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, nil)
+}
+
+// List Webhook Deliveries
+// @Summary 	List Webhook Deliveries
+// @Description List the delivery log of a webhook, newest first. Admin only
+// @Produce 		json
+// @Param id path string true "webhook id"
+// @Param status query string false "pending, succeeded or failed"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "page size, default 20, max 100"
+// @Success 		200		{object}	response.Page{data=[]model.WebhookDelivery}	"Return the deliveries"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not an admin"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the webhook is not found"
+// @Failure 		422 	{object}	response.ErrorResponse 	"When request validation failed"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /webhooks/:id/deliveries [get]
+func (w *Webhook) ListDeliveries(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.ListWebhookDeliveries")
+
+	// Validation
+	var req request.WebhookDeliveryList
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.WithError(err).Warning("bad request error")
+		response.WriteFailResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("invalid field")
+		response.WriteFailResponse(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Action
+	id := c.Param("id")
+	webhookUseCase := usecase.NewWebhook(w.appContainer)
+	res, next, err := webhookUseCase.ListDeliveries(c, repository.WebhookDeliveryListFilter{
+		WebhookId: &id,
+		Status:    req.Status,
+		Limit:     req.Limit,
+	}, req.Cursor)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error list webhook deliveries")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, response.Page{
+		Data:       res,
+		NextCursor: next,
+	})
+}
+
+// Redeliver Webhook Delivery
+// @Summary 	Redeliver Webhook Delivery
+// @Description Send the payload of a past delivery again as a new delivery. Admin only
+// @Produce 		json
+// @Param id path string true "webhook id"
+// @Param deliveryId path string true "delivery id"
+// @Success 		200		{object}	model.WebhookDelivery	"Return the new delivery with the outcome of its first attempt"
+// @Failure 		401 	{object}	response.ErrorResponse 	"When	the auth token is missing or invalid"
+// @Failure 		403 	{object}	response.ErrorResponse 	"When the user is not an admin"
+// @Failure 		404 	{object}	response.ErrorResponse 	"When the webhook or delivery is not found"
+// @Failure 		500 	{object}	response.ErrorResponse 	"When server encountered unhandled error"
+// @Security 		BearerAuth
+// @Router /webhooks/:id/deliveries/:deliveryId/redeliver [post]
+func (w *Webhook) Redeliver(c *gin.Context) {
+	logger := helper.GetLogger(c).WithField("method", "Controller.Handler.RedeliverWebhook")
+
+	// Action
+	id := c.Param("id")
+	deliveryId := c.Param("deliveryId")
+	webhookUseCase := usecase.NewWebhook(w.appContainer)
+	res, err := webhookUseCase.Redeliver(c, &id, &deliveryId)
+	if err != nil {
+		var e model.Error
+		if !errors.As(err, &e) {
+			logger.WithError(err).Warning("error redeliver webhook")
+			response.WriteFailResponse(c, http.StatusInternalServerError, err)
+		} else {
+			response.WriteFailResponse(c, e.Code, e)
+		}
+		return
+	}
+
+	response.WriteSuccessResponse(c, res)
+}
@@ -5,6 +5,7 @@ import (
 	"tempo/container"
 	"tempo/controller/handler"
 	"tempo/controller/middleware"
+	"tempo/jwtkey"
 
 	"fmt"
 	"net/http"
@@ -21,13 +22,29 @@ type HttpServer interface {
 
 type httpServer struct {
 	config      config.Config
+	jwtKeys     *jwtkey.Keyring
 	engine      *gin.Engine
 	controllers controllers
 }
 
 type controllers struct {
-	user handler.User
-	news handler.News
+	user         handler.User
+	news         handler.News
+	bookmark     handler.Bookmark
+	follow       handler.Follow
+	notification handler.Notification
+	webhook      handler.Webhook
+	graphql      handler.Graphql
+	moderation   handler.Moderation
+	featured     handler.Featured
+	collection   handler.Collection
+	newsAuthor   handler.NewsAuthor
+	newsReport   handler.NewsReport
+	userAdmin    handler.UserAdmin
+	sitemap      handler.Sitemap
+	share        handler.Share
+	archive      handler.Archive
+	jwks         handler.Jwks
 }
 
 func NewHttpServer(container *container.Container) *httpServer {
@@ -41,8 +58,23 @@ func NewHttpServer(container *container.Container) *httpServer {
 	controllers := controllers{
 		*handler.NewUser(container),
 		*handler.NewNews(container),
+		*handler.NewBookmark(container),
+		*handler.NewFollow(container),
+		*handler.NewNotification(container),
+		*handler.NewWebhook(container),
+		*handler.NewGraphql(container),
+		*handler.NewModeration(container),
+		*handler.NewFeatured(container),
+		*handler.NewCollection(container),
+		*handler.NewNewsAuthor(container),
+		*handler.NewNewsReport(container),
+		*handler.NewUserAdmin(container),
+		*handler.NewSitemap(container),
+		*handler.NewShare(container),
+		*handler.NewArchive(container),
+		*handler.NewJwks(container),
 	}
-	requestHandler := &httpServer{container.Config(), engine, controllers}
+	requestHandler := &httpServer{container.Config(), container.JwtKeys(), engine, controllers}
 	requestHandler.setupRouting()
 
 	return requestHandler
@@ -13,6 +13,7 @@ import (
 
 	"tempo/controller/response"
 	"tempo/helper"
+	"tempo/jwtkey"
 	"tempo/model"
 
 	"github.com/dgrijalva/jwt-go"
@@ -23,9 +24,24 @@ import (
 type JWTData struct {
 	jwt.StandardClaims
 	model.User
+	// IssuedAtMicro is the issued at in microseconds, telling apart the tokens issued before and after a revocation in
+	// the same second
+	IssuedAtMicro int64 `json:"iat_us,omitempty"`
 }
 
-func NewHmacJwtMiddleware(secretKey []byte) gin.HandlerFunc {
+// IssuedTime return when the token was issued, to the second for the tokens issued without IssuedAtMicro
+func (j JWTData) IssuedTime() time.Time {
+	if j.IssuedAtMicro != 0 {
+		return time.UnixMicro(j.IssuedAtMicro)
+	}
+	return time.Unix(j.IssuedAt, 0)
+}
+
+// TokenValidator reject a well signed token that can no longer be used, like the one of a suspended user
+type TokenValidator func(ctx context.Context, claim *JWTData) error
+
+// NewJwtMiddleware reject the requests without a token verified by the keys
+func NewJwtMiddleware(keys *jwtkey.Keyring, validators ...TokenValidator) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		requestID := ksuid.New().String()
 		ctxWithRequestID := context.WithValue(c.Request.Context(), helper.ContextKeyRequestId, requestID)
@@ -44,31 +60,63 @@ func NewHmacJwtMiddleware(secretKey []byte) gin.HandlerFunc {
 			response.WriteFailResponse(c, http.StatusUnauthorized, errors.New("missing bearer token"))
 			return
 		}
-		claim, err := decodeHmacJwtData(secretKey, *bearer)
-		if err != nil {
-			c.Abort()
-			response.WriteFailResponse(c, http.StatusUnauthorized, err)
+
+		authenticate(c, ctxWithRequestID, requestID, keys, *bearer, validators)
+	}
+}
+
+// NewOptionalJwtMiddleware let the requests without bearer token through as anonymous, a token that is sent must be valid
+func NewOptionalJwtMiddleware(keys *jwtkey.Keyring, validators ...TokenValidator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := ksuid.New().String()
+		ctxWithRequestID := context.WithValue(c.Request.Context(), helper.ContextKeyRequestId, requestID)
+
+		bearer := getBearerAuth(c.Request)
+		if bearer == nil {
+			c.Set(string(helper.ContextKeyRequestId), requestID)
+			c.Request = c.Request.WithContext(ctxWithRequestID)
+			c.Next()
 			return
 		}
-		if err = claim.Validate(); err != nil {
+
+		authenticate(c, ctxWithRequestID, requestID, keys, *bearer, validators)
+	}
+}
+
+func authenticate(c *gin.Context, ctx context.Context, requestID string, keys *jwtkey.Keyring, bearer string, validators []TokenValidator) {
+	claim, err := ParseJwt(keys, bearer)
+	if err != nil {
+		c.Abort()
+		response.WriteFailResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+	for _, validate := range validators {
+		if err = validate(ctx, claim); err != nil {
 			c.Abort()
-			response.WriteFailResponse(c, http.StatusUnauthorized, err)
+			var e model.Error
+			if !errors.As(err, &e) {
+				helper.GetLogger(ctx).WithError(err).Warning("error validate token")
+				response.WriteFailResponse(c, http.StatusInternalServerError, err)
+			} else {
+				response.WriteFailResponse(c, http.StatusUnauthorized, err)
+			}
 			return
 		}
-		c.Set(string(helper.ContextKeyJwtData), claim.User)
-		c.Set(string(helper.ContextKeyTokenBearer), *bearer)
-		c.Set(string(helper.ContextKeyRequestId), requestID)
-
-		savedCtx := c.Request.Context()
-		defer func() {
-			c.Request = c.Request.WithContext(savedCtx)
-		}()
-		ctxWithJwt := context.WithValue(ctxWithRequestID, helper.ContextKeyJwtData, claim.User)
-		ctxWithToken := context.WithValue(ctxWithJwt, helper.ContextKeyTokenBearer, *bearer)
-		c.Request = c.Request.WithContext(ctxWithToken)
-
-		c.Next()
 	}
+	c.Set(string(helper.ContextKeyJwtData), claim.User)
+	c.Set(string(helper.ContextKeyJwtClaims), claim.StandardClaims)
+	c.Set(string(helper.ContextKeyTokenBearer), bearer)
+	c.Set(string(helper.ContextKeyRequestId), requestID)
+
+	savedCtx := c.Request.Context()
+	defer func() {
+		c.Request = c.Request.WithContext(savedCtx)
+	}()
+	ctxWithJwt := context.WithValue(ctx, helper.ContextKeyJwtData, claim.User)
+	ctxWithToken := context.WithValue(ctxWithJwt, helper.ContextKeyTokenBearer, bearer)
+	c.Request = c.Request.WithContext(ctxWithToken)
+
+	c.Next()
 }
 
 func GetJWTData(c *gin.Context) (model.User, error) {
@@ -79,6 +127,15 @@ func GetJWTData(c *gin.Context) (model.User, error) {
 	return claim.(model.User), nil
 }
 
+// GetJWTClaims return the standard claims of the token, like its jti and expiry
+func GetJWTClaims(c *gin.Context) (jwt.StandardClaims, error) {
+	claims, ok := c.Get(string(helper.ContextKeyJwtClaims))
+	if !ok {
+		return jwt.StandardClaims{}, model.NewNotFoundError()
+	}
+	return claims.(jwt.StandardClaims), nil
+}
+
 func getBearerAuth(r *http.Request) *string {
 	authHeader := r.Header.Get("Authorization")
 	authForm := r.Form.Get("code")
@@ -99,17 +156,24 @@ func getBearerAuth(r *http.Request) *string {
 	return &token
 }
 
-func decodeHmacJwtData(hmacSecret []byte, tokenStr string) (*JWTData, error) {
-	var claim JWTData
-
-	secretFn := func(token *jwt.Token) (interface{}, error) {
-		if _, validSignMethod := token.Method.(*jwt.SigningMethodHMAC); !validSignMethod {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return hmacSecret, nil
+// ParseJwt decode the token and validate its claims
+func ParseJwt(keys *jwtkey.Keyring, tokenStr string) (*JWTData, error) {
+	claim, err := decodeJwtData(keys, tokenStr)
+	if err != nil {
+		return nil, err
+	}
+	if err = claim.Validate(); err != nil {
+		return nil, err
 	}
 
-	token, err := jwt.ParseWithClaims(tokenStr, &claim, secretFn)
+	return claim, nil
+}
+
+// decodeJwtData verify the token with the key of its kid, and only with the algorithm of the key
+func decodeJwtData(keys *jwtkey.Keyring, tokenStr string) (*JWTData, error) {
+	var claim JWTData
+
+	token, err := jwt.ParseWithClaims(tokenStr, &claim, keys.Keyfunc)
 	if err != nil {
 		return nil, err
 	}
@@ -135,10 +199,14 @@ func GetToken(c context.Context) (token string, ok bool) {
 	return
 }
 
-func GenerateJwt(user model.User, secretKey string) (*string, error) {
+// GenerateJwt return an access token of the user signed by the keys and valid for the lifetime, renewed with a
+// refresh token once expired
+func GenerateJwt(user model.User, keys *jwtkey.Keyring, lifetime time.Duration) (*string, error) {
+	now := time.Now()
 	stdClaims := jwt.StandardClaims{
 		Id:        ksuid.New().String(),
-		ExpiresAt: time.Now().Add(300 * time.Second).Unix(),
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(lifetime).Unix(),
 		Subject:   *user.Id,
 	}
 
@@ -146,10 +214,9 @@ func GenerateJwt(user model.User, secretKey string) (*string, error) {
 	accessClaims := JWTData{
 		StandardClaims: stdClaims,
 		User:           user,
+		IssuedAtMicro:  now.UnixMicro(),
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims)
-	var byteSecret = []byte(secretKey)
-	accessToken, err := token.SignedString(byteSecret)
+	accessToken, err := keys.Sign(accessClaims)
 	if err != nil {
 		return nil, err
 	}
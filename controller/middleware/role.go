@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+
+	"tempo/controller/response"
+	"tempo/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireRole reject the request unless the authenticated user has one of the roles, it must run after the jwt middleware
+func RequireRole(roles ...model.UserRole) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, err := GetJWTData(c)
+		if err != nil {
+			c.Abort()
+			response.WriteFailResponse(c, http.StatusUnauthorized, err)
+			return
+		}
+
+		if !user.HasRole(roles...) {
+			e := model.NewUnauthorizedError()
+			c.Abort()
+			response.WriteFailResponse(c, e.Code, e)
+			return
+		}
+
+		c.Next()
+	}
+}
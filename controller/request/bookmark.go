@@ -0,0 +1,16 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type Bookmark struct {
+	Folder *string `json:"folder"`
+}
+
+func (b Bookmark) Validate() error {
+	return validation.ValidateStruct(
+		&b,
+		validation.Field(&b.Folder, validation.Length(1, 100)),
+	)
+}
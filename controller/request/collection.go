@@ -0,0 +1,46 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type Collection struct {
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+}
+
+func (c Collection) Validate() error {
+	return validation.ValidateStruct(
+		&c,
+		validation.Field(&c.Title, validation.Required, validation.Length(1, 255)),
+		validation.Field(&c.Description, validation.Length(0, 2000)),
+	)
+}
+
+type CollectionItem struct {
+	NewsId *string `json:"news_id"`
+	// Position is where the news is inserted, it is appended when the position is missing
+	Position *int `json:"position"`
+}
+
+func (c CollectionItem) Validate() error {
+	return validation.ValidateStruct(
+		&c,
+		validation.Field(&c.NewsId, validation.Required),
+		validation.Field(&c.Position, validation.Min(0)),
+	)
+}
+
+type CollectionReorder struct {
+	// Version is the version of the collection the order was made on
+	Version *int     `json:"version"`
+	NewsIds []string `json:"news_ids"`
+}
+
+func (c CollectionReorder) Validate() error {
+	return validation.ValidateStruct(
+		&c,
+		validation.Field(&c.Version, validation.NotNil),
+		validation.Field(&c.NewsIds, validation.NotNil),
+	)
+}
@@ -0,0 +1,31 @@
+package request
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type FeaturedSet struct {
+	// Items are the news of the slot in their order, an empty list clear the slot
+	Items []FeaturedSetItem `json:"items"`
+}
+
+type FeaturedSetItem struct {
+	NewsId    *string    `json:"news_id"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+func (f FeaturedSet) Validate() error {
+	return validation.ValidateStruct(
+		&f,
+		validation.Field(&f.Items, validation.NotNil),
+	)
+}
+
+func (f FeaturedSetItem) Validate() error {
+	return validation.ValidateStruct(
+		&f,
+		validation.Field(&f.NewsId, validation.Required),
+	)
+}
@@ -0,0 +1,18 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type Graphql struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+func (g Graphql) Validate() error {
+	return validation.ValidateStruct(
+		&g,
+		validation.Field(&g.Query, validation.Required),
+	)
+}
@@ -0,0 +1,44 @@
+package request
+
+import (
+	"tempo/model"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type ModerationList struct {
+	Pagination
+	Status *model.ModerationStatus `form:"status"`
+}
+
+func (m ModerationList) Validate() error {
+	if err := m.Pagination.Validate(); err != nil {
+		return err
+	}
+
+	return validation.ValidateStruct(
+		&m,
+		validation.Field(&m.Status, validation.In(
+			model.ModerationPending,
+			model.ModerationApproved,
+			model.ModerationRemoved,
+		)),
+	)
+}
+
+type ModerationResolve struct {
+	// Status is approved to keep the news or removed to delete it
+	Status *model.ModerationStatus `json:"status"`
+	Note   *string                 `json:"note"`
+}
+
+func (m ModerationResolve) Validate() error {
+	return validation.ValidateStruct(
+		&m,
+		validation.Field(&m.Status, validation.Required, validation.In(
+			model.ModerationApproved,
+			model.ModerationRemoved,
+		)),
+		validation.Field(&m.Note, validation.Length(0, 1000)),
+	)
+}
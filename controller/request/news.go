@@ -7,6 +7,8 @@ import (
 type News struct {
 	Title       *string `json:"title"`
 	Description *string `json:"description"`
+	// Tags replace the tags of the news on update, they are left unchanged when missing
+	Tags []string `json:"tags"`
 }
 
 func (n News) Validate() error {
@@ -16,3 +18,8 @@ func (n News) Validate() error {
 		validation.Field(&n.Description, validation.Required),
 	)
 }
+
+type NewsStream struct {
+	Author *string `form:"author"`
+	Tag    *string `form:"tag"`
+}
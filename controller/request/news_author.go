@@ -0,0 +1,34 @@
+package request
+
+import (
+	"tempo/model"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type NewsAuthorInvite struct {
+	UserId *string               `json:"user_id"`
+	Role   *model.NewsAuthorRole `json:"role"`
+}
+
+func (n NewsAuthorInvite) Validate() error {
+	return validation.ValidateStruct(
+		&n,
+		validation.Field(&n.UserId, validation.Required),
+		validation.Field(&n.Role, validation.Required, validation.In(
+			model.NewsAuthorCoAuthor,
+			model.NewsAuthorEditor,
+		)),
+	)
+}
+
+type NewsOwnerTransfer struct {
+	UserId *string `json:"user_id"`
+}
+
+func (n NewsOwnerTransfer) Validate() error {
+	return validation.ValidateStruct(
+		&n,
+		validation.Field(&n.UserId, validation.Required),
+	)
+}
@@ -0,0 +1,56 @@
+package request
+
+import (
+	"tempo/model"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type NewsReport struct {
+	Reason *model.NewsReportReason `json:"reason"`
+	Note   *string                 `json:"note"`
+}
+
+func (n NewsReport) Validate() error {
+	return validation.ValidateStruct(
+		&n,
+		validation.Field(&n.Reason, validation.Required, validation.In(
+			model.NewsReportSpam,
+			model.NewsReportHarassment,
+			model.NewsReportHate,
+			model.NewsReportMisinformation,
+			model.NewsReportCopyright,
+			model.NewsReportOther,
+		)),
+		validation.Field(&n.Note, validation.Length(0, 1000)),
+	)
+}
+
+type NewsReportQueue struct {
+	Limit int `form:"limit"`
+}
+
+func (n NewsReportQueue) Validate() error {
+	return validation.ValidateStruct(
+		&n,
+		validation.Field(&n.Limit, validation.Min(0), validation.Max(100)),
+	)
+}
+
+type NewsReportResolve struct {
+	// Action is dismiss to keep the news, unpublish or delete
+	Action *model.NewsReportAction `json:"action"`
+	Note   *string                 `json:"note"`
+}
+
+func (n NewsReportResolve) Validate() error {
+	return validation.ValidateStruct(
+		&n,
+		validation.Field(&n.Action, validation.Required, validation.In(
+			model.NewsReportDismiss,
+			model.NewsReportUnpublish,
+			model.NewsReportDelete,
+		)),
+		validation.Field(&n.Note, validation.Length(0, 1000)),
+	)
+}
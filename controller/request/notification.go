@@ -0,0 +1,35 @@
+package request
+
+import (
+	"tempo/model"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type NotificationList struct {
+	Pagination
+	Unread bool `form:"unread"`
+}
+
+type NotificationRead struct {
+	Ids []string `json:"ids"`
+	All bool     `json:"all"`
+}
+
+func (n NotificationRead) Validate() error {
+	return validation.ValidateStruct(
+		&n,
+		validation.Field(&n.Ids, validation.When(!n.All, validation.Required.Error("ids is required unless all is set")), validation.Length(0, 100)),
+	)
+}
+
+type NotificationPreferences struct {
+	Preferences []model.NotificationPreference `json:"preferences"`
+}
+
+func (n NotificationPreferences) Validate() error {
+	return validation.ValidateStruct(
+		&n,
+		validation.Field(&n.Preferences, validation.Required),
+	)
+}
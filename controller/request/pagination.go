@@ -0,0 +1,17 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type Pagination struct {
+	Cursor *string `form:"cursor"`
+	Limit  int     `form:"limit"`
+}
+
+func (p Pagination) Validate() error {
+	return validation.ValidateStruct(
+		&p,
+		validation.Field(&p.Limit, validation.Min(0), validation.Max(100)),
+	)
+}
@@ -0,0 +1,24 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+)
+
+const (
+	OEmbedFormatJson = "json"
+	OEmbedFormatXml  = "xml"
+)
+
+// OEmbed is the query of an oEmbed consumer, the max sizes being ignored as a link has none
+type OEmbed struct {
+	Url    string `form:"url"`
+	Format string `form:"format"`
+}
+
+func (o OEmbed) Validate() error {
+	return validation.ValidateStruct(
+		&o,
+		validation.Field(&o.Url, validation.Required, is.URL),
+	)
+}
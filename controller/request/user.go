@@ -18,3 +18,108 @@ func (u User) Validate() error {
 		validation.Field(&u.Password, validation.Required, validation.Length(6, 64)),
 	)
 }
+
+type UserPassword struct {
+	CurrentPassword *string `json:"current_password"`
+	NewPassword     *string `json:"new_password"`
+}
+
+func (u UserPassword) Validate() error {
+	return validation.ValidateStruct(
+		&u,
+		validation.Field(&u.CurrentPassword, validation.Required),
+		validation.Field(&u.NewPassword, validation.Required, validation.Length(6, 64)),
+	)
+}
+
+type UserAdminList struct {
+	Pagination
+	// Query match the users whose email or full name contain it
+	Query     *string `form:"q"`
+	Suspended *bool   `form:"suspended"`
+}
+
+func (u UserAdminList) Validate() error {
+	if err := u.Pagination.Validate(); err != nil {
+		return err
+	}
+
+	return validation.ValidateStruct(
+		&u,
+		validation.Field(&u.Query, validation.Length(0, 100)),
+	)
+}
+
+// UserSuspension give the reason to suspend or unsuspend a user
+type UserSuspension struct {
+	Reason *string `json:"reason"`
+}
+
+func (u UserSuspension) Validate() error {
+	return validation.ValidateStruct(
+		&u,
+		validation.Field(&u.Reason, validation.Required, validation.Length(1, 1000)),
+	)
+}
+
+type TokenRefresh struct {
+	RefreshToken *string `json:"refresh_token"`
+}
+
+func (t TokenRefresh) Validate() error {
+	return validation.ValidateStruct(
+		&t,
+		validation.Field(&t.RefreshToken, validation.Required),
+	)
+}
+
+// Logout give the refresh token of the login to revoke with the access token, it is optional
+type Logout struct {
+	RefreshToken *string `json:"refresh_token"`
+}
+
+type EmailVerify struct {
+	Token *string `json:"token"`
+}
+
+func (e EmailVerify) Validate() error {
+	return validation.ValidateStruct(
+		&e,
+		validation.Field(&e.Token, validation.Required),
+	)
+}
+
+type EmailVerificationResend struct {
+	Email *string `json:"email"`
+}
+
+func (e EmailVerificationResend) Validate() error {
+	return validation.ValidateStruct(
+		&e,
+		validation.Field(&e.Email, validation.Required, is.Email),
+	)
+}
+
+type PasswordForgot struct {
+	Email *string `json:"email"`
+}
+
+func (p PasswordForgot) Validate() error {
+	return validation.ValidateStruct(
+		&p,
+		validation.Field(&p.Email, validation.Required, is.Email),
+	)
+}
+
+type PasswordReset struct {
+	Token       *string `json:"token"`
+	NewPassword *string `json:"new_password"`
+}
+
+func (p PasswordReset) Validate() error {
+	return validation.ValidateStruct(
+		&p,
+		validation.Field(&p.Token, validation.Required),
+		validation.Field(&p.NewPassword, validation.Required, validation.Length(6, 64)),
+	)
+}
@@ -0,0 +1,36 @@
+package request
+
+import (
+	"tempo/model"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type Webhook struct {
+	Url        *string           `json:"url"`
+	EventTypes []model.EventType `json:"event_types"`
+	// Secret is generated when missing on creation
+	Secret *string `json:"secret"`
+	// Active re-enable a webhook disabled after repeated failures
+	Active *bool `json:"active"`
+}
+
+type WebhookDeliveryList struct {
+	Pagination
+	Status *model.WebhookDeliveryStatus `form:"status"`
+}
+
+func (w WebhookDeliveryList) Validate() error {
+	if err := w.Pagination.Validate(); err != nil {
+		return err
+	}
+
+	return validation.ValidateStruct(
+		&w,
+		validation.Field(&w.Status, validation.In(
+			model.WebhookDeliveryPending,
+			model.WebhookDeliverySucceeded,
+			model.WebhookDeliveryFailed,
+		)),
+	)
+}
@@ -3,4 +3,6 @@ package response
 type Login struct {
 	Id       *string `json:"id"`
 	JwtToken *string `json:"jwt_token"`
+	// RefreshToken renew the jwt token once at /user/token/refresh, which return the next refresh token
+	RefreshToken *string `json:"refresh_token"`
 }
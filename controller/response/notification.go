@@ -0,0 +1,10 @@
+package response
+
+type NotificationPage struct {
+	Page
+	UnreadCount int64 `json:"unread_count"`
+}
+
+type NotificationRead struct {
+	Updated int64 `json:"updated"`
+}
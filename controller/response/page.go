@@ -0,0 +1,6 @@
+package response
+
+type Page struct {
+	Data       interface{} `json:"data"`
+	NextCursor *string     `json:"next_cursor"`
+}
@@ -0,0 +1,88 @@
+package response
+
+import (
+	"encoding/xml"
+	"html/template"
+	"strings"
+
+	"tempo/config"
+	"tempo/model"
+)
+
+// OEmbed is a link response of the oEmbed spec, in json or xml
+type OEmbed struct {
+	XMLName      xml.Name `json:"-" xml:"oembed"`
+	Version      string   `json:"version" xml:"version"`
+	Type         string   `json:"type" xml:"type"`
+	Title        string   `json:"title" xml:"title"`
+	AuthorName   string   `json:"author_name,omitempty" xml:"author_name,omitempty"`
+	ProviderName string   `json:"provider_name" xml:"provider_name"`
+	ProviderUrl  string   `json:"provider_url" xml:"provider_url"`
+	CacheAge     int      `json:"cache_age" xml:"cache_age"`
+}
+
+func NewOEmbed(preview model.NewsPreview, site config.SiteConfig, cacheAge int) OEmbed {
+	return OEmbed{
+		Version:      "1.0",
+		Type:         "link",
+		Title:        preview.Title,
+		AuthorName:   strings.Join(preview.AuthorNames, ", "),
+		ProviderName: site.Name,
+		ProviderUrl:  site.Url("/"),
+		CacheAge:     cacheAge,
+	}
+}
+
+// SharePage is the html page of a news for the link previews, with its OpenGraph and Twitter card tags
+type SharePage struct {
+	model.NewsPreview
+	SiteName  string
+	Language  string
+	OEmbedUrl string
+}
+
+// TwitterCard is the large image card when the news has an image
+func (s SharePage) TwitterCard() string {
+	if s.ImageUrl != nil {
+		return "summary_large_image"
+	}
+	return "summary"
+}
+
+var SharePageTemplate = template.Must(template.New("share").Parse(`<!DOCTYPE html>
+<html lang="{{.Language}}">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}} | {{.SiteName}}</title>
+<meta name="description" content="{{.Excerpt}}">
+<link rel="canonical" href="{{.Url}}">
+<link rel="alternate" type="application/json+oembed" href="{{.OEmbedUrl}}" title="{{.Title}}">
+<meta property="og:type" content="article">
+<meta property="og:site_name" content="{{.SiteName}}">
+<meta property="og:title" content="{{.Title}}">
+<meta property="og:description" content="{{.Excerpt}}">
+<meta property="og:url" content="{{.Url}}">
+{{- with .ImageUrl}}
+<meta property="og:image" content="{{.}}">
+{{- end}}
+{{- with .PublishedAt}}
+<meta property="article:published_time" content="{{.UTC.Format "2006-01-02T15:04:05Z07:00"}}">
+{{- end}}
+{{- range .AuthorNames}}
+<meta property="article:author" content="{{.}}">
+{{- end}}
+<meta name="twitter:card" content="{{.TwitterCard}}">
+<meta name="twitter:title" content="{{.Title}}">
+<meta name="twitter:description" content="{{.Excerpt}}">
+{{- with .ImageUrl}}
+<meta name="twitter:image" content="{{.}}">
+{{- end}}
+</head>
+<body>
+<article>
+<h1><a href="{{.Url}}">{{.Title}}</a></h1>
+<p>{{.Excerpt}}</p>
+</article>
+</body>
+</html>
+`))
@@ -3,6 +3,7 @@ package controller
 import (
 	"tempo/controller/middleware"
 	_ "tempo/docs/api/rest/swag"
+	"tempo/model"
 
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
@@ -29,14 +30,85 @@ func (h *httpServer) setupRouting() {
 	// API
 	router.POST("/user/register", h.controllers.user.Register)
 	router.POST("/user/login", h.controllers.user.Login)
+	router.POST("/user/token/refresh", h.controllers.user.RefreshToken)
+	router.POST("/user/verify-email", h.controllers.user.VerifyEmail)
+	router.POST("/user/verify-email/resend", h.controllers.user.ResendVerificationEmail)
+	router.POST("/user/password/forgot", h.controllers.user.ForgotPassword)
+	router.POST("/user/password/reset", h.controllers.user.ResetPassword)
+	router.GET("/.well-known/jwks.json", h.controllers.jwks.Keys)
+	router.GET("/sitemap.xml", h.controllers.sitemap.Index)
+	router.GET("/sitemaps/:chunk", h.controllers.sitemap.Chunk)
+	router.GET("/sitemap-news.xml", h.controllers.sitemap.News)
+	router.GET("/oembed", h.controllers.share.OEmbed)
+	router.GET("/share/news/:id", h.controllers.share.Page)
+	router.POST("/graphql", middleware.NewOptionalJwtMiddleware(h.jwtKeys, h.controllers.user.ValidateToken), h.controllers.graphql.Serve)
 
-	router.Use(middleware.NewHmacJwtMiddleware([]byte(h.config.JwtSecret)))
+	router.Use(middleware.NewJwtMiddleware(h.jwtKeys, h.controllers.user.ValidateToken))
 	{
 		router.PUT("/user", h.controllers.user.UpdateUser)
+		router.PUT("/user/password", h.controllers.user.ChangePassword)
+		router.POST("/user/logout", h.controllers.user.Logout)
+		router.POST("/user/logout/all", h.controllers.user.LogoutAll)
 
 		router.POST("/news", h.controllers.news.Add)
+		router.GET("/news/stream", h.controllers.news.Stream)
+		router.GET("/news/archive", h.controllers.archive.Months)
+		router.GET("/news/archive/:year/:month", h.controllers.archive.List)
 		router.GET("/news/:id", h.controllers.news.Get)
 		router.PUT("/news/:id", h.controllers.news.Update)
+		router.GET("/news/:id/authors", h.controllers.newsAuthor.List)
+		router.POST("/news/:id/authors", h.controllers.newsAuthor.Invite)
+		router.DELETE("/news/:id/authors/:userId", h.controllers.newsAuthor.Remove)
+		router.PUT("/news/:id/owner", h.controllers.newsAuthor.TransferOwnership)
+		router.POST("/news/:id/reports", h.controllers.newsReport.Report)
+
+		router.GET("/me/bookmarks", h.controllers.bookmark.List)
+		router.PUT("/me/bookmarks/:newsId", h.controllers.bookmark.Put)
+		router.DELETE("/me/bookmarks/:newsId", h.controllers.bookmark.Delete)
+
+		router.POST("/users/:id/follow", h.controllers.follow.Follow)
+		router.DELETE("/users/:id/follow", h.controllers.follow.Unfollow)
+		router.GET("/me/following", h.controllers.follow.ListFollowing)
+		router.GET("/me/followers", h.controllers.follow.ListFollowers)
+		router.GET("/me/feed", h.controllers.follow.Feed)
+
+		router.GET("/featured/:slot", h.controllers.featured.Get)
+
+		router.POST("/collections", h.controllers.collection.Add)
+		router.GET("/collections/:id", h.controllers.collection.Get)
+		router.POST("/collections/:id/items", h.controllers.collection.AddItem)
+		router.PUT("/collections/:id/items", h.controllers.collection.Reorder)
+		router.DELETE("/collections/:id/items/:newsId", h.controllers.collection.RemoveItem)
+
+		router.GET("/me/notifications", h.controllers.notification.List)
+		router.POST("/me/notifications/read", h.controllers.notification.MarkRead)
+		router.GET("/me/notifications/preferences", h.controllers.notification.GetPreferences)
+		router.PUT("/me/notifications/preferences", h.controllers.notification.UpdatePreferences)
+
+		admin := router.Group("", middleware.RequireRole(model.UserRoleAdmin))
+		admin.POST("/webhooks", h.controllers.webhook.Add)
+		admin.GET("/webhooks", h.controllers.webhook.List)
+		admin.GET("/webhooks/:id", h.controllers.webhook.Get)
+		admin.PUT("/webhooks/:id", h.controllers.webhook.Update)
+		admin.DELETE("/webhooks/:id", h.controllers.webhook.Delete)
+		admin.GET("/webhooks/:id/deliveries", h.controllers.webhook.ListDeliveries)
+		admin.POST("/webhooks/:id/deliveries/:deliveryId/redeliver", h.controllers.webhook.Redeliver)
+
+		admin.GET("/news/:id/duplicates", h.controllers.news.Duplicates)
+
+		admin.PUT("/featured/:slot", h.controllers.featured.Set)
+		admin.GET("/featured/:slot/audits", h.controllers.featured.ListAudits)
+
+		admin.GET("/moderation/items", h.controllers.moderation.List)
+		admin.POST("/moderation/items/:id/resolve", h.controllers.moderation.Resolve)
+		admin.GET("/moderation/reports", h.controllers.newsReport.Queue)
+		admin.POST("/moderation/reports/:newsId/resolve", h.controllers.newsReport.Resolve)
+
+		admin.GET("/admin/users", h.controllers.userAdmin.List)
+		admin.GET("/admin/users/:id", h.controllers.userAdmin.Get)
+		admin.POST("/admin/users/:id/suspend", h.controllers.userAdmin.Suspend)
+		admin.POST("/admin/users/:id/unsuspend", h.controllers.userAdmin.Unsuspend)
+		admin.POST("/admin/users/:id/reset-password", h.controllers.userAdmin.ResetPassword)
 	}
 
 }
@@ -0,0 +1,137 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"tempo/controller/middleware"
+	"tempo/helper"
+	"tempo/jwtkey"
+	"tempo/model"
+
+	"github.com/segmentio/ksuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const requestIdHeader = "x-request-id"
+
+// requestIdInterceptor reuse the request id sent by the caller or generate one, and send it back in the header
+func requestIdInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	requestId := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(requestIdHeader); len(v) > 0 {
+			requestId = v[0]
+		}
+	}
+	if requestId == "" {
+		requestId = ksuid.New().String()
+	}
+
+	ctx = helper.ContextWithRequestId(ctx, requestId)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIdHeader, requestId))
+
+	return handler(ctx, req)
+}
+
+// errorInterceptor turn the errors of the services into grpc status, hiding the unhandled ones from the caller
+func errorInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	res, err := handler(ctx, req)
+	if err == nil {
+		return res, nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return nil, err
+	}
+
+	var e model.Error
+	if errors.As(err, &e) {
+		return nil, status.Error(toGrpcCode(e.Code), e.Message)
+	}
+
+	helper.GetLogger(ctx).WithField("method", info.FullMethod).WithError(err).Warning("unhandled error")
+	return nil, status.Error(codes.Internal, "internal server error")
+}
+
+func toGrpcCode(code int) codes.Code {
+	switch code {
+	case model.ErrorBadRequest, model.ErrorUnprocessableEntity:
+		return codes.InvalidArgument
+	case model.ErrorUnauthenticated:
+		return codes.Unauthenticated
+	case model.ErrorUnauthorized:
+		return codes.PermissionDenied
+	case model.ErrorNotFound:
+		return codes.NotFound
+	case model.ErrorDuplicate:
+		return codes.AlreadyExists
+	case model.ErrorTooManyRequests:
+		return codes.ResourceExhausted
+	case model.ErrorServiceUnavailable:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
+
+// newAuthInterceptor check the bearer token of the authorization metadata like the REST middleware, except for the public methods
+func newAuthInterceptor(keys *jwtkey.Keyring, validate middleware.TokenValidator, publicMethods ...string) grpc.UnaryServerInterceptor {
+	public := map[string]bool{}
+	for _, v := range publicMethods {
+		public[v] = true
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if public[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		bearer := getBearer(ctx)
+		if bearer == nil {
+			return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+		}
+
+		claim, err := middleware.ParseJwt(keys, *bearer)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		if err = validate(ctx, claim); err != nil {
+			var e model.Error
+			if !errors.As(err, &e) {
+				return nil, err
+			}
+			return nil, status.Error(codes.Unauthenticated, e.Message)
+		}
+
+		ctx = context.WithValue(ctx, helper.ContextKeyJwtData, claim.User)
+		ctx = context.WithValue(ctx, helper.ContextKeyTokenBearer, *bearer)
+		return handler(ctx, req)
+	}
+}
+
+func getBearer(ctx context.Context) *string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	for _, v := range md.Get("authorization") {
+		s := strings.SplitN(v, " ", 2)
+		if len(s) == 2 && strings.ToLower(s[0]) == "bearer" {
+			return &s[1]
+		}
+	}
+	return nil
+}
+
+func getJwtData(ctx context.Context) (model.User, error) {
+	user, ok := ctx.Value(helper.ContextKeyJwtData).(model.User)
+	if !ok {
+		return model.User{}, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	return user, nil
+}
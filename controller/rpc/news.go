@@ -0,0 +1,117 @@
+package rpc
+
+import (
+	"context"
+
+	"tempo/container"
+	"tempo/controller/request"
+	"tempo/controller/rpc/pb"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/usecase"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type News struct {
+	pb.UnimplementedNewsServiceServer
+	appContainer *container.Container
+}
+
+func NewNews(appContainer *container.Container) *News {
+	return &News{appContainer: appContainer}
+}
+
+func (n *News) AddNews(ctx context.Context, req *pb.AddNewsRequest) (*pb.AddNewsResponse, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "Controller.Rpc.AddNews")
+
+	// auth
+	user, err := getJwtData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	reqNews := request.News{
+		Title:       &req.Title,
+		Description: &req.Description,
+	}
+	if err := reqNews.Validate(); err != nil {
+		logger.WithError(err).Warning("missing required field")
+		return nil, model.NewParameterError(helper.Pointer(err.Error()))
+	}
+
+	// Action
+	newsUseCase := usecase.NewNews(n.appContainer)
+	res, err := newsUseCase.Add(ctx, &model.News{
+		UserId:      user.Id,
+		Title:       reqNews.Title,
+		Description: reqNews.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AddNewsResponse{News: toPbNews(res)}, nil
+}
+
+func (n *News) GetNews(ctx context.Context, req *pb.GetNewsRequest) (*pb.GetNewsResponse, error) {
+	// auth
+	user, err := getJwtData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	if req.Id == "" {
+		return nil, model.NewParameterError(helper.Pointer("missing id"))
+	}
+
+	// Action
+	newsUseCase := usecase.NewNews(n.appContainer)
+	res, err := newsUseCase.Get(ctx, &req.Id, &user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetNewsResponse{News: toPbNews(res)}, nil
+}
+
+func (n *News) UpdateNews(ctx context.Context, req *pb.UpdateNewsRequest) (*pb.UpdateNewsResponse, error) {
+	// auth
+	user, err := getJwtData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Action
+	newsUseCase := usecase.NewNews(n.appContainer)
+	res, err := newsUseCase.Update(ctx, &req.Id, user.Id, &model.News{
+		Title:       req.Title,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateNewsResponse{News: toPbNews(res)}, nil
+}
+
+func toPbNews(news *model.News) *pb.News {
+	res := &pb.News{
+		Id:          helper.Val(news.Id),
+		Title:       helper.Val(news.Title),
+		Description: helper.Val(news.Description),
+		UserId:      helper.Val(news.UserId),
+	}
+	if news.PublishedAt != nil {
+		res.PublishedAt = timestamppb.New(*news.PublishedAt)
+	}
+	if news.CreatedAt != nil {
+		res.CreatedAt = timestamppb.New(*news.CreatedAt)
+	}
+	if news.UpdatedAt != nil {
+		res.UpdatedAt = timestamppb.New(*news.UpdatedAt)
+	}
+	return res
+}
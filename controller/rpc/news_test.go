@@ -0,0 +1,154 @@
+package rpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"tempo/config"
+	"tempo/container"
+	"tempo/controller/rpc/pb"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestNews_GetNews(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnUnauthenticated_WhenTokenIsMissing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		conn := setupClient(t, nil)
+
+		// CODE UNDER TEST
+		_, err := pb.NewNewsServiceClient(conn).GetNews(context.Background(), &pb.GetNewsRequest{Id: "id"})
+
+		// EXPECTATION
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("ShouldReturnUnauthenticated_WhenTokenIsInvalid", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		conn := setupClient(t, nil)
+
+		// CODE UNDER TEST
+		_, err := pb.NewNewsServiceClient(conn).GetNews(withToken("token"), &pb.GetNewsRequest{Id: "id"})
+
+		// EXPECTATION
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("ShouldReturnNotFound_WhenNewsIsNotFound", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, _ := test.FakeJwtToken(t, nil)
+		id := "id"
+
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, &id).Return(nil, model.NewNotFoundError()).Once()
+
+		conn := setupClient(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsRepo(newsMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		var header metadata.MD
+		ctx := metadata.AppendToOutgoingContext(withToken(token), "x-request-id", "request-id")
+		_, err := pb.NewNewsServiceClient(conn).GetNews(ctx, &pb.GetNewsRequest{Id: id}, grpc.Header(&header))
+
+		// EXPECTATION
+		require.Equal(t, codes.NotFound, status.Code(err))
+		require.Equal(t, []string{"request-id"}, header.Get("x-request-id"))
+		newsMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnInternal_WhenErrorIsUnhandled", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, _ := test.FakeJwtToken(t, nil)
+		id := "id"
+
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, &id).Return(nil, errors.New("connection refused")).Once()
+
+		conn := setupClient(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsRepo(newsMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		var header metadata.MD
+		_, err := pb.NewNewsServiceClient(conn).GetNews(withToken(token), &pb.GetNewsRequest{Id: id}, grpc.Header(&header))
+
+		// EXPECTATION
+		require.Equal(t, codes.Internal, status.Code(err))
+		require.Equal(t, "internal server error", status.Convert(err).Message())
+		require.Len(t, header.Get("x-request-id"), 1)
+		newsMock.AssertExpectations(t)
+	})
+}
+
+func TestNews_AddNews(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnInvalidArgument_WhenTitleIsMissing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, _ := test.FakeJwtToken(t, nil)
+		conn := setupClient(t, nil)
+
+		// CODE UNDER TEST
+		_, err := pb.NewNewsServiceClient(conn).AddNews(withToken(token), &pb.AddNewsRequest{Description: "description"})
+
+		// EXPECTATION
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ShouldAddNewsOfTheUser", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token, fakeUser := test.FakeJwtToken(t, nil)
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.UserId = fakeUser.Id
+			return news
+		})
+
+		newsMock := &mocks.News{}
+		addedNews := &model.News{
+			UserId:      fakeUser.Id,
+			Title:       fakeNews.Title,
+			Description: fakeNews.Description,
+		}
+		addedNews.Fingerprint = helper.Pointer(addedNews.ComputeFingerprint())
+		reading := config.Instance().NewsReading
+		addedNews.ComputeReading(reading.ExcerptLength, reading.WordsPerMinute, reading.CharactersPerMinute)
+		newsMock.On("ListSimilar", mock.Anything, mock.Anything).Return([]model.NewsDuplicate{}, nil).Once()
+		newsMock.On("Add", mock.Anything, addedNews).Return(&fakeNews, nil).Once()
+
+		conn := setupClient(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetNewsRepo(newsMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		res, err := pb.NewNewsServiceClient(conn).AddNews(withToken(token), &pb.AddNewsRequest{
+			Title:       *fakeNews.Title,
+			Description: *fakeNews.Description,
+		})
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, *fakeNews.Id, res.News.Id)
+		require.Equal(t, *fakeUser.Id, res.News.UserId)
+		newsMock.AssertExpectations(t)
+	})
+}
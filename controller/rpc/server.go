@@ -0,0 +1,53 @@
+package rpc
+
+import (
+	"fmt"
+	"net"
+
+	"tempo/config"
+	"tempo/container"
+	"tempo/controller/rpc/pb"
+
+	"google.golang.org/grpc"
+)
+
+type Server struct {
+	config config.Config
+	server *grpc.Server
+}
+
+func NewServer(container *container.Container) *Server {
+	cfg := container.Config()
+
+	user := NewUser(container)
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		requestIdInterceptor,
+		errorInterceptor,
+		newAuthInterceptor(container.JwtKeys(), user.ValidateToken,
+			pb.UserService_Register_FullMethodName,
+			pb.UserService_Login_FullMethodName,
+		),
+	))
+	pb.RegisterUserServiceServer(server, user)
+	pb.RegisterNewsServiceServer(server, NewNews(container))
+
+	return &Server{config: cfg, server: server}
+}
+
+// Start serve on the grpc port until Stop is called
+func (s *Server) Start() error {
+	lis, err := net.Listen("tcp", fmt.Sprintf("%s:%s", s.config.Service.Host, s.config.Service.GrpcPort))
+	if err != nil {
+		return err
+	}
+
+	return s.Serve(lis)
+}
+
+func (s *Server) Serve(lis net.Listener) error {
+	return s.server.Serve(lis)
+}
+
+func (s *Server) Stop() {
+	s.server.GracefulStop()
+}
@@ -0,0 +1,66 @@
+package rpc_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"testing"
+
+	"tempo/config"
+	"tempo/container"
+	"tempo/controller/rpc"
+	"tempo/helper/test"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestMain(m *testing.M) {
+	err := config.Load()
+	if err != nil {
+		fmt.Printf("Config error: %s\n", err.Error())
+		os.Exit(1)
+	}
+
+	retCode := m.Run()
+	os.Exit(retCode)
+}
+
+// setupClient serve the grpc api in memory and return a connection to it
+func setupClient(t *testing.T, cb func(appContainer *container.Container) *container.Container) *grpc.ClientConn {
+	t.Helper()
+
+	appContainer := test.DefaultAppContainer()
+	if cb != nil {
+		appContainer = cb(appContainer)
+	}
+
+	lis := bufconn.Listen(1024 * 1024)
+	server := rpc.NewServer(appContainer)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return conn
+}
+
+func withToken(token string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
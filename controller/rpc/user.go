@@ -0,0 +1,130 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"tempo/container"
+	"tempo/controller/middleware"
+	"tempo/controller/request"
+	"tempo/controller/rpc/pb"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/usecase"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type User struct {
+	pb.UnimplementedUserServiceServer
+	appContainer *container.Container
+}
+
+func NewUser(appContainer *container.Container) *User {
+	return &User{appContainer: appContainer}
+}
+
+// ValidateToken reject the token revoked at a logout, of a suspended user, or issued before the password was reset.
+// The role of the token is replaced with the current one, so a demoted admin lose the access at once
+func (u *User) ValidateToken(ctx context.Context, claim *middleware.JWTData) error {
+	userUseCase := usecase.NewUser(u.appContainer)
+	return userUseCase.ValidateToken(ctx, &claim.User, claim.StandardClaims.Id, claim.IssuedTime())
+}
+
+func (u *User) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "Controller.Rpc.Register")
+
+	// Validation
+	reqUser := request.User{
+		Email:    &req.Email,
+		FullName: &req.FullName,
+		Password: &req.Password,
+	}
+	if err := reqUser.Validate(); err != nil {
+		logger.WithError(err).Warning("missing required field")
+		return nil, model.NewParameterError(helper.Pointer(err.Error()))
+	}
+
+	// Action
+	userUseCase := usecase.NewUser(u.appContainer)
+	res, err := userUseCase.Register(ctx, model.User{
+		Email:    reqUser.Email,
+		Password: reqUser.Password,
+		FullName: reqUser.FullName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RegisterResponse{User: toPbUser(res)}, nil
+}
+
+func (u *User) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "Controller.Rpc.Login")
+
+	// Validation
+	reqUser := request.User{
+		Email:    &req.Email,
+		Password: &req.Password,
+	}
+	if err := reqUser.Validate(); err != nil {
+		logger.WithError(err).Warning("missing required field")
+		return nil, model.NewParameterError(helper.Pointer(err.Error()))
+	}
+
+	// Action
+	userUseCase := usecase.NewUser(u.appContainer)
+	res, err := userUseCase.Login(ctx, &model.User{
+		Email:    reqUser.Email,
+		Password: reqUser.Password,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	config := u.appContainer.Config()
+	token, err := middleware.GenerateJwt(*res, u.appContainer.JwtKeys(), time.Duration(config.Token.AccessSeconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LoginResponse{
+		Id:       *res.Id,
+		JwtToken: *token,
+	}, nil
+}
+
+func (u *User) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
+	// auth
+	user, err := getJwtData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Action
+	userUseCase := usecase.NewUser(u.appContainer)
+	res, err := userUseCase.Update(ctx, user.Email, &model.User{
+		Email:    req.Email,
+		FullName: req.FullName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateUserResponse{User: toPbUser(res)}, nil
+}
+
+func toPbUser(user *model.User) *pb.User {
+	res := &pb.User{
+		Id:       helper.Val(user.Id),
+		Email:    helper.Val(user.Email),
+		FullName: helper.Val(user.FullName),
+	}
+	if user.Role != nil {
+		res.Role = string(*user.Role)
+	}
+	if user.CreatedAt != nil {
+		res.CreatedAt = timestamppb.New(*user.CreatedAt)
+	}
+	return res
+}
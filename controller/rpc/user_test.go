@@ -0,0 +1,102 @@
+package rpc_test
+
+import (
+	"context"
+	"testing"
+
+	"tempo/container"
+	"tempo/controller/rpc/pb"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUser_Register(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnInvalidArgument_WhenPasswordIsTooShort", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		conn := setupClient(t, nil)
+
+		// CODE UNDER TEST
+		_, err := pb.NewUserServiceClient(conn).Register(context.Background(), &pb.RegisterRequest{
+			Email:    "email@gmail.com",
+			FullName: "full name",
+			Password: "pass",
+		})
+
+		// EXPECTATION
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ShouldReturnAlreadyExists_WhenEmailIsTaken", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Email = helper.Pointer("email@gmail.com")
+			return user
+		})
+
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Email: fakeUser.Email}).Return(&fakeUser, nil).Once()
+
+		conn := setupClient(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetUserRepo(userMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		_, err := pb.NewUserServiceClient(conn).Register(context.Background(), &pb.RegisterRequest{
+			Email:    *fakeUser.Email,
+			FullName: *fakeUser.FullName,
+			Password: "password",
+		})
+
+		// EXPECTATION
+		require.Equal(t, codes.AlreadyExists, status.Code(err))
+		userMock.AssertExpectations(t)
+	})
+}
+
+func TestUser_Login(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnToken_WhenPasswordIsValid", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		hash, err := test.DefaultAppContainer().PasswordHasher().Hash("password")
+		require.NoError(t, err)
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Email = helper.Pointer("email@gmail.com")
+			user.PasswordSalt = nil
+			user.Password = &hash
+			return user
+		})
+
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Email: fakeUser.Email}).Return(&fakeUser, nil).Once()
+
+		conn := setupClient(t, func(appContainer *container.Container) *container.Container {
+			appContainer.SetUserRepo(userMock)
+			return appContainer
+		})
+
+		// CODE UNDER TEST
+		res, err := pb.NewUserServiceClient(conn).Login(context.Background(), &pb.LoginRequest{
+			Email:    *fakeUser.Email,
+			Password: "password",
+		})
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, *fakeUser.Id, res.Id)
+		require.NotEmpty(t, res.JwtToken)
+		userMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,222 @@
+package denylist
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"tempo/config"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+)
+
+// revocationLookback re-read the revocations from a bit before the last refresh, for the transactions committed late
+// and the clock skew between the instances, reading a revocation twice being harmless
+const revocationLookback = time.Minute
+
+// purgeInterval is how often the expired revocations are deleted from the table
+const purgeInterval = time.Hour
+
+// Denylist keep the jti of the revoked access tokens in memory, with the users whose tokens are rejected and the role of
+// the users who are not a regular user, so checking a token never hit MySQL. It load the revocations on the first use, then only read the new ones at most once per
+// refresh interval: a token revoked on another instance is rejected here after at most the interval, a token revoked
+// here is rejected at once.
+type Denylist struct {
+	repo     repository.RevokedToken
+	userRepo repository.User
+	refresh  time.Duration
+	// tokenLifetime is how long the access tokens are valid, the tokens of a user revoked before are all expired
+	tokenLifetime time.Duration
+	now           func() time.Time
+
+	mu          sync.Mutex
+	loaded      bool
+	refreshedAt time.Time
+	purgedAt    time.Time
+	// expiresAt of the revoked tokens by jti, a token is dropped once expired as it is rejected anyway
+	expiresAt map[string]time.Time
+	// users by id whose tokens can be rejected or who have another role than user, with only their role and token
+	// state. The other users are left out
+	users map[string]model.User
+}
+
+func New(repo repository.RevokedToken, userRepo repository.User, refresh time.Duration, tokenLifetime time.Duration) *Denylist {
+	return &Denylist{
+		repo:          repo,
+		userRepo:      userRepo,
+		refresh:       refresh,
+		tokenLifetime: tokenLifetime,
+		now:           time.Now,
+		expiresAt:     map[string]time.Time{},
+		users:         map[string]model.User{},
+	}
+}
+
+func NewFromConfig(cfg config.Config, repo repository.RevokedToken, userRepo repository.User) *Denylist {
+	return New(repo, userRepo, time.Duration(cfg.Token.DenylistRefreshSeconds)*time.Second,
+		time.Duration(cfg.Token.AccessSeconds)*time.Second)
+}
+
+// IsRevoked return whether the token of the jti was revoked and is not expired yet
+func (d *Denylist) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if err := d.sync(ctx); err != nil {
+		return false, err
+	}
+
+	expiresAt, ok := d.expiresAt[jti]
+	return ok && d.now().Before(expiresAt), nil
+}
+
+// User return the token state of the user, suspended or revoking the tokens issued before TokensValidAfter, or nil
+// when every token of the user is accepted
+func (d *Denylist) User(ctx context.Context, id string) (*model.User, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if err := d.sync(ctx); err != nil {
+		return nil, err
+	}
+
+	user, ok := d.users[id]
+	if !ok || !d.isRestricted(user, d.now()) {
+		return nil, nil
+	}
+	return &user, nil
+}
+
+// Role return the role the user has now, which is not the role in a token issued before an admin changed it
+func (d *Denylist) Role(ctx context.Context, id string) (model.UserRole, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if err := d.sync(ctx); err != nil {
+		return "", err
+	}
+
+	user, ok := d.users[id]
+	if !ok || user.Role == nil {
+		return model.UserRoleUser, nil
+	}
+	return *user.Role, nil
+}
+
+// Reload the token state of the user changed here, so it apply on this instance at once and on the others at their
+// next refresh
+func (d *Denylist) Reload(ctx context.Context, id string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if err := d.sync(ctx); err != nil {
+		return err
+	}
+
+	user, err := d.userRepo.Get(ctx, repository.UserGetFilter{Id: &id})
+	if err != nil {
+		return err
+	}
+	d.setUser(*user, d.now())
+
+	return nil
+}
+
+// Revoke deny the token until it expires, on this instance at once and on the others at their next refresh
+func (d *Denylist) Revoke(ctx context.Context, token model.RevokedToken) error {
+	if token.Jti == nil || token.ExpiresAt == nil {
+		return model.NewParameterError(helper.Pointer("missing jti or expiry"))
+	}
+
+	if err := d.repo.Add(ctx, &token); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.expiresAt[*token.Jti] = *token.ExpiresAt
+
+	return nil
+}
+
+// sync read the revocations since the previous refresh, a failure after the first load only leave the denylist stale
+func (d *Denylist) sync(ctx context.Context) error {
+	now := d.now()
+	if d.loaded && now.Sub(d.refreshedAt) < d.refresh {
+		return nil
+	}
+
+	var since *time.Time
+	if d.loaded {
+		since = helper.Pointer(d.refreshedAt.Add(-revocationLookback))
+	}
+	tokens, err := d.repo.List(ctx, now, since)
+	var users []model.User
+	if err == nil {
+		if since == nil {
+			users, err = d.userRepo.ListRestricted(ctx, now.Add(-d.tokenLifetime))
+		} else {
+			users, err = d.userRepo.ListUpdated(ctx, *since)
+		}
+	}
+	if err != nil {
+		if !d.loaded {
+			return err
+		}
+		helper.GetLogger(ctx).WithError(err).Warning("Failed refresh the denylist, using the stale one")
+		return nil
+	}
+
+	for jti, expiresAt := range d.expiresAt {
+		if !now.Before(expiresAt) {
+			delete(d.expiresAt, jti)
+		}
+	}
+	for _, v := range tokens {
+		d.expiresAt[*v.Jti] = *v.ExpiresAt
+	}
+	for id, user := range d.users {
+		if !d.isKept(user, now) {
+			delete(d.users, id)
+		}
+	}
+	for _, v := range users {
+		d.setUser(v, now)
+	}
+	d.loaded = true
+	d.refreshedAt = now
+
+	if now.Sub(d.purgedAt) >= purgeInterval {
+		if err := d.repo.DeleteExpired(ctx, now); err != nil {
+			helper.GetLogger(ctx).WithError(err).Warning("Failed delete the expired revoked tokens")
+		} else {
+			d.purgedAt = now
+		}
+	}
+
+	return nil
+}
+
+// setUser keep the role and token state of the user while it can reject a token or grant another role than user
+func (d *Denylist) setUser(user model.User, now time.Time) {
+	if !d.isKept(user, now) {
+		delete(d.users, *user.Id)
+		return
+	}
+
+	d.users[*user.Id] = model.User{
+		Id:               user.Id,
+		Role:             user.Role,
+		SuspendedAt:      user.SuspendedAt,
+		TokensValidAfter: user.TokensValidAfter,
+	}
+}
+
+func (d *Denylist) isKept(user model.User, now time.Time) bool {
+	return d.isRestricted(user, now) || !user.HasRole(model.UserRoleUser)
+}
+
+func (d *Denylist) isRestricted(user model.User, now time.Time) bool {
+	return user.IsSuspended() || (user.TokensValidAfter != nil && now.Sub(*user.TokensValidAfter) < d.tokenLifetime)
+}
@@ -0,0 +1,337 @@
+package denylist_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"tempo/denylist"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func fakeRevokedToken(jti string, expiresAt time.Time) model.RevokedToken {
+	return model.RevokedToken{
+		Jti:       helper.Pointer(jti),
+		UserId:    helper.Pointer("user"),
+		ExpiresAt: helper.Pointer(expiresAt),
+	}
+}
+
+func TestDenylist_IsRevoked(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldLoadTheRevocationsOnce_WithinTheRefreshInterval", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		repoMock := &mocks.RevokedToken{}
+		userMock := &mocks.User{}
+		userMock.On("ListRestricted", mock.Anything, mock.Anything).Return([]model.User{}, nil).Once()
+		repoMock.On("List", mock.Anything, mock.Anything, (*time.Time)(nil)).Return([]model.RevokedToken{
+			fakeRevokedToken("revoked", time.Now().Add(time.Minute)),
+		}, nil).Once()
+		repoMock.On("DeleteExpired", mock.Anything, mock.Anything).Return(nil).Once()
+		list := denylist.New(repoMock, userMock, time.Hour, time.Hour)
+
+		// CODE UNDER TEST
+		revoked, err := list.IsRevoked(context.TODO(), "revoked")
+		require.NoError(t, err)
+		other, err := list.IsRevoked(context.TODO(), "other")
+		require.NoError(t, err)
+
+		// EXPECTATION
+		require.True(t, revoked)
+		require.False(t, other)
+
+		repoMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReadTheNewRevocations_WhenRefreshing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		repoMock := &mocks.RevokedToken{}
+		userMock := &mocks.User{}
+		userMock.On("ListRestricted", mock.Anything, mock.Anything).Return([]model.User{}, nil).Once()
+		userMock.On("ListUpdated", mock.Anything, mock.Anything).Return([]model.User{}, nil).Once()
+		repoMock.On("List", mock.Anything, mock.Anything, (*time.Time)(nil)).Return([]model.RevokedToken{}, nil).Once()
+		repoMock.On("List", mock.Anything, mock.Anything, mock.AnythingOfType("*time.Time")).Return([]model.RevokedToken{
+			fakeRevokedToken("revoked", time.Now().Add(time.Minute)),
+		}, nil).Once()
+		repoMock.On("DeleteExpired", mock.Anything, mock.Anything).Return(nil).Once()
+		list := denylist.New(repoMock, userMock, 0, time.Hour)
+
+		// CODE UNDER TEST
+		before, err := list.IsRevoked(context.TODO(), "revoked")
+		require.NoError(t, err)
+		after, err := list.IsRevoked(context.TODO(), "revoked")
+		require.NoError(t, err)
+
+		// EXPECTATION
+		require.False(t, before)
+		require.True(t, after)
+
+		repoMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldNotDenyTheToken_WhenItIsExpired", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		repoMock := &mocks.RevokedToken{}
+		userMock := &mocks.User{}
+		userMock.On("ListRestricted", mock.Anything, mock.Anything).Return([]model.User{}, nil).Once()
+		repoMock.On("List", mock.Anything, mock.Anything, (*time.Time)(nil)).Return([]model.RevokedToken{}, nil).Once()
+		repoMock.On("DeleteExpired", mock.Anything, mock.Anything).Return(nil).Once()
+		repoMock.On("Add", mock.Anything, mock.Anything).Return(nil).Once()
+		list := denylist.New(repoMock, userMock, time.Hour, time.Hour)
+
+		// CODE UNDER TEST
+		err := list.Revoke(context.TODO(), fakeRevokedToken("expired", time.Now().Add(-time.Second)))
+		require.NoError(t, err)
+		revoked, err := list.IsRevoked(context.TODO(), "expired")
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.False(t, revoked)
+
+		repoMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnError_WhenTheFirstLoadFailed", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		repoMock := &mocks.RevokedToken{}
+		userMock := &mocks.User{}
+		repoMock.On("List", mock.Anything, mock.Anything, (*time.Time)(nil)).Return(nil, errors.New("db is down")).Once()
+		list := denylist.New(repoMock, userMock, time.Hour, time.Hour)
+
+		// CODE UNDER TEST
+		revoked, err := list.IsRevoked(context.TODO(), "revoked")
+
+		// EXPECTATION
+		require.EqualError(t, err, "db is down")
+		require.False(t, revoked)
+
+		repoMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldUseTheStaleDenylist_WhenRefreshFailed", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		repoMock := &mocks.RevokedToken{}
+		userMock := &mocks.User{}
+		userMock.On("ListRestricted", mock.Anything, mock.Anything).Return([]model.User{}, nil).Once()
+		repoMock.On("List", mock.Anything, mock.Anything, (*time.Time)(nil)).Return([]model.RevokedToken{
+			fakeRevokedToken("revoked", time.Now().Add(time.Minute)),
+		}, nil).Once()
+		repoMock.On("List", mock.Anything, mock.Anything, mock.AnythingOfType("*time.Time")).Return(nil, errors.New("db is down")).Once()
+		repoMock.On("DeleteExpired", mock.Anything, mock.Anything).Return(nil).Once()
+		list := denylist.New(repoMock, userMock, 0, time.Hour)
+
+		// CODE UNDER TEST
+		_, err := list.IsRevoked(context.TODO(), "revoked")
+		require.NoError(t, err)
+		revoked, err := list.IsRevoked(context.TODO(), "revoked")
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.True(t, revoked)
+
+		repoMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+}
+
+func TestDenylist_Revoke(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldDenyTheTokenAtOnce", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token := fakeRevokedToken("revoked", time.Now().Add(time.Minute))
+		repoMock := &mocks.RevokedToken{}
+		userMock := &mocks.User{}
+		userMock.On("ListRestricted", mock.Anything, mock.Anything).Return([]model.User{}, nil).Once()
+		repoMock.On("List", mock.Anything, mock.Anything, (*time.Time)(nil)).Return([]model.RevokedToken{}, nil).Once()
+		repoMock.On("DeleteExpired", mock.Anything, mock.Anything).Return(nil).Once()
+		repoMock.On("Add", mock.Anything, &token).Return(nil).Once()
+		list := denylist.New(repoMock, userMock, time.Hour, time.Hour)
+
+		// CODE UNDER TEST
+		before, err := list.IsRevoked(context.TODO(), "revoked")
+		require.NoError(t, err)
+		err = list.Revoke(context.TODO(), token)
+		require.NoError(t, err)
+		after, err := list.IsRevoked(context.TODO(), "revoked")
+		require.NoError(t, err)
+
+		// EXPECTATION
+		require.False(t, before)
+		require.True(t, after)
+
+		repoMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnError_WhenJtiIsMissing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		repoMock := &mocks.RevokedToken{}
+		userMock := &mocks.User{}
+		list := denylist.New(repoMock, userMock, time.Hour, time.Hour)
+
+		// CODE UNDER TEST
+		err := list.Revoke(context.TODO(), model.RevokedToken{ExpiresAt: helper.Pointer(time.Now())})
+
+		// EXPECTATION
+		require.Error(t, err)
+
+		repoMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+}
+
+func TestDenylist_User(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldKeepOnlyTheRestrictedUsers_WhenLoading", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		repoMock := &mocks.RevokedToken{}
+		repoMock.On("List", mock.Anything, mock.Anything, (*time.Time)(nil)).Return([]model.RevokedToken{}, nil).Once()
+		repoMock.On("DeleteExpired", mock.Anything, mock.Anything).Return(nil).Once()
+		userMock := &mocks.User{}
+		userMock.On("ListRestricted", mock.Anything, mock.Anything).Return([]model.User{
+			{Id: helper.Pointer("suspended"), SuspendedAt: helper.Pointer(time.Now())},
+			{Id: helper.Pointer("revoked"), TokensValidAfter: helper.Pointer(time.Now())},
+			{Id: helper.Pointer("expired"), TokensValidAfter: helper.Pointer(time.Now().Add(-2 * time.Hour))},
+		}, nil).Once()
+		list := denylist.New(repoMock, userMock, time.Hour, time.Hour)
+
+		// CODE UNDER TEST
+		suspended, err := list.User(context.TODO(), "suspended")
+		require.NoError(t, err)
+		revoked, err := list.User(context.TODO(), "revoked")
+		require.NoError(t, err)
+		expired, err := list.User(context.TODO(), "expired")
+		require.NoError(t, err)
+
+		// EXPECTATION
+		require.True(t, suspended.IsSuspended())
+		require.NotNil(t, revoked.TokensValidAfter)
+		require.Nil(t, expired)
+
+		repoMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldApplyTheUpdatedUsers_WhenRefreshing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		repoMock := &mocks.RevokedToken{}
+		repoMock.On("List", mock.Anything, mock.Anything, mock.Anything).Return([]model.RevokedToken{}, nil).Times(3)
+		repoMock.On("DeleteExpired", mock.Anything, mock.Anything).Return(nil).Once()
+		userMock := &mocks.User{}
+		userMock.On("ListRestricted", mock.Anything, mock.Anything).Return([]model.User{
+			{Id: helper.Pointer("unsuspended"), SuspendedAt: helper.Pointer(time.Now())},
+		}, nil).Once()
+		userMock.On("ListUpdated", mock.Anything, mock.Anything).Return([]model.User{
+			{Id: helper.Pointer("unsuspended")},
+			{Id: helper.Pointer("suspended"), SuspendedAt: helper.Pointer(time.Now())},
+		}, nil).Once()
+		userMock.On("ListUpdated", mock.Anything, mock.Anything).Return([]model.User{}, nil).Once()
+		list := denylist.New(repoMock, userMock, 0, time.Hour)
+
+		// CODE UNDER TEST
+		before, err := list.User(context.TODO(), "unsuspended")
+		require.NoError(t, err)
+		after, err := list.User(context.TODO(), "unsuspended")
+		require.NoError(t, err)
+		suspended, err := list.User(context.TODO(), "suspended")
+		require.NoError(t, err)
+
+		// EXPECTATION
+		require.NotNil(t, before)
+		require.Nil(t, after)
+		require.True(t, suspended.IsSuspended())
+
+		repoMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+}
+
+func TestDenylist_Role(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnTheCurrentRole_WhenTheRoleChanged", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		repoMock := &mocks.RevokedToken{}
+		repoMock.On("List", mock.Anything, mock.Anything, mock.Anything).Return([]model.RevokedToken{}, nil).Times(3)
+		repoMock.On("DeleteExpired", mock.Anything, mock.Anything).Return(nil).Once()
+		userMock := &mocks.User{}
+		userMock.On("ListRestricted", mock.Anything, mock.Anything).Return([]model.User{
+			{Id: helper.Pointer("admin"), Role: helper.Pointer(model.UserRoleAdmin)},
+		}, nil).Once()
+		userMock.On("ListUpdated", mock.Anything, mock.Anything).Return([]model.User{
+			{Id: helper.Pointer("admin"), Role: helper.Pointer(model.UserRoleUser)},
+		}, nil).Twice()
+		list := denylist.New(repoMock, userMock, 0, time.Hour)
+
+		// CODE UNDER TEST
+		before, err := list.Role(context.TODO(), "admin")
+		require.NoError(t, err)
+		after, err := list.Role(context.TODO(), "admin")
+		require.NoError(t, err)
+		other, err := list.User(context.TODO(), "admin")
+		require.NoError(t, err)
+
+		// EXPECTATION
+		require.Equal(t, model.UserRoleAdmin, before)
+		require.Equal(t, model.UserRoleUser, after)
+		require.Nil(t, other)
+
+		repoMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+}
+
+func TestDenylist_Reload(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldApplyTheUserAtOnce", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		revokedAt := time.Now()
+		repoMock := &mocks.RevokedToken{}
+		repoMock.On("List", mock.Anything, mock.Anything, (*time.Time)(nil)).Return([]model.RevokedToken{}, nil).Once()
+		repoMock.On("DeleteExpired", mock.Anything, mock.Anything).Return(nil).Once()
+		userMock := &mocks.User{}
+		userMock.On("ListRestricted", mock.Anything, mock.Anything).Return([]model.User{}, nil).Once()
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Id: helper.Pointer("user")}).Return(&model.User{
+			Id:               helper.Pointer("user"),
+			Email:            helper.Pointer("user@mail.com"),
+			TokensValidAfter: &revokedAt,
+		}, nil).Once()
+		list := denylist.New(repoMock, userMock, time.Hour, time.Hour)
+
+		// CODE UNDER TEST
+		before, err := list.User(context.TODO(), "user")
+		require.NoError(t, err)
+		err = list.Reload(context.TODO(), "user")
+		require.NoError(t, err)
+		after, err := list.User(context.TODO(), "user")
+		require.NoError(t, err)
+
+		// EXPECTATION
+		require.Nil(t, before)
+		require.Equal(t, &model.User{Id: helper.Pointer("user"), TokensValidAfter: &revokedAt}, after)
+
+		repoMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+}
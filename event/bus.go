@@ -0,0 +1,131 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"tempo/helper"
+	"tempo/model"
+
+	"github.com/segmentio/ksuid"
+)
+
+type Handler func(ctx context.Context, e model.Event) error
+
+type Bus interface {
+	Publish(ctx context.Context, e model.Event)
+	Subscribe(eventType model.EventType, handler Handler)
+}
+
+func New(eventType model.EventType, data interface{}) model.Event {
+	return model.Event{
+		Id:         ksuid.New().String(),
+		Type:       eventType,
+		OccurredAt: time.Now().UTC(),
+		Data:       data,
+	}
+}
+
+type delivery struct {
+	ctx   context.Context
+	event model.Event
+}
+
+// AsyncBus dispatch events to their handlers on a pool of background workers so publishing never wait for them.
+// When the queue is full the event is dropped and logged rather than slowing down the publisher.
+type AsyncBus struct {
+	mu       sync.RWMutex
+	handlers map[model.EventType][]Handler
+	queue    chan delivery
+	workers  int
+	closed   bool
+	wg       sync.WaitGroup
+}
+
+func NewAsyncBus(workers int, queueSize int) *AsyncBus {
+	if workers <= 0 {
+		workers = 1
+	}
+
+	return &AsyncBus{
+		handlers: map[model.EventType][]Handler{},
+		queue:    make(chan delivery, queueSize),
+		workers:  workers,
+	}
+}
+
+func (b *AsyncBus) Subscribe(eventType model.EventType, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+}
+
+func (b *AsyncBus) Publish(ctx context.Context, e model.Event) {
+	logger := helper.GetLogger(ctx).WithField("method", "event.AsyncBus.Publish").WithField("eventType", e.Type)
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.closed {
+		logger.Warning("Event bus is closed, dropping event")
+		return
+	}
+
+	select {
+	case b.queue <- delivery{ctx: helper.DetachContext(ctx), event: e}:
+	default:
+		logger.Error("Event queue is full, dropping event")
+	}
+}
+
+func (b *AsyncBus) Start() {
+	for i := 0; i < b.workers; i++ {
+		b.wg.Add(1)
+		go b.work()
+	}
+}
+
+// Close stop accepting events and wait for the queued ones to be handled
+func (b *AsyncBus) Close() {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return
+	}
+	b.closed = true
+	close(b.queue)
+	b.mu.Unlock()
+
+	b.wg.Wait()
+}
+
+func (b *AsyncBus) work() {
+	defer b.wg.Done()
+
+	for d := range b.queue {
+		b.mu.RLock()
+		handlers := b.handlers[d.event.Type]
+		b.mu.RUnlock()
+
+		for _, h := range handlers {
+			b.handle(d, h)
+		}
+	}
+}
+
+func (b *AsyncBus) handle(d delivery, h Handler) {
+	logger := helper.GetLogger(d.ctx).WithField("method", "event.AsyncBus.handle").WithField("eventType", d.event.Type)
+
+	defer func() {
+		if r := recover(); r != nil {
+			logger.WithError(fmt.Errorf("%v", r)).Error("Event handler panicked")
+		}
+	}()
+
+	if err := h(d.ctx, d.event); err != nil {
+		logger.WithError(err).Warning("Failed handle event")
+	}
+}
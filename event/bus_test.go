@@ -0,0 +1,87 @@
+package event_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"tempo/event"
+	"tempo/model"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsyncBus_Publish(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldDeliverEventToSubscribers", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		var mu sync.Mutex
+		var received []model.Event
+		bus := event.NewAsyncBus(2, 10)
+		bus.Subscribe(model.EventNewsCreated, func(ctx context.Context, e model.Event) error {
+			mu.Lock()
+			defer mu.Unlock()
+			received = append(received, e)
+			return nil
+		})
+		bus.Start()
+
+		// CODE UNDER TEST
+		bus.Publish(context.Background(), event.New(model.EventNewsCreated, "news"))
+		bus.Publish(context.Background(), event.New(model.EventNewsUpdated, "news"))
+		bus.Close()
+
+		// EXPECTATION
+		require.Len(t, received, 1)
+		require.Equal(t, model.EventNewsCreated, received[0].Type)
+		require.Equal(t, "news", received[0].Data)
+	})
+
+	t.Run("ShouldKeepWorking_WhenHandlerFailsOrPanics", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		var mu sync.Mutex
+		calls := 0
+		bus := event.NewAsyncBus(1, 10)
+		bus.Subscribe(model.EventNewsCreated, func(ctx context.Context, e model.Event) error {
+			panic("boom")
+		})
+		bus.Subscribe(model.EventNewsCreated, func(ctx context.Context, e model.Event) error {
+			mu.Lock()
+			defer mu.Unlock()
+			calls++
+			return errors.New("error handle")
+		})
+		bus.Start()
+
+		// CODE UNDER TEST
+		bus.Publish(context.Background(), event.New(model.EventNewsCreated, nil))
+		bus.Publish(context.Background(), event.New(model.EventNewsCreated, nil))
+		bus.Close()
+
+		// EXPECTATION
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("ShouldDropEvent_WhenQueueIsFull", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		calls := 0
+		bus := event.NewAsyncBus(1, 1)
+		bus.Subscribe(model.EventNewsCreated, func(ctx context.Context, e model.Event) error {
+			calls++
+			return nil
+		})
+
+		// CODE UNDER TEST
+		bus.Publish(context.Background(), event.New(model.EventNewsCreated, nil))
+		bus.Publish(context.Background(), event.New(model.EventNewsCreated, nil))
+		bus.Start()
+		bus.Close()
+
+		// EXPECTATION
+		require.Equal(t, 1, calls)
+	})
+}
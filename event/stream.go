@@ -0,0 +1,134 @@
+package event
+
+import (
+	"context"
+	"sync"
+
+	"tempo/model"
+)
+
+// StreamEntry is an event numbered in the order the stream received it, the sequence is what clients resume from
+type StreamEntry struct {
+	Seq   int64
+	Event model.Event
+}
+
+// StreamFilter tell whether a subscriber want the event
+type StreamFilter func(e model.Event) bool
+
+type streamSubscriber struct {
+	ch     chan StreamEntry
+	filter StreamFilter
+}
+
+// Stream fan out the events it is subscribed to to any number of live subscribers, keeping the latest ones in a bounded
+// ring buffer so a reconnecting subscriber can catch up. A subscriber that does not keep up is disconnected rather
+// than slowing down the others, it is expected to reconnect from the last sequence it handled.
+type Stream struct {
+	mu          sync.Mutex
+	buffer      []StreamEntry
+	next        int
+	seq         int64
+	clientSize  int
+	subscribers map[*streamSubscriber]struct{}
+}
+
+func NewStream(bufferSize int, clientBufferSize int) *Stream {
+	if bufferSize <= 0 {
+		bufferSize = 1
+	}
+	if clientBufferSize <= 0 {
+		clientBufferSize = 1
+	}
+
+	return &Stream{
+		buffer:      make([]StreamEntry, 0, bufferSize),
+		clientSize:  clientBufferSize,
+		subscribers: map[*streamSubscriber]struct{}{},
+	}
+}
+
+// Listen register the stream on the bus for the event types
+func (s *Stream) Listen(bus Bus, eventTypes ...model.EventType) {
+	for _, v := range eventTypes {
+		bus.Subscribe(v, s.handle)
+	}
+}
+
+func (s *Stream) handle(ctx context.Context, e model.Event) error {
+	s.Push(e)
+	return nil
+}
+
+// Push number the event, keep it in the buffer and hand it to the subscribers
+func (s *Stream) Push(e model.Event) StreamEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.seq++
+	entry := StreamEntry{Seq: s.seq, Event: e}
+	if len(s.buffer) < cap(s.buffer) {
+		s.buffer = append(s.buffer, entry)
+	} else {
+		s.buffer[s.next] = entry
+		s.next = (s.next + 1) % len(s.buffer)
+	}
+
+	for sub := range s.subscribers {
+		if sub.filter != nil && !sub.filter(e) {
+			continue
+		}
+
+		select {
+		case sub.ch <- entry:
+		default:
+			// too slow, the subscriber will resume from its last sequence
+			delete(s.subscribers, sub)
+			close(sub.ch)
+		}
+	}
+
+	return entry
+}
+
+// Subscribe return the buffered entries after lastSeq, matching the filter, followed by a channel of the live ones.
+// The channel is closed when the subscriber fall behind, and it must be released with the returned func.
+func (s *Stream) Subscribe(lastSeq int64, filter StreamFilter) ([]StreamEntry, <-chan StreamEntry, func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if lastSeq > s.seq {
+		// the sequence is from before a restart, all the buffered entries are new to the subscriber
+		lastSeq = 0
+	}
+
+	var replay []StreamEntry
+	for i := 0; i < len(s.buffer); i++ {
+		entry := s.buffer[(s.next+i)%len(s.buffer)]
+		if entry.Seq <= lastSeq {
+			continue
+		}
+		if filter != nil && !filter(entry.Event) {
+			continue
+		}
+		replay = append(replay, entry)
+	}
+
+	sub := &streamSubscriber{
+		ch:     make(chan StreamEntry, s.clientSize),
+		filter: filter,
+	}
+	s.subscribers[sub] = struct{}{}
+
+	unsubscribe := func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if _, ok := s.subscribers[sub]; ok {
+			delete(s.subscribers, sub)
+			close(sub.ch)
+		}
+	}
+
+	return replay, sub.ch, unsubscribe
+}
@@ -0,0 +1,124 @@
+package event_test
+
+import (
+	"context"
+	"testing"
+
+	"tempo/event"
+	"tempo/model"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStream_Subscribe(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReplayOnlyTheBufferedEntriesAfterLastSeq", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		stream := event.NewStream(3, 10)
+		for i := 0; i < 5; i++ {
+			stream.Push(event.New(model.EventNewsCreated, i))
+		}
+
+		// CODE UNDER TEST
+		all, _, unsubscribeAll := stream.Subscribe(0, nil)
+		defer unsubscribeAll()
+		after, _, unsubscribeAfter := stream.Subscribe(4, nil)
+		defer unsubscribeAfter()
+
+		// EXPECTATION
+		require.Len(t, all, 3)
+		require.Equal(t, int64(3), all[0].Seq)
+		require.Equal(t, int64(5), all[2].Seq)
+		require.Equal(t, 4, all[2].Event.Data)
+		require.Len(t, after, 1)
+		require.Equal(t, int64(5), after[0].Seq)
+	})
+
+	t.Run("ShouldReplayEverything_WhenLastSeqIsFromBeforeARestart", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		stream := event.NewStream(3, 10)
+		stream.Push(event.New(model.EventNewsCreated, nil))
+
+		// CODE UNDER TEST
+		replay, _, unsubscribe := stream.Subscribe(100, nil)
+		defer unsubscribe()
+
+		// EXPECTATION
+		require.Len(t, replay, 1)
+	})
+
+	t.Run("ShouldOnlySendMatchingEntries_WhenFilterIsSet", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		stream := event.NewStream(10, 10)
+		stream.Push(event.New(model.EventNewsCreated, "a"))
+		filter := func(e model.Event) bool {
+			return e.Data == "a"
+		}
+
+		// CODE UNDER TEST
+		replay, ch, unsubscribe := stream.Subscribe(0, filter)
+		defer unsubscribe()
+		stream.Push(event.New(model.EventNewsCreated, "b"))
+		stream.Push(event.New(model.EventNewsUpdated, "a"))
+
+		// EXPECTATION
+		require.Len(t, replay, 1)
+		entry := <-ch
+		require.Equal(t, int64(3), entry.Seq)
+		require.Equal(t, model.EventNewsUpdated, entry.Event.Type)
+		require.Len(t, ch, 0)
+	})
+
+	t.Run("ShouldCloseTheChannel_WhenSubscriberFallsBehind", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		stream := event.NewStream(10, 2)
+		_, slow, unsubscribeSlow := stream.Subscribe(0, nil)
+		defer unsubscribeSlow()
+		_, fast, unsubscribeFast := stream.Subscribe(0, nil)
+		defer unsubscribeFast()
+
+		// CODE UNDER TEST
+		stream.Push(event.New(model.EventNewsCreated, nil))
+		stream.Push(event.New(model.EventNewsCreated, nil))
+		<-fast
+		<-fast
+		stream.Push(event.New(model.EventNewsCreated, nil))
+
+		// EXPECTATION
+		var received []int64
+		for v := range slow {
+			received = append(received, v.Seq)
+		}
+		require.Equal(t, []int64{1, 2}, received)
+		entry, ok := <-fast
+		require.True(t, ok)
+		require.Equal(t, int64(3), entry.Seq)
+	})
+}
+
+func TestStream_Listen(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldPushTheEventsOfTheBus", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		bus := event.NewAsyncBus(1, 10)
+		stream := event.NewStream(10, 10)
+		stream.Listen(bus, model.EventNewsCreated)
+		bus.Start()
+
+		// CODE UNDER TEST
+		bus.Publish(context.Background(), event.New(model.EventNewsCreated, "news"))
+		bus.Publish(context.Background(), event.New(model.EventUserRegistered, "user"))
+		bus.Close()
+
+		// EXPECTATION
+		replay, _, unsubscribe := stream.Subscribe(0, nil)
+		defer unsubscribe()
+		require.Len(t, replay, 1)
+		require.Equal(t, "news", replay[0].Event.Data)
+	})
+}
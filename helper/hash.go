@@ -1,10 +1,15 @@
 package helper
 
 import (
+	"crypto/hmac"
+	"crypto/rand"
 	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 )
 
+// Hash is the legacy SHA-1 password hash, the passwords are now hashed by the password package, which still verify it
 func Hash(salt string, password string) string {
 	s := fmt.Sprintf("_%s+%s_", salt, password)
 	hash := sha1.New()
@@ -12,3 +17,25 @@ func Hash(salt string, password string) string {
 	s = fmt.Sprintf("%x", hash.Sum(nil))
 	return s
 }
+
+// HmacSha256 return the hex encoded HMAC-SHA256 of the message
+func HmacSha256(secret string, message []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(message)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Sha256 return the hex encoded SHA-256 of the message
+func Sha256(message string) string {
+	sum := sha256.Sum256([]byte(message))
+	return hex.EncodeToString(sum[:])
+}
+
+// RandomToken return a hex encoded random token of n bytes
+func RandomToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -10,6 +10,7 @@ import (
 const (
 	ContextKeyRequestId   Key = "requestId"
 	ContextKeyJwtData     Key = "jwtData"
+	ContextKeyJwtClaims   Key = "jwtClaims"
 	ContextKeyTokenBearer Key = "tokenBearer"
 )
 
@@ -39,3 +40,17 @@ func ContextWithRequestId(ctx context.Context, requestId string) context.Context
 	}
 	return context.WithValue(defaultContext, ContextKeyRequestId, requestId)
 }
+
+// DetachContext return a new background context carrying only the request id of ctx,
+// so work started by a request can outlive it and still be traced back to it
+func DetachContext(ctx context.Context) context.Context {
+	reqId := ctx.Value(ContextKeyRequestId)
+	if reqId == nil {
+		reqId = ctx.Value(string(ContextKeyRequestId))
+	}
+	if reqId == nil {
+		return context.Background()
+	}
+
+	return context.WithValue(context.Background(), ContextKeyRequestId, reqId)
+}
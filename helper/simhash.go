@@ -0,0 +1,64 @@
+package helper
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"strings"
+	"unicode"
+)
+
+// simHashShingle is the number of consecutive words hashed together, so a reordered text is not seen as the same one
+const simHashShingle = 2
+
+// NormalizeText lower case the text and keep only its words, separated by a single space
+func NormalizeText(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	return strings.Join(words, " ")
+}
+
+// SimHash return the 64 bits fingerprint of the normalized text. Unlike a cryptographic hash, close texts get
+// fingerprints that differ by a few bits only, see HammingDistance.
+func SimHash(text string) uint64 {
+	words := strings.Fields(NormalizeText(text))
+	if len(words) == 0 {
+		return 0
+	}
+
+	var weights [64]int
+	n := len(words) - simHashShingle + 1
+	if n < 1 {
+		n = 1
+	}
+	for i := 0; i < n; i++ {
+		end := i + simHashShingle
+		if end > len(words) {
+			end = len(words)
+		}
+
+		h := fnv.New64a()
+		h.Write([]byte(strings.Join(words[i:end], " ")))
+		sum := h.Sum64()
+		for b := 0; b < 64; b++ {
+			if sum&(1<<uint(b)) != 0 {
+				weights[b]++
+			} else {
+				weights[b]--
+			}
+		}
+	}
+
+	var fingerprint uint64
+	for b := 0; b < 64; b++ {
+		if weights[b] > 0 {
+			fingerprint |= 1 << uint(b)
+		}
+	}
+	return fingerprint
+}
+
+// HammingDistance return the number of bits that differ between the fingerprints
+func HammingDistance(a uint64, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
@@ -0,0 +1,44 @@
+package helper_test
+
+import (
+	"testing"
+
+	"tempo/helper"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimHash(t *testing.T) {
+	t.Parallel()
+	pressRelease := "The city council approved on Monday a new budget of 4 million for the public libraries, " +
+		"which will extend their opening hours and renovate the oldest buildings of the northern district before next winter."
+
+	t.Run("ShouldReturnTheSameFingerprint_WhenOnlyCaseAndPunctuationDiffer", func(t *testing.T) {
+		t.Parallel()
+		// CODE UNDER TEST
+		a := helper.SimHash(pressRelease)
+		b := helper.SimHash("THE city council approved -- on Monday -- a new budget of 4 million for the public libraries; " +
+			"which will extend their opening hours and renovate the oldest buildings of the northern district before next winter!")
+
+		// EXPECTATION
+		require.Equal(t, a, b)
+	})
+
+	t.Run("ShouldReturnCloseFingerprints_WhenTextIsSlightlyEdited", func(t *testing.T) {
+		t.Parallel()
+		// CODE UNDER TEST
+		a := helper.SimHash(pressRelease)
+		b := helper.SimHash(pressRelease + " Press contact: the mayor office.")
+		c := helper.SimHash("Local football club wins the regional cup after a dramatic penalty shootout against its oldest rival.")
+
+		// EXPECTATION
+		require.Less(t, helper.HammingDistance(a, b), helper.HammingDistance(a, c))
+		require.LessOrEqual(t, helper.HammingDistance(a, b), 8)
+		require.Greater(t, helper.HammingDistance(a, c), 16)
+	})
+
+	t.Run("ShouldReturnZero_WhenTextHasNoWord", func(t *testing.T) {
+		t.Parallel()
+		require.Equal(t, uint64(0), helper.SimHash(" ... !! "))
+	})
+}
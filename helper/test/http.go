@@ -4,6 +4,8 @@ import (
 	"tempo/config"
 	"tempo/container"
 	"tempo/controller"
+	"tempo/jwtkey"
+	"tempo/password"
 
 	"net/http"
 	"testing"
@@ -26,6 +28,10 @@ func DefaultAppContainer() *container.Container {
 	appContainer := container.NewContainer()
 
 	appContainer.SetConfig(config.Instance())
+	appContainer.SetPasswordHasher(password.Argon2id{Memory: 1024, Time: 1, Threads: 1})
+	jwtKeys, _ := jwtkey.New(jwtkey.NewHmacKey([]byte(config.Instance().JwtSecret)))
+	appContainer.SetJwtKeys(jwtKeys)
+	appContainer.SetMailer(&Mailer{})
 
 	return appContainer
 }
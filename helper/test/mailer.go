@@ -0,0 +1,36 @@
+package test
+
+import (
+	"context"
+	"sync"
+
+	"tempo/mailer"
+)
+
+// Mailer capture the messages instead of sending them, failing every send with Err when it is set
+type Mailer struct {
+	Err error
+
+	mu       sync.Mutex
+	messages []mailer.Message
+}
+
+func (m *Mailer) Send(ctx context.Context, message mailer.Message) error {
+	if m.Err != nil {
+		return m.Err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages = append(m.messages, message)
+
+	return nil
+}
+
+// Messages return the messages sent so far, the oldest first
+func (m *Mailer) Messages() []mailer.Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]mailer.Message{}, m.messages...)
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/icrowley/fake"
+	"github.com/segmentio/ksuid"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
 )
@@ -85,12 +86,14 @@ func FakeJwtToken(t *testing.T, data *model.User) (string, model.User) {
 
 	jwtClaims := middleware.JWTData{
 		StandardClaims: jwt.StandardClaims{
+			Id:        ksuid.New().String(),
 			ExpiresAt: time.Now().Add(time.Hour).Unix(),
 		},
 		User: model.User{
 			Id:       data.Id,
 			Email:    data.Email,
 			FullName: data.FullName,
+			Role:     data.Role,
 		},
 	}
 
@@ -101,3 +104,29 @@ func FakeJwtToken(t *testing.T, data *model.User) (string, model.User) {
 	}
 	return accessToken, *data
 }
+
+func FakeBookmark(t *testing.T, cb func(bookmark model.Bookmark) model.Bookmark) model.Bookmark {
+	t.Helper()
+
+	fakeRp := model.Bookmark{
+		UserId: helper.Pointer(fake.CharactersN(7)),
+		NewsId: helper.Pointer(fake.CharactersN(7)),
+		Folder: helper.Pointer(fake.Word()),
+	}
+	if cb != nil {
+		fakeRp = cb(fakeRp)
+	}
+	return fakeRp
+}
+
+func FakeBookmarkCreate(t *testing.T, mysqlDB *gorm.DB, callback func(bookmark model.Bookmark) model.Bookmark) *model.Bookmark {
+	t.Helper()
+
+	fakeData := FakeBookmark(t, callback)
+
+	repo := mysqlrepo.NewBookmarkRepository(mysqlDB)
+	bookmark, err := repo.Upsert(context.TODO(), &fakeData)
+	require.NoError(t, err)
+
+	return bookmark
+}
@@ -0,0 +1,116 @@
+package helper
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	imageUrlPattern      = regexp.MustCompile(`(?i)https?://[^\s"'<>()\[\]]+\.(?:png|jpe?g|gif|webp)(?:\?[^\s"'<>()\[\]]*)?`)
+	markdownImagePattern = regexp.MustCompile(`!\[[^\]]*\]\([^)]*\)`)
+	markdownLinkPattern  = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+	htmlTagPattern       = regexp.MustCompile(`<[^>]*>`)
+)
+
+// spacelessCharactersPerWord is the average length of a word in the scripts written without spaces and counted by
+// syllables, like Thai or Khmer
+const spacelessCharactersPerWord = 4
+
+// FirstImageUrl return the first url of an image in the text, be it a bare link, a markdown image or an img tag
+func FirstImageUrl(text string) *string {
+	res := imageUrlPattern.FindString(text)
+	if res == "" {
+		return nil
+	}
+	return &res
+}
+
+// PlainText remove the markdown and html markup of the text, and collapse its spaces
+func PlainText(text string) string {
+	text = markdownImagePattern.ReplaceAllString(text, " ")
+	text = markdownLinkPattern.ReplaceAllString(text, "$1")
+	text = htmlTagPattern.ReplaceAllString(text, " ")
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// Excerpt return the beginning of the text as plain text in at most maxLength characters. It end on a sentence when
+// one end in the second half of the excerpt, else it is cut on a word.
+func Excerpt(text string, maxLength int) string {
+	text = PlainText(text)
+
+	runes := []rune(text)
+	if maxLength <= 0 || len(runes) <= maxLength {
+		return text
+	}
+
+	cut := runes[:maxLength-1]
+	for i := len(cut) - 1; i >= maxLength/2; i-- {
+		// a latin punctuation only end a sentence before a space, unlike in 3.5 or example.com
+		if isSentenceEnd(cut[i]) && (cut[i] > unicode.MaxLatin1 || unicode.IsSpace(runes[i+1])) {
+			return string(cut[:i+1])
+		}
+	}
+
+	res := string(cut)
+	if i := strings.LastIndex(res, " "); i > 0 {
+		res = res[:i]
+	}
+	return strings.TrimRight(res, " ,;:.-、，") + "…"
+}
+
+// CountWords return the number of words of the plain text, and how many of them are characters of the scripts
+// read by character, the CJK ideographs and the kana, each counting as a word. The other scripts written without
+// spaces count spacelessCharactersPerWord characters as a word.
+func CountWords(text string) (words int, characters int) {
+	inWord := false
+	spaceless := 0
+	flush := func() {
+		if spaceless > 0 {
+			words += (spaceless + spacelessCharactersPerWord - 1) / spacelessCharactersPerWord
+			spaceless = 0
+		}
+	}
+
+	for _, r := range text {
+		switch {
+		case isWideScript(r):
+			flush()
+			inWord = false
+			words++
+			characters++
+		case isSpacelessScript(r):
+			inWord = false
+			if unicode.IsLetter(r) {
+				spaceless++
+			}
+		case unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.IsMark(r):
+			flush()
+			if !inWord {
+				words++
+				inWord = true
+			}
+		case r == '\'' || r == '’' || r == '-':
+			// part of the word, like don't or well-known
+		default:
+			flush()
+			inWord = false
+		}
+	}
+	flush()
+
+	return words, characters
+}
+
+// isSentenceEnd tell whether the rune is a punctuation ending a sentence, the ideographic ones included
+func isSentenceEnd(r rune) bool {
+	return r == '.' || r == '!' || r == '?' || r == '。' || r == '！' || r == '？'
+}
+
+func isWideScript(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana)
+}
+
+func isSpacelessScript(r rune) bool {
+	return unicode.In(r, unicode.Thai, unicode.Lao, unicode.Khmer, unicode.Myanmar)
+}
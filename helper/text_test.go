@@ -0,0 +1,106 @@
+package helper_test
+
+import (
+	"testing"
+
+	"tempo/helper"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFirstImageUrl(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnTheFirstImage_WhenTextHasLinks", func(t *testing.T) {
+		t.Parallel()
+		// CODE UNDER TEST
+		res := helper.FirstImageUrl("Read https://tempo.test/about then ![the mayor](https://cdn.tempo.test/mayor.JPG?w=800) " +
+			`and <img src="https://cdn.tempo.test/council.png">`)
+
+		// EXPECTATION
+		require.NotNil(t, res)
+		require.Equal(t, "https://cdn.tempo.test/mayor.JPG?w=800", *res)
+	})
+
+	t.Run("ShouldReturnNil_WhenTextHasNoImage", func(t *testing.T) {
+		t.Parallel()
+		// CODE UNDER TEST
+		res := helper.FirstImageUrl("Read https://tempo.test/about")
+
+		// EXPECTATION
+		require.Nil(t, res)
+	})
+}
+
+func TestExcerpt(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldStripTheMarkup", func(t *testing.T) {
+		t.Parallel()
+		// CODE UNDER TEST
+		res := helper.Excerpt("![mayor](https://cdn.tempo.test/mayor.jpg)\n<p>The <b>council</b> approved the [budget](https://tempo.test/budget).</p>", 100)
+
+		// EXPECTATION
+		require.Equal(t, "The council approved the budget.", res)
+	})
+
+	t.Run("ShouldEndOnASentence_WhenOneEndInTheSecondHalf", func(t *testing.T) {
+		t.Parallel()
+		// CODE UNDER TEST
+		res := helper.Excerpt("The council approved the budget of 3.5 million. The libraries will open longer.", 60)
+
+		// EXPECTATION
+		require.Equal(t, "The council approved the budget of 3.5 million.", res)
+	})
+
+	t.Run("ShouldEndOnASentence_WhenTextIsJapanese", func(t *testing.T) {
+		t.Parallel()
+		// CODE UNDER TEST
+		res := helper.Excerpt("市議会は予算を承認した。図書館の開館時間が延長される。", 20)
+
+		// EXPECTATION
+		require.Equal(t, "市議会は予算を承認した。", res)
+	})
+
+	t.Run("ShouldCutOnAWord_WhenTextIsTooLong", func(t *testing.T) {
+		t.Parallel()
+		// CODE UNDER TEST
+		res := helper.Excerpt("The city council approved a new budget", 20)
+
+		// EXPECTATION
+		require.Equal(t, "The city council…", res)
+		require.LessOrEqual(t, len([]rune(res)), 20)
+	})
+}
+
+func TestCountWords(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldCountTheWords_WhenTextIsSeparatedBySpaces", func(t *testing.T) {
+		t.Parallel()
+		// CODE UNDER TEST
+		words, characters := helper.CountWords("The well-known council didn't approve the 2024 budget, again!")
+
+		// EXPECTATION
+		require.Equal(t, 9, words)
+		require.Equal(t, 0, characters)
+	})
+
+	t.Run("ShouldCountEachCharacter_WhenTextIsChinese", func(t *testing.T) {
+		t.Parallel()
+		// CODE UNDER TEST
+		words, characters := helper.CountWords("市议会批准了预算。Tempo news")
+
+		// EXPECTATION
+		require.Equal(t, 10, words)
+		require.Equal(t, 8, characters)
+	})
+
+	t.Run("ShouldEstimateTheWords_WhenTextIsThai", func(t *testing.T) {
+		t.Parallel()
+		// CODE UNDER TEST
+		words, characters := helper.CountWords("สภาเมืองอนุมัติงบประมาณ")
+
+		// EXPECTATION
+		require.Greater(t, words, 3)
+		require.Less(t, words, 8)
+		require.Equal(t, 0, characters)
+	})
+}
@@ -0,0 +1,73 @@
+package jwtkey
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"sort"
+)
+
+// JSONWebKey is the public part of a verification key, as in RFC 7517
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// N and E are the modulus and the exponent of an RSA key
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Crv and X, with Y for an EC key, are the curve and the coordinates of an EC or OKP key
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// Jwks return the public keys verifying the tokens, ordered by kid. The HMAC secret is never published
+func (k *Keyring) Jwks() JSONWebKeySet {
+	res := JSONWebKeySet{Keys: []JSONWebKey{}}
+	for _, v := range k.keys {
+		if v.isHmac() {
+			continue
+		}
+		res.Keys = append(res.Keys, v.jwk())
+	}
+	sort.Slice(res.Keys, func(i, j int) bool {
+		return res.Keys[i].Kid < res.Keys[j].Kid
+	})
+
+	return res
+}
+
+func (k *Key) jwk() JSONWebKey {
+	res := JSONWebKey{Kid: k.Id, Use: "sig", Alg: k.Method.Alg()}
+
+	switch v := k.public.(type) {
+	case *rsa.PublicKey:
+		res.Kty = "RSA"
+		res.N = encode(v.N.Bytes())
+		res.E = encode(big.NewInt(int64(v.E)).Bytes())
+	case *ecdsa.PublicKey:
+		// the coordinates are padded to the size of the curve
+		size := (v.Curve.Params().BitSize + 7) / 8
+		res.Kty = "EC"
+		res.Crv = v.Curve.Params().Name
+		res.X = encode(v.X.FillBytes(make([]byte, size)))
+		res.Y = encode(v.Y.FillBytes(make([]byte, size)))
+	case ed25519.PublicKey:
+		res.Kty = "OKP"
+		res.Crv = "Ed25519"
+		res.X = encode(v)
+	}
+
+	return res
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,138 @@
+package jwtkey
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"tempo/config"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// Key sign or verify the tokens of its single algorithm. The HMAC key has no id, the tokens it signs have no kid
+type Key struct {
+	Id     string
+	Method jwt.SigningMethod
+	// private sign the tokens, it is nil for a verification only key
+	private interface{}
+	public  interface{}
+}
+
+// NewHmacKey return the key of the shared secret, signing and verifying the tokens with HS256
+func NewHmacKey(secret []byte) *Key {
+	return &Key{Method: jwt.SigningMethodHS256, private: secret, public: secret}
+}
+
+func (k *Key) isHmac() bool {
+	_, ok := k.Method.(*jwt.SigningMethodHMAC)
+	return ok
+}
+
+// Keyring sign the tokens with its signing key and verify them with the key of their kid. Several keys verify the
+// tokens during a rotation: the next key is published before it signs, and the previous one is kept until its tokens
+// expired
+type Keyring struct {
+	signing *Key
+	keys    map[string]*Key
+}
+
+// New return the keyring signing with the signing key, which verify too, and verifying with the others
+func New(signing *Key, verification ...*Key) (*Keyring, error) {
+	if signing.private == nil {
+		return nil, errors.New("the signing key has no private key")
+	}
+
+	keys := map[string]*Key{}
+	for _, v := range append([]*Key{signing}, verification...) {
+		if v.isHmac() != (v.Id == "") {
+			return nil, fmt.Errorf("the key %q must have an id unless it is the HMAC secret", v.Id)
+		}
+		if _, ok := keys[v.Id]; ok {
+			return nil, fmt.Errorf("duplicate key id %q", v.Id)
+		}
+		keys[v.Id] = v
+	}
+
+	return &Keyring{signing: signing, keys: keys}, nil
+}
+
+// NewFromConfig return the keyring signing with the configured algorithm. The HMAC secret only verify the tokens
+// without kid when it is the signing key, or when Jwt.VerifyHmac let the tokens signed before a move to an
+// asymmetric key through
+func NewFromConfig(cfg config.Config) (*Keyring, error) {
+	hmac := NewHmacKey([]byte(cfg.JwtSecret))
+
+	var verification []*Key
+	for _, v := range strings.Split(cfg.Jwt.PublicKeyFiles, ",") {
+		if strings.TrimSpace(v) == "" {
+			continue
+		}
+		id, path, found := strings.Cut(strings.TrimSpace(v), "=")
+		if !found || id == "" {
+			return nil, fmt.Errorf("public key file %q is not kid=path", v)
+		}
+		pemBytes, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		key, err := ParsePublicKey(id, pemBytes)
+		if err != nil {
+			return nil, err
+		}
+		verification = append(verification, key)
+	}
+
+	if cfg.Jwt.Algorithm == "" || cfg.Jwt.Algorithm == jwt.SigningMethodHS256.Alg() {
+		return New(hmac, verification...)
+	}
+
+	pemBytes, err := os.ReadFile(cfg.Jwt.PrivateKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	signing, err := ParsePrivateKey(cfg.Jwt.KeyId, pemBytes)
+	if err != nil {
+		return nil, err
+	}
+	if signing.Method.Alg() != cfg.Jwt.Algorithm {
+		return nil, fmt.Errorf("the private key is of %s, not of %s", signing.Method.Alg(), cfg.Jwt.Algorithm)
+	}
+	if cfg.Jwt.VerifyHmac {
+		verification = append(verification, hmac)
+	}
+
+	return New(signing, verification...)
+}
+
+// Sign the claims with the signing key, setting its kid in the header
+func (k *Keyring) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(k.signing.Method, claims)
+	if k.signing.Id != "" {
+		token.Header["kid"] = k.signing.Id
+	}
+
+	return token.SignedString(k.signing.private)
+}
+
+// Keyfunc return the key verifying the token, picked by its kid. The token must be signed with the algorithm of the
+// key, so a public key is never used as an HMAC secret and the none algorithm is never accepted
+func (k *Keyring) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid := ""
+	if v, ok := token.Header["kid"]; ok {
+		if kid, ok = v.(string); !ok || kid == "" {
+			return nil, errors.New("invalid key id")
+		}
+	}
+
+	key, ok := k.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	if token.Method == nil || token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+
+	return key.public, nil
+}
@@ -0,0 +1,266 @@
+package jwtkey_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"tempo/config"
+	"tempo/jwtkey"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/require"
+)
+
+func privatePem(t *testing.T, key interface{}) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func publicPem(t *testing.T, key interface{}) []byte {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func claims() jwt.StandardClaims {
+	return jwt.StandardClaims{Subject: "user", ExpiresAt: time.Now().Add(time.Minute).Unix()}
+}
+
+func verify(keys *jwtkey.Keyring, token string) error {
+	_, err := jwt.ParseWithClaims(token, &jwt.StandardClaims{}, keys.Keyfunc)
+	return err
+}
+
+func TestKeyring_Sign(t *testing.T) {
+	t.Parallel()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	for alg, private := range map[string]interface{}{"RS256": rsaKey, "ES256": ecKey, "EdDSA": edKey} {
+		alg, private := alg, private
+		t.Run("ShouldSignAndVerifyWithTheKid_"+alg, func(t *testing.T) {
+			t.Parallel()
+			// INIT
+			key, err := jwtkey.ParsePrivateKey("key-1", privatePem(t, private))
+			require.NoError(t, err)
+			keys, err := jwtkey.New(key)
+			require.NoError(t, err)
+
+			// CODE UNDER TEST
+			token, err := keys.Sign(claims())
+			require.NoError(t, err)
+
+			// EXPECTATION
+			parsed, _, err := new(jwt.Parser).ParseUnverified(token, &jwt.StandardClaims{})
+			require.NoError(t, err)
+			require.Equal(t, alg, parsed.Header["alg"])
+			require.Equal(t, "key-1", parsed.Header["kid"])
+			require.NoError(t, verify(keys, token))
+		})
+	}
+}
+
+func TestKeyring_Keyfunc(t *testing.T) {
+	t.Parallel()
+	previousKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	nextKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	t.Run("ShouldVerifyTheTokensOfEveryKey_DuringARotation", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		previous, err := jwtkey.ParsePrivateKey("previous", privatePem(t, previousKey))
+		require.NoError(t, err)
+		previousKeys, err := jwtkey.New(previous)
+		require.NoError(t, err)
+		oldToken, err := previousKeys.Sign(claims())
+		require.NoError(t, err)
+
+		next, err := jwtkey.ParsePrivateKey("next", privatePem(t, nextKey))
+		require.NoError(t, err)
+		previousPublic, err := jwtkey.ParsePublicKey("previous", publicPem(t, &previousKey.PublicKey))
+		require.NoError(t, err)
+
+		// CODE UNDER TEST
+		keys, err := jwtkey.New(next, previousPublic)
+		require.NoError(t, err)
+		newToken, err := keys.Sign(claims())
+		require.NoError(t, err)
+
+		// EXPECTATION
+		require.NoError(t, verify(keys, oldToken))
+		require.NoError(t, verify(keys, newToken))
+		require.Error(t, verify(previousKeys, newToken))
+	})
+
+	t.Run("ShouldRejectTheToken_WhenSignedWithThePublicKeyAsHmacSecret", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		public, err := jwtkey.ParsePublicKey("previous", publicPem(t, &previousKey.PublicKey))
+		require.NoError(t, err)
+		private, err := jwtkey.ParsePrivateKey("next", privatePem(t, nextKey))
+		require.NoError(t, err)
+		keys, err := jwtkey.New(private, public)
+		require.NoError(t, err)
+
+		forged := jwt.NewWithClaims(jwt.SigningMethodHS256, claims())
+		forged.Header["kid"] = "previous"
+		token, err := forged.SignedString(publicPem(t, &previousKey.PublicKey))
+		require.NoError(t, err)
+
+		// CODE UNDER TEST
+		err = verify(keys, token)
+
+		// EXPECTATION
+		require.ErrorContains(t, err, "unexpected signing method")
+	})
+
+	t.Run("ShouldRejectTheToken_WhenKidIsUnknown", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		private, err := jwtkey.ParsePrivateKey("next", privatePem(t, nextKey))
+		require.NoError(t, err)
+		keys, err := jwtkey.New(private)
+		require.NoError(t, err)
+		otherKeys, err := jwtkey.New(jwtkey.NewHmacKey([]byte("secret")))
+		require.NoError(t, err)
+		withoutKid, err := otherKeys.Sign(claims())
+		require.NoError(t, err)
+
+		// CODE UNDER TEST
+		err = verify(keys, withoutKid)
+
+		// EXPECTATION
+		require.ErrorContains(t, err, "unknown key id")
+	})
+
+	t.Run("ShouldRejectTheNoneAlgorithm", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		keys, err := jwtkey.New(jwtkey.NewHmacKey([]byte("secret")))
+		require.NoError(t, err)
+		token, err := jwt.NewWithClaims(jwt.SigningMethodNone, claims()).SignedString(jwt.UnsafeAllowNoneSignatureType)
+		require.NoError(t, err)
+
+		// CODE UNDER TEST
+		err = verify(keys, token)
+
+		// EXPECTATION
+		require.ErrorContains(t, err, "unexpected signing method")
+	})
+}
+
+func TestKeyring_Jwks(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldPublishThePublicKeys_WithoutTheHmacSecret", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		edPublic, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		signing, err := jwtkey.ParsePrivateKey("b-ed", privatePem(t, edPrivate))
+		require.NoError(t, err)
+		ecPublic, err := jwtkey.ParsePublicKey("a-ec", publicPem(t, &ecKey.PublicKey))
+		require.NoError(t, err)
+		keys, err := jwtkey.New(signing, ecPublic, jwtkey.NewHmacKey([]byte("secret")))
+		require.NoError(t, err)
+
+		// CODE UNDER TEST
+		res := keys.Jwks()
+
+		// EXPECTATION
+		require.Len(t, res.Keys, 2)
+		require.Equal(t, jwtkey.JSONWebKey{
+			Kty: "EC", Kid: "a-ec", Use: "sig", Alg: "ES256", Crv: "P-256",
+			X: res.Keys[0].X, Y: res.Keys[0].Y,
+		}, res.Keys[0])
+		require.Len(t, res.Keys[0].X, 43)
+		require.Len(t, res.Keys[0].Y, 43)
+		require.Equal(t, "OKP", res.Keys[1].Kty)
+		require.Equal(t, "EdDSA", res.Keys[1].Alg)
+		require.Equal(t, "Ed25519", res.Keys[1].Crv)
+		require.Equal(t, base64.RawURLEncoding.EncodeToString(edPublic), res.Keys[1].X)
+	})
+}
+
+func TestNewFromConfig(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldLoadTheKeysFromThePemFiles", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		dir := t.TempDir()
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		previousKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "private.pem"), privatePem(t, rsaKey), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "previous.pem"), publicPem(t, &previousKey.PublicKey), 0600))
+
+		cfg := config.Config{JwtSecret: "secret"}
+		cfg.Jwt.Algorithm = "RS256"
+		cfg.Jwt.KeyId = "current"
+		cfg.Jwt.PrivateKeyFile = filepath.Join(dir, "private.pem")
+		cfg.Jwt.PublicKeyFiles = "previous=" + filepath.Join(dir, "previous.pem")
+
+		// CODE UNDER TEST
+		keys, err := jwtkey.NewFromConfig(cfg)
+		require.NoError(t, err)
+
+		// EXPECTATION
+		res := keys.Jwks()
+		require.Len(t, res.Keys, 2)
+		require.Equal(t, "current", res.Keys[0].Kid)
+		require.Equal(t, "previous", res.Keys[1].Kid)
+
+		// the tokens signed with the secret are only verified with VerifyHmac
+		hmacKeys, err := jwtkey.New(jwtkey.NewHmacKey([]byte("secret")))
+		require.NoError(t, err)
+		hmacToken, err := hmacKeys.Sign(claims())
+		require.NoError(t, err)
+		require.Error(t, verify(keys, hmacToken))
+
+		cfg.Jwt.VerifyHmac = true
+		keys, err = jwtkey.NewFromConfig(cfg)
+		require.NoError(t, err)
+		require.NoError(t, verify(keys, hmacToken))
+	})
+
+	t.Run("ShouldReturnError_WhenTheKeyIsNotOfTheAlgorithm", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		dir := t.TempDir()
+		ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "private.pem"), privatePem(t, ecKey), 0600))
+
+		cfg := config.Config{JwtSecret: "secret"}
+		cfg.Jwt.Algorithm = "RS256"
+		cfg.Jwt.KeyId = "current"
+		cfg.Jwt.PrivateKeyFile = filepath.Join(dir, "private.pem")
+
+		// CODE UNDER TEST
+		keys, err := jwtkey.NewFromConfig(cfg)
+
+		// EXPECTATION
+		require.EqualError(t, err, "the private key is of ES256, not of RS256")
+		require.Nil(t, keys)
+	})
+}
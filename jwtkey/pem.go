@@ -0,0 +1,98 @@
+package jwtkey
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// ParsePrivateKey return the signing key of the PEM private key, in PKCS #8, PKCS #1 or SEC 1 form. Its algorithm is
+// the one of the key type: RS256, ES256 or EdDSA
+func ParsePrivateKey(id string, pemBytes []byte) (*Key, error) {
+	if id == "" {
+		return nil, errors.New("the signing key has no id")
+	}
+
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, fmt.Errorf("the key %q is not PEM encoded", id)
+	}
+
+	var private interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		private, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		private, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		private, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("the key %q: %w", id, err)
+	}
+
+	switch v := private.(type) {
+	case *rsa.PrivateKey:
+		return newKey(id, v, &v.PublicKey)
+	case *ecdsa.PrivateKey:
+		return newKey(id, v, &v.PublicKey)
+	case ed25519.PrivateKey:
+		return newKey(id, v, v.Public())
+	default:
+		return nil, fmt.Errorf("the key %q is of an unsupported type %T", id, private)
+	}
+}
+
+// ParsePublicKey return the verification key of the PEM public key, in PKIX or PKCS #1 form or in a certificate
+func ParsePublicKey(id string, pemBytes []byte) (*Key, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, fmt.Errorf("the key %q is not PEM encoded", id)
+	}
+
+	var public interface{}
+	var err error
+	switch block.Type {
+	case "RSA PUBLIC KEY":
+		public, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "CERTIFICATE":
+		var cert *x509.Certificate
+		if cert, err = x509.ParseCertificate(block.Bytes); err == nil {
+			public = cert.PublicKey
+		}
+	default:
+		public, err = x509.ParsePKIXPublicKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("the key %q: %w", id, err)
+	}
+
+	return newKey(id, nil, public)
+}
+
+func newKey(id string, private interface{}, public interface{}) (*Key, error) {
+	var method jwt.SigningMethod
+	switch v := public.(type) {
+	case *rsa.PublicKey:
+		method = jwt.SigningMethodRS256
+	case *ecdsa.PublicKey:
+		if v.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("the key %q is not on the P-256 curve of ES256", id)
+		}
+		method = jwt.SigningMethodES256
+	case ed25519.PublicKey:
+		method = SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("the key %q is of an unsupported type %T", id, public)
+	}
+
+	return &Key{Id: id, Method: method, private: private, public: public}, nil
+}
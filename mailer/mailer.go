@@ -0,0 +1,98 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/smtp"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"tempo/config"
+)
+
+// Message is a plain text email
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer send the emails of the service. Send return once the message is handed over to the mail server
+type Mailer interface {
+	Send(ctx context.Context, message Message) error
+}
+
+type WriterMailer struct {
+	from string
+	w    io.Writer
+}
+
+// NewWriterMailer write the messages to w instead of sending them, typically stdout in development
+func NewWriterMailer(from string, w io.Writer) *WriterMailer {
+	return &WriterMailer{from: from, w: w}
+}
+
+func (m *WriterMailer) Send(ctx context.Context, message Message) error {
+	_, err := m.w.Write(format(m.from, message))
+	return err
+}
+
+type SmtpMailer struct {
+	from string
+	addr string
+	auth smtp.Auth
+}
+
+// NewSmtpMailer send the messages through the SMTP server at host:port, authenticating with PLAIN when a username is
+// set. net/smtp upgrade the connection with STARTTLS when the server support it
+func NewSmtpMailer(from string, host string, port int, username string, password string) *SmtpMailer {
+	m := &SmtpMailer{
+		from: from,
+		addr: net.JoinHostPort(host, strconv.Itoa(port)),
+	}
+	if username != "" {
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return m
+}
+
+func (m *SmtpMailer) Send(ctx context.Context, message Message) error {
+	return smtp.SendMail(m.addr, m.auth, m.from, []string{message.To}, format(m.from, message))
+}
+
+// format return the message as an RFC 5322 email. The header values are stripped of line breaks so a user input
+// can't add a header
+func format(from string, message Message) []byte {
+	header := strings.NewReplacer("\r", "", "\n", "")
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", header.Replace(from))
+	fmt.Fprintf(&b, "To: %s\r\n", header.Replace(message.To))
+	fmt.Fprintf(&b, "Subject: %s\r\n", header.Replace(message.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(message.Body, "\r\n", "\n"), "\n", "\r\n"))
+	b.WriteString("\r\n")
+
+	return []byte(b.String())
+}
+
+// NewFromConfig return the mailer of the configured driver, log writing the messages to stdout
+func NewFromConfig(cfg config.Config) (Mailer, error) {
+	switch cfg.Mail.Driver {
+	case "", "log":
+		return NewWriterMailer(cfg.Mail.From, os.Stdout), nil
+	case "smtp":
+		return NewSmtpMailer(cfg.Mail.From, cfg.Mail.SmtpHost, cfg.Mail.SmtpPort, cfg.Mail.SmtpUsername,
+			cfg.Mail.SmtpPassword), nil
+	default:
+		return nil, fmt.Errorf("unknown mail driver %q", cfg.Mail.Driver)
+	}
+}
@@ -0,0 +1,59 @@
+package mailer_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"tempo/config"
+	"tempo/mailer"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriterMailer_Send(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldWriteTheEmail_WithoutTheInjectedHeaders", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		var buf bytes.Buffer
+		m := mailer.NewWriterMailer("Tempo <no-reply@tempo.dev>", &buf)
+
+		// CODE UNDER TEST
+		err := m.Send(context.Background(), mailer.Message{
+			To:      "email@gmail.com\r\nBcc: other@gmail.com",
+			Subject: "Verify your email",
+			Body:    "line 1\nline 2",
+		})
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Contains(t, buf.String(), "From: Tempo <no-reply@tempo.dev>\r\n")
+		require.Contains(t, buf.String(), "To: email@gmail.comBcc: other@gmail.com\r\n")
+		require.Contains(t, buf.String(), "Subject: Verify your email\r\n")
+		require.Contains(t, buf.String(), "\r\n\r\nline 1\r\nline 2\r\n")
+	})
+}
+
+func TestNewFromConfig(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnTheMailerOfTheDriver", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		cfg := config.Config{}
+
+		// CODE UNDER TEST
+		logMailer, logErr := mailer.NewFromConfig(cfg)
+		cfg.Mail.Driver = "smtp"
+		smtpMailer, smtpErr := mailer.NewFromConfig(cfg)
+		cfg.Mail.Driver = "carrier-pigeon"
+		_, unknownErr := mailer.NewFromConfig(cfg)
+
+		// EXPECTATION
+		require.NoError(t, logErr)
+		require.IsType(t, &mailer.WriterMailer{}, logMailer)
+		require.NoError(t, smtpErr)
+		require.IsType(t, &mailer.SmtpMailer{}, smtpMailer)
+		require.EqualError(t, unknownErr, `unknown mail driver "carrier-pigeon"`)
+	})
+}
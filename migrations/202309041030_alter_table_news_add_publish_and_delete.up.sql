@@ -0,0 +1,5 @@
+ALTER TABLE news
+	ADD COLUMN published_at timestamp NULL DEFAULT NULL AFTER description,
+	ADD COLUMN deleted_at timestamp NULL DEFAULT NULL;
+
+UPDATE news SET published_at = created_at, updated_at = updated_at;
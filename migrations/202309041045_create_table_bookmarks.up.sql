@@ -0,0 +1,9 @@
+CREATE TABLE bookmarks (
+	id VARCHAR (255) PRIMARY KEY,
+	user_id VARCHAR (255) NOT NULL,
+	news_id VARCHAR (255) NOT NULL,
+	folder VARCHAR (100) NULL,
+	created_at timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE KEY uq_bookmarks_user_news (user_id, news_id),
+	KEY idx_bookmarks_user_created (user_id, created_at, id)
+);
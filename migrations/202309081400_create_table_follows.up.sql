@@ -0,0 +1,11 @@
+CREATE TABLE follows (
+	id VARCHAR (255) PRIMARY KEY,
+	follower_id VARCHAR (255) NOT NULL,
+	followee_id VARCHAR (255) NOT NULL,
+	created_at timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE KEY uq_follows_follower_followee (follower_id, followee_id),
+	KEY idx_follows_follower_created (follower_id, created_at, id),
+	KEY idx_follows_followee_created (followee_id, created_at, id)
+);
+
+ALTER TABLE news ADD INDEX idx_news_user_published (user_id, published_at, id);
@@ -0,0 +1,18 @@
+CREATE TABLE notifications (
+	id VARCHAR (255) PRIMARY KEY,
+	user_id VARCHAR (255) NOT NULL,
+	type VARCHAR (64) NOT NULL,
+	actor_id VARCHAR (255) NULL,
+	news_id VARCHAR (255) NULL,
+	read_at timestamp NULL DEFAULT NULL,
+	created_at timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+	KEY idx_notifications_user_created (user_id, created_at, id),
+	KEY idx_notifications_user_read (user_id, read_at)
+);
+
+CREATE TABLE notification_preferences (
+	user_id VARCHAR (255) NOT NULL,
+	type VARCHAR (64) NOT NULL,
+	enabled BOOLEAN NOT NULL DEFAULT TRUE,
+	PRIMARY KEY (user_id, type)
+);
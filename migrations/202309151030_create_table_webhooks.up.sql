@@ -0,0 +1,31 @@
+CREATE TABLE webhooks (
+	id VARCHAR (255) PRIMARY KEY,
+	url VARCHAR (2048) NOT NULL,
+	secret VARCHAR (255) NOT NULL,
+	event_types TEXT NOT NULL,
+	active BOOLEAN NOT NULL DEFAULT TRUE,
+	failure_count INT NOT NULL DEFAULT 0,
+	disabled_at timestamp NULL DEFAULT NULL,
+	created_by VARCHAR (255) NULL,
+	created_at timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+);
+
+CREATE TABLE webhook_deliveries (
+	id VARCHAR (255) PRIMARY KEY,
+	webhook_id VARCHAR (255) NOT NULL,
+	event_id VARCHAR (255) NOT NULL,
+	event_type VARCHAR (64) NOT NULL,
+	payload MEDIUMTEXT NOT NULL,
+	status VARCHAR (20) NOT NULL DEFAULT 'pending',
+	attempts INT NOT NULL DEFAULT 0,
+	next_attempt_at timestamp NULL DEFAULT NULL,
+	status_code INT NULL,
+	response_body TEXT NULL,
+	error TEXT NULL,
+	duration_ms BIGINT NULL,
+	created_at timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+	KEY idx_webhook_deliveries_webhook_created (webhook_id, created_at, id),
+	KEY idx_webhook_deliveries_due (status, next_attempt_at)
+);
@@ -0,0 +1,10 @@
+CREATE TABLE outbox (
+	id BIGINT AUTO_INCREMENT PRIMARY KEY,
+	event_id VARCHAR (255) UNIQUE NOT NULL,
+	event_type VARCHAR (64) NOT NULL,
+	aggregate_id VARCHAR (255) NOT NULL,
+	payload MEDIUMTEXT NOT NULL,
+	occurred_at timestamp(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+	dispatched_at timestamp NULL DEFAULT NULL,
+	KEY idx_outbox_pending (dispatched_at, id)
+);
@@ -0,0 +1,14 @@
+CREATE TABLE moderation_items (
+	id VARCHAR (255) PRIMARY KEY,
+	news_id VARCHAR (255) NOT NULL,
+	user_id VARCHAR (255) NOT NULL,
+	status VARCHAR (20) NOT NULL DEFAULT 'pending',
+	flags TEXT NOT NULL,
+	resolved_by VARCHAR (255) NULL,
+	resolved_at timestamp NULL DEFAULT NULL,
+	note TEXT NULL,
+	created_at timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+	KEY idx_moderation_items_status_created (status, created_at, id),
+	KEY idx_moderation_items_news (news_id)
+);
@@ -0,0 +1,2 @@
+ALTER TABLE news
+	ADD COLUMN fingerprint BIGINT UNSIGNED NULL AFTER description;
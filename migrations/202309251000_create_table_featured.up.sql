@@ -0,0 +1,19 @@
+CREATE TABLE featured_items (
+	slot VARCHAR (50) NOT NULL,
+	news_id VARCHAR (255) NOT NULL,
+	position INT NOT NULL,
+	expires_at timestamp NULL DEFAULT NULL,
+	pinned_by VARCHAR (255) NOT NULL,
+	pinned_at timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (slot, news_id),
+	KEY idx_featured_items_slot_position (slot, position)
+);
+
+CREATE TABLE featured_audits (
+	id VARCHAR (255) PRIMARY KEY,
+	slot VARCHAR (50) NOT NULL,
+	user_id VARCHAR (255) NOT NULL,
+	items TEXT NOT NULL,
+	created_at timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+	KEY idx_featured_audits_slot_created (slot, created_at, id)
+);
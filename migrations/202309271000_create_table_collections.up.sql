@@ -0,0 +1,20 @@
+CREATE TABLE collections (
+	id VARCHAR (255) PRIMARY KEY,
+	user_id VARCHAR (255) NOT NULL,
+	title VARCHAR (255) NOT NULL,
+	description TEXT NULL,
+	version INT NOT NULL DEFAULT 0,
+	created_at timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+	KEY idx_collections_user (user_id)
+);
+
+CREATE TABLE collection_items (
+	collection_id VARCHAR (255) NOT NULL,
+	news_id VARCHAR (255) NOT NULL,
+	position INT NOT NULL,
+	added_at timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (collection_id, news_id),
+	KEY idx_collection_items_position (collection_id, position),
+	KEY idx_collection_items_news (news_id)
+);
@@ -0,0 +1,12 @@
+CREATE TABLE news_authors (
+	news_id VARCHAR (255) NOT NULL,
+	user_id VARCHAR (255) NOT NULL,
+	role VARCHAR (20) NOT NULL,
+	invited_by VARCHAR (255) NULL,
+	created_at timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (news_id, user_id),
+	KEY idx_news_authors_user (user_id)
+);
+
+INSERT INTO news_authors (news_id, user_id, role, created_at)
+SELECT id, user_id, 'owner', created_at FROM news;
@@ -0,0 +1,28 @@
+CREATE TABLE news_reports (
+	id VARCHAR (255) PRIMARY KEY,
+	news_id VARCHAR (255) NOT NULL,
+	user_id VARCHAR (255) NOT NULL,
+	reason VARCHAR (30) NOT NULL,
+	note TEXT NULL,
+	resolution_id VARCHAR (255) NULL,
+	created_at timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+	KEY idx_news_reports_news_resolution (news_id, resolution_id),
+	KEY idx_news_reports_resolution (resolution_id, news_id)
+);
+
+CREATE TABLE news_report_resolutions (
+	id VARCHAR (255) PRIMARY KEY,
+	news_id VARCHAR (255) NOT NULL,
+	action VARCHAR (20) NOT NULL,
+	report_count INT NOT NULL,
+	resolved_by VARCHAR (255) NOT NULL,
+	note TEXT NULL,
+	created_at timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+	KEY idx_news_report_resolutions_news_created (news_id, created_at)
+);
+
+CREATE TABLE news_report_holds (
+	news_id VARCHAR (255) PRIMARY KEY,
+	published_at timestamp NULL DEFAULT NULL,
+	created_at timestamp NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,17 @@
+ALTER TABLE users
+	ADD COLUMN suspended_at timestamp NULL DEFAULT NULL,
+	ADD COLUMN suspended_reason TEXT NULL,
+	ADD COLUMN suspended_by VARCHAR (255) NULL,
+	ADD COLUMN password_reset_required TINYINT (1) NOT NULL DEFAULT 0,
+	ADD COLUMN tokens_valid_after timestamp NULL DEFAULT NULL,
+	ADD KEY idx_users_created (created_at, id);
+
+CREATE TABLE user_admin_actions (
+	id VARCHAR (255) PRIMARY KEY,
+	user_id VARCHAR (255) NOT NULL,
+	admin_id VARCHAR (255) NOT NULL,
+	action VARCHAR (30) NOT NULL,
+	reason TEXT NULL,
+	created_at timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+	KEY idx_user_admin_actions_user_created (user_id, created_at)
+);
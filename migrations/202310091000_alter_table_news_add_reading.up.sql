@@ -0,0 +1,4 @@
+ALTER TABLE news
+	ADD COLUMN excerpt TEXT NULL AFTER description,
+	ADD COLUMN word_count INT NULL AFTER excerpt,
+	ADD COLUMN reading_minutes INT NULL AFTER word_count;
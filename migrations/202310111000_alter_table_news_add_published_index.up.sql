@@ -0,0 +1,2 @@
+ALTER TABLE news
+	ADD KEY idx_news_published (published_at, deleted_at);
@@ -0,0 +1,13 @@
+CREATE TABLE refresh_tokens (
+	id VARCHAR (255) PRIMARY KEY,
+	user_id VARCHAR (255) NOT NULL,
+	family_id VARCHAR (255) NOT NULL,
+	token_hash CHAR (64) NOT NULL,
+	expires_at timestamp NOT NULL,
+	used_at timestamp NULL DEFAULT NULL,
+	revoked_at timestamp NULL DEFAULT NULL,
+	created_at timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE KEY uq_refresh_tokens_hash (token_hash),
+	KEY idx_refresh_tokens_family (family_id),
+	KEY idx_refresh_tokens_user (user_id)
+);
@@ -0,0 +1,8 @@
+CREATE TABLE revoked_tokens (
+	jti VARCHAR (255) PRIMARY KEY,
+	user_id VARCHAR (255) NOT NULL,
+	expires_at timestamp NOT NULL,
+	created_at timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+	KEY idx_revoked_tokens_created (created_at),
+	KEY idx_revoked_tokens_expires (expires_at)
+);
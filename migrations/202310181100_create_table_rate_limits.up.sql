@@ -0,0 +1,5 @@
+CREATE TABLE rate_limits (
+	bucket VARCHAR (255) PRIMARY KEY,
+	window_start timestamp NOT NULL,
+	hits INT NOT NULL
+);
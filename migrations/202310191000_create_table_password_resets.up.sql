@@ -0,0 +1,10 @@
+CREATE TABLE password_resets (
+	id VARCHAR (255) PRIMARY KEY,
+	user_id VARCHAR (255) NOT NULL,
+	token_hash CHAR (64) NOT NULL,
+	expires_at timestamp NOT NULL,
+	used_at timestamp NULL DEFAULT NULL,
+	created_at timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE KEY uq_password_resets_hash (token_hash),
+	KEY idx_password_resets_user (user_id)
+);
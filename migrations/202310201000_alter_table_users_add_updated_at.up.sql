@@ -0,0 +1,3 @@
+ALTER TABLE users
+	ADD COLUMN updated_at timestamp(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+	ADD KEY idx_users_updated (updated_at);
@@ -0,0 +1,2 @@
+ALTER TABLE news
+	ADD COLUMN tags varchar(512) NOT NULL DEFAULT '' AFTER description;
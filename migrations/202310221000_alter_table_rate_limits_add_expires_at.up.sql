@@ -0,0 +1,4 @@
+ALTER TABLE rate_limits
+	ADD COLUMN expires_at timestamp NULL DEFAULT NULL AFTER hits,
+	ADD KEY idx_rate_limits_expires_at (expires_at);
+UPDATE rate_limits SET expires_at = DATE_ADD(window_start, INTERVAL 1 DAY);
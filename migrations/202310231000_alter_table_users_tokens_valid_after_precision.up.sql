@@ -0,0 +1,2 @@
+ALTER TABLE users
+	MODIFY COLUMN tokens_valid_after timestamp(6) NULL DEFAULT NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE refresh_tokens
+	MODIFY COLUMN created_at timestamp(6) NULL DEFAULT CURRENT_TIMESTAMP(6);
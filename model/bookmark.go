@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type Bookmark struct {
+	Id        *string    `json:"id"`
+	UserId    *string    `json:"user_id"`
+	NewsId    *string    `json:"news_id"`
+	Folder    *string    `json:"folder"`
+	CreatedAt *time.Time `json:"created_at"`
+	News      *News      `json:"news,omitempty"`
+}
+
+func (b Bookmark) Validate() error {
+	return validation.ValidateStruct(
+		&b,
+		validation.Field(&b.UserId, validation.Required),
+		validation.Field(&b.NewsId, validation.Required),
+		validation.Field(&b.Folder, validation.Length(1, 100)),
+	)
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// Collection is an ordered series of news of an author, like a multi-part investigation
+type Collection struct {
+	Id          *string `json:"id"`
+	UserId      *string `json:"user_id"`
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+	// Version is incremented on every change of the items, a reorder must be made on the current version
+	Version   *int             `json:"version"`
+	Items     []CollectionItem `json:"items,omitempty"`
+	CreatedAt *time.Time       `json:"created_at"`
+	UpdatedAt *time.Time       `json:"updated_at"`
+}
+
+func (c Collection) Validate() error {
+	return validation.ValidateStruct(
+		&c,
+		validation.Field(&c.UserId, validation.Required),
+		validation.Field(&c.Title, validation.Required, validation.Length(1, 255)),
+		validation.Field(&c.Description, validation.Length(0, 2000)),
+	)
+}
+
+// CollectionItem is a news of the collection, deleted news are left out of the items
+type CollectionItem struct {
+	NewsId   *string    `json:"news_id"`
+	Position *int       `json:"position"`
+	AddedAt  *time.Time `json:"added_at"`
+	News     *News      `json:"news,omitempty"`
+}
+
+// NewsSeries place a news in a collection it belong to
+type NewsSeries struct {
+	CollectionId *string   `json:"collection_id"`
+	Title        *string   `json:"title"`
+	Position     *int      `json:"position"`
+	Previous     *NewsLink `json:"previous"`
+	Next         *NewsLink `json:"next"`
+}
+
+type NewsLink struct {
+	Id    *string `json:"id"`
+	Title *string `json:"title"`
+	Href  *string `json:"href"`
+}
@@ -7,11 +7,14 @@ import (
 
 const (
 	ErrorBadRequest          int = 400
+	ErrorUnauthenticated     int = 401
 	ErrorUnauthorized        int = 403
 	ErrorNotFound            int = 404
 	ErrorDuplicate           int = 409
 	ErrorUnprocessableEntity int = 422
+	ErrorTooManyRequests     int = 429
 	ErrorInternalServer      int = 500
+	ErrorServiceUnavailable  int = 503
 )
 
 type Error struct {
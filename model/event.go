@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+)
+
+type EventType string
+
+const (
+	EventNewsCreated    EventType = "news.created"
+	EventNewsUpdated    EventType = "news.updated"
+	EventUserRegistered EventType = "user.registered"
+	EventUserUpdated    EventType = "user.updated"
+
+	// EventNewsCommented and EventNewsReacted are internal, they are published with a NewsActivity by the comments
+	// and the reactions to notify the news author
+	EventNewsCommented EventType = "news.commented"
+	EventNewsReacted   EventType = "news.reacted"
+	// EventPasswordResetRequested is internal, it carry the email a reset link is asked for to the background workers
+	EventPasswordResetRequested EventType = "password_reset.requested"
+	// EventEmailVerificationRequested is internal, it carry the email a verification link is asked again for
+	EventEmailVerificationRequested EventType = "email_verification.requested"
+)
+
+// NewsActivity is the payload of the events of a user acting on a news
+type NewsActivity struct {
+	News    *News   `json:"news"`
+	ActorId *string `json:"actor_id"`
+}
+
+type Event struct {
+	Id         string      `json:"id"`
+	Type       EventType   `json:"type"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	Data       interface{} `json:"data"`
+}
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"regexp"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// featuredSlotFormat is the format of the slot names, like homepage_hero or sidebar
+var featuredSlotFormat = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// FeaturedItem is a news pinned to a slot, the slot list its items by position
+type FeaturedItem struct {
+	Slot      *string    `json:"slot"`
+	NewsId    *string    `json:"news_id"`
+	Position  *int       `json:"position"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	PinnedBy  *string    `json:"pinned_by"`
+	PinnedAt  *time.Time `json:"pinned_at"`
+}
+
+func (f FeaturedItem) Validate() error {
+	return validation.ValidateStruct(
+		&f,
+		validation.Field(&f.NewsId, validation.Required),
+		validation.Field(&f.ExpiresAt, validation.When(f.ExpiresAt != nil, validation.Min(time.Now()).Error("must be in the future"))),
+	)
+}
+
+// FeaturedNews is a news of a slot as it is shown
+type FeaturedNews struct {
+	News
+	Position  int        `json:"position"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// FeaturedAudit record who changed a slot and the items it was set to
+type FeaturedAudit struct {
+	Id        *string        `json:"id"`
+	Slot      *string        `json:"slot"`
+	UserId    *string        `json:"user_id"`
+	Items     []FeaturedItem `json:"items"`
+	CreatedAt *time.Time     `json:"created_at"`
+}
+
+// ValidateFeaturedSlot check the slot name
+func ValidateFeaturedSlot(slot string) error {
+	return validation.Validate(slot,
+		validation.Required,
+		validation.Length(1, 50),
+		validation.Match(featuredSlotFormat).Error("must be lower case letters, digits, _ or -"),
+	)
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type Follow struct {
+	Id         *string    `json:"id"`
+	FollowerId *string    `json:"follower_id"`
+	FolloweeId *string    `json:"followee_id"`
+	CreatedAt  *time.Time `json:"created_at"`
+	// User is the other side of the relation: the followee when listing who a user follows, the follower otherwise
+	User *PublicUser `json:"user,omitempty"`
+}
+
+func (f Follow) Validate() error {
+	return validation.ValidateStruct(
+		&f,
+		validation.Field(&f.FollowerId, validation.Required),
+		validation.Field(&f.FolloweeId, validation.Required),
+	)
+}
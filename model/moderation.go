@@ -0,0 +1,80 @@
+package model
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// ModerationVerdict is the outcome of a moderation check, ordered from the most lenient
+type ModerationVerdict string
+
+const (
+	ModerationAllow  ModerationVerdict = "allow"
+	ModerationFlag   ModerationVerdict = "flag"
+	ModerationReject ModerationVerdict = "reject"
+)
+
+// Severity order the verdicts so the strictest of a pipeline wins
+func (v ModerationVerdict) Severity() int {
+	switch v {
+	case ModerationReject:
+		return 2
+	case ModerationFlag:
+		return 1
+	default:
+		return 0
+	}
+}
+
+type ModerationResult struct {
+	Check   string            `json:"check"`
+	Verdict ModerationVerdict `json:"verdict"`
+	Reason  string            `json:"reason"`
+}
+
+type ModerationStatus string
+
+const (
+	ModerationPending  ModerationStatus = "pending"
+	ModerationApproved ModerationStatus = "approved"
+	ModerationRemoved  ModerationStatus = "removed"
+)
+
+var ModerationStatuses = []ModerationStatus{
+	ModerationPending,
+	ModerationApproved,
+	ModerationRemoved,
+}
+
+func (s ModerationStatus) IsValid() bool {
+	for _, v := range ModerationStatuses {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// ModerationItem is a flagged news waiting for an admin to approve or remove it
+type ModerationItem struct {
+	Id         *string            `json:"id"`
+	NewsId     *string            `json:"news_id"`
+	UserId     *string            `json:"user_id"`
+	Status     *ModerationStatus  `json:"status"`
+	Flags      []ModerationResult `json:"flags"`
+	ResolvedBy *string            `json:"resolved_by"`
+	ResolvedAt *time.Time         `json:"resolved_at"`
+	Note       *string            `json:"note"`
+	CreatedAt  *time.Time         `json:"created_at"`
+	UpdatedAt  *time.Time         `json:"updated_at"`
+}
+
+func (m ModerationItem) Validate() error {
+	return validation.ValidateStruct(
+		&m,
+		validation.Field(&m.NewsId, validation.Required),
+		validation.Field(&m.UserId, validation.Required),
+		validation.Field(&m.Flags, validation.Required),
+	)
+}
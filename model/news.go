@@ -1,25 +1,128 @@
 package model
 
 import (
+	"math"
+	"regexp"
+	"strings"
 	"time"
 
+	"tempo/helper"
+
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
 type News struct {
-	Id          *string    `json:"id"`
-	Title       *string    `json:"title"`
-	Description *string    `json:"description"`
+	Id          *string `json:"id"`
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+	// Tags are lowercase words of letters, digits and dashes, normalized by NormalizeTags
+	Tags        []string   `json:"tags,omitempty"`
 	UserId      *string    `json:"user_id"`
+	PublishedAt *time.Time `json:"published_at"`
 	CreatedAt   *time.Time `json:"created_at"`
 	UpdatedAt   *time.Time `json:"updated_at"`
+	// Excerpt, WordCount and ReadingMinutes are computed from the description when the news is written
+	Excerpt        *string `json:"excerpt"`
+	WordCount      *int    `json:"word_count"`
+	ReadingMinutes *int    `json:"reading_minutes"`
+	// Fingerprint is the SimHash of the title and description, used to find the near duplicates
+	Fingerprint *uint64 `json:"-"`
+	// DuplicateOf is only set on the created news, with the ids of the existing near duplicates
+	DuplicateOf []string `json:"duplicate_of,omitempty"`
+	// Authors are the bylines of the news, the owner first
+	Authors []NewsAuthor `json:"authors,omitempty"`
+	// Series is only set on a single news, with its previous and next news in the collections it belong to
+	Series []NewsSeries `json:"series,omitempty"`
+}
+
+// NewsDuplicate is a news close to another one, Distance being the number of bits their fingerprints differ by
+type NewsDuplicate struct {
+	News
+	Distance int `json:"distance"`
 }
 
+// maxNewsTags bound the tags of a news
+const maxNewsTags = 10
+
+var newsTagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,31}$`)
+
 func (n News) Validate() error {
 	return validation.ValidateStruct(
 		&n,
 		validation.Field(&n.UserId, validation.Required),
 		validation.Field(&n.Title, validation.Required),
 		validation.Field(&n.Description, validation.Required),
+		validation.Field(&n.Tags, newsTagsRules()...),
+	)
+}
+
+// ValidateTags check the tags of an update, the other fields being optional
+func (n News) ValidateTags() error {
+	return validation.ValidateStruct(
+		&n,
+		validation.Field(&n.Tags, newsTagsRules()...),
 	)
 }
+
+func newsTagsRules() []validation.Rule {
+	return []validation.Rule{
+		validation.Length(0, maxNewsTags),
+		validation.Each(validation.Match(newsTagPattern).Error("must be lowercase letters, digits and dashes")),
+	}
+}
+
+// NormalizeTags lowercase and trim the tags, dropping the empty and repeated ones. Nil is kept nil, as the tags left
+// unchanged by an update
+func NormalizeTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+
+	res := make([]string, 0, len(tags))
+	seen := map[string]bool{}
+	for _, v := range tags {
+		tag := strings.ToLower(strings.TrimSpace(v))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		res = append(res, tag)
+	}
+
+	return res
+}
+
+// HasTag tell whether the news is tagged with the tag, compared as normalized
+func (n News) HasTag(tag string) bool {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	for _, v := range n.Tags {
+		if v == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// ComputeFingerprint return the SimHash of the title and description
+func (n News) ComputeFingerprint() uint64 {
+	return helper.SimHash(helper.Val(n.Title) + "\n" + helper.Val(n.Description))
+}
+
+// ComputeReading set the excerpt, word count and reading time of the news from its description. The characters of the
+// scripts read by character, like Chinese, are read at charactersPerMinute and the other words at wordsPerMinute.
+func (n *News) ComputeReading(excerptLength int, wordsPerMinute int, charactersPerMinute int) {
+	text := helper.PlainText(helper.Val(n.Description))
+	words, characters := helper.CountWords(text)
+
+	minutes := 0.0
+	if wordsPerMinute > 0 {
+		minutes += float64(words-characters) / float64(wordsPerMinute)
+	}
+	if charactersPerMinute > 0 {
+		minutes += float64(characters) / float64(charactersPerMinute)
+	}
+
+	n.Excerpt = helper.Pointer(helper.Excerpt(text, excerptLength))
+	n.WordCount = helper.Pointer(words)
+	n.ReadingMinutes = helper.Pointer(int(math.Ceil(minutes)))
+}
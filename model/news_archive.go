@@ -0,0 +1,8 @@
+package model
+
+// NewsArchiveMonth is the number of news published in a month of the site time zone
+type NewsArchiveMonth struct {
+	Year  int   `json:"year"`
+	Month int   `json:"month"`
+	Count int64 `json:"count"`
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+)
+
+type NewsAuthorRole string
+
+const (
+	// NewsAuthorOwner manage the collaborators of the news, a news has exactly one owner
+	NewsAuthorOwner    NewsAuthorRole = "owner"
+	NewsAuthorCoAuthor NewsAuthorRole = "co_author"
+	// NewsAuthorEditor can update the news without being in its bylines
+	NewsAuthorEditor NewsAuthorRole = "editor"
+)
+
+// CanEdit tell whether the role allow to update the news
+func (r NewsAuthorRole) CanEdit() bool {
+	return r == NewsAuthorOwner || r == NewsAuthorCoAuthor || r == NewsAuthorEditor
+}
+
+// IsByline tell whether the collaborator is credited as an author of the news
+func (r NewsAuthorRole) IsByline() bool {
+	return r == NewsAuthorOwner || r == NewsAuthorCoAuthor
+}
+
+// NewsAuthor is a user collaborating on a news
+type NewsAuthor struct {
+	NewsId    *string         `json:"news_id"`
+	UserId    *string         `json:"user_id"`
+	FullName  *string         `json:"full_name"`
+	Role      *NewsAuthorRole `json:"role"`
+	InvitedBy *string         `json:"invited_by"`
+	CreatedAt *time.Time      `json:"created_at"`
+}
@@ -0,0 +1,88 @@
+package model
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type NewsReportReason string
+
+const (
+	NewsReportSpam           NewsReportReason = "spam"
+	NewsReportHarassment     NewsReportReason = "harassment"
+	NewsReportHate           NewsReportReason = "hate"
+	NewsReportMisinformation NewsReportReason = "misinformation"
+	NewsReportCopyright      NewsReportReason = "copyright"
+	NewsReportOther          NewsReportReason = "other"
+)
+
+// NewsReportAction is how a moderator resolve the open reports of a news
+type NewsReportAction string
+
+const (
+	// NewsReportDismiss keep the news, publishing it again when the reports hid it
+	NewsReportDismiss   NewsReportAction = "dismiss"
+	NewsReportUnpublish NewsReportAction = "unpublish"
+	NewsReportDelete    NewsReportAction = "delete"
+)
+
+func (a NewsReportAction) IsValid() bool {
+	switch a {
+	case NewsReportDismiss, NewsReportUnpublish, NewsReportDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewsReport is a user reporting a news, it stay open until a moderator resolve the reports of the news
+type NewsReport struct {
+	Id           *string           `json:"id"`
+	NewsId       *string           `json:"news_id"`
+	UserId       *string           `json:"user_id"`
+	Reason       *NewsReportReason `json:"reason"`
+	Note         *string           `json:"note"`
+	ResolutionId *string           `json:"resolution_id"`
+	CreatedAt    *time.Time        `json:"created_at"`
+}
+
+func (n NewsReport) Validate() error {
+	return validation.ValidateStruct(
+		&n,
+		validation.Field(&n.NewsId, validation.Required),
+		validation.Field(&n.UserId, validation.Required),
+		validation.Field(&n.Reason, validation.Required, validation.In(
+			NewsReportSpam,
+			NewsReportHarassment,
+			NewsReportHate,
+			NewsReportMisinformation,
+			NewsReportCopyright,
+			NewsReportOther,
+		)),
+		validation.Field(&n.Note, validation.Length(0, 1000)),
+	)
+}
+
+// NewsReportGroup is a news of the moderator queue with its open reports
+type NewsReportGroup struct {
+	NewsId      *string `json:"news_id"`
+	News        *News   `json:"news"`
+	ReportCount int     `json:"report_count"`
+	// Hidden tell whether the news was unpublished because of the number of reports, until a moderator resolve them
+	Hidden          bool         `json:"hidden"`
+	FirstReportedAt *time.Time   `json:"first_reported_at"`
+	LastReportedAt  *time.Time   `json:"last_reported_at"`
+	Reports         []NewsReport `json:"reports"`
+}
+
+// NewsReportResolution record the action a moderator took on the open reports of a news
+type NewsReportResolution struct {
+	Id          *string           `json:"id"`
+	NewsId      *string           `json:"news_id"`
+	Action      *NewsReportAction `json:"action"`
+	ReportCount *int              `json:"report_count"`
+	ResolvedBy  *string           `json:"resolved_by"`
+	Note        *string           `json:"note"`
+	CreatedAt   *time.Time        `json:"created_at"`
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+)
+
+type NotificationType string
+
+const (
+	NotificationFollowedAuthorPublished NotificationType = "followed_author_published"
+	NotificationNewsCommented           NotificationType = "news_commented"
+	NotificationNewsReacted             NotificationType = "news_reacted"
+)
+
+var NotificationTypes = []NotificationType{
+	NotificationFollowedAuthorPublished,
+	NotificationNewsCommented,
+	NotificationNewsReacted,
+}
+
+func (t NotificationType) IsValid() bool {
+	for _, v := range NotificationTypes {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
+
+type Notification struct {
+	Id        *string           `json:"id"`
+	UserId    *string           `json:"user_id"`
+	Type      *NotificationType `json:"type"`
+	ActorId   *string           `json:"actor_id"`
+	NewsId    *string           `json:"news_id"`
+	ReadAt    *time.Time        `json:"read_at"`
+	CreatedAt *time.Time        `json:"created_at"`
+}
+
+type NotificationPreference struct {
+	UserId  *string          `json:"-"`
+	Type    NotificationType `json:"type"`
+	Enabled bool             `json:"enabled"`
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// OutboxEvent is a domain event recorded in the same transaction as the change it describe.
+// Offset is its position in the outbox, events are relayed in offset order.
+type OutboxEvent struct {
+	Offset       *int64          `json:"offset"`
+	EventId      *string         `json:"id"`
+	Type         *EventType      `json:"type"`
+	AggregateId  *string         `json:"aggregate_id"`
+	Data         json.RawMessage `json:"data"`
+	OccurredAt   *time.Time      `json:"occurred_at"`
+	DispatchedAt *time.Time      `json:"-"`
+}
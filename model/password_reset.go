@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+)
+
+// PasswordReset let the user set a new password once with the token emailed to them. Only the hash of the token is
+// stored, the token itself is only in the email
+type PasswordReset struct {
+	Id        *string    `json:"id"`
+	UserId    *string    `json:"user_id"`
+	TokenHash *string    `json:"-"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+func (p PasswordReset) IsExpired(now time.Time) bool {
+	return p.ExpiresAt != nil && !now.Before(*p.ExpiresAt)
+}
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+)
+
+// RefreshToken renew the access token once, it is then rotated into a new token of the same family. Only the hash of
+// the token is stored, the token itself is only given to the user
+type RefreshToken struct {
+	Id        *string    `json:"id"`
+	UserId    *string    `json:"user_id"`
+	FamilyId  *string    `json:"family_id"`
+	TokenHash *string    `json:"-"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	// UsedAt is set when the token is rotated, a token used again is stolen and its whole family is revoked
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+func (r RefreshToken) IsExpired(now time.Time) bool {
+	return r.ExpiresAt != nil && !now.Before(*r.ExpiresAt)
+}
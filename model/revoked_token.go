@@ -0,0 +1,13 @@
+package model
+
+import (
+	"time"
+)
+
+// RevokedToken deny an access token by its jti until the token expires by itself, when the user logged out
+type RevokedToken struct {
+	Jti       *string    `json:"jti"`
+	UserId    *string    `json:"user_id"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	CreatedAt *time.Time `json:"created_at"`
+}
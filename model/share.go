@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+)
+
+// NewsPreview is what the link previews of a news show, the url being the one of the news on the public site
+type NewsPreview struct {
+	NewsId      string     `json:"news_id"`
+	Url         string     `json:"url"`
+	Title       string     `json:"title"`
+	Excerpt     string     `json:"excerpt"`
+	ImageUrl    *string    `json:"image_url"`
+	AuthorNames []string   `json:"author_names"`
+	PublishedAt *time.Time `json:"published_at"`
+}
@@ -0,0 +1,14 @@
+package model
+
+import (
+	"time"
+)
+
+// SitemapEntry is a news as the sitemaps list it, Listed is false once the news is unpublished or deleted
+type SitemapEntry struct {
+	NewsId      string
+	Title       string
+	PublishedAt time.Time
+	UpdatedAt   time.Time
+	Listed      bool
+}
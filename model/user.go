@@ -7,13 +7,81 @@ import (
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 )
 
+type UserRole string
+
+const (
+	UserRoleUser  UserRole = "user"
+	UserRoleAdmin UserRole = "admin"
+)
+
+var UserRoles = []UserRole{
+	UserRoleUser,
+	UserRoleAdmin,
+}
+
+func (r UserRole) IsValid() bool {
+	for _, v := range UserRoles {
+		if v == r {
+			return true
+		}
+	}
+	return false
+}
+
 type User struct {
 	Id           *string    `json:"id"`
 	Email        *string    `json:"email"`
 	FullName     *string    `json:"full_name"`
+	Role         *UserRole  `json:"role,omitempty"`
 	Password     *string    `json:"-"`
 	PasswordSalt *string    `json:"-"`
 	CreatedAt    *time.Time `json:"created_at"`
+	// SuspendedAt is set while an admin suspend the user, who can not login nor use their tokens
+	SuspendedAt     *time.Time `json:"suspended_at,omitempty"`
+	SuspendedReason *string    `json:"suspended_reason,omitempty"`
+	SuspendedBy     *string    `json:"suspended_by,omitempty"`
+	// TokensValidAfter reject the tokens issued before it, when the password was reset
+	TokensValidAfter *time.Time `json:"-"`
+	// EmailVerifiedAt is set once the user opened the verification link sent to the email, it is cleared when the
+	// email change
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+}
+
+// PublicUser is what any user can see of another user
+type PublicUser struct {
+	Id       *string `json:"id"`
+	FullName *string `json:"full_name"`
+}
+
+// Public return the user without their email, role and account state
+func (u User) Public() *PublicUser {
+	return &PublicUser{
+		Id:       u.Id,
+		FullName: u.FullName,
+	}
+}
+
+func (u User) IsSuspended() bool {
+	return u.SuspendedAt != nil
+}
+
+func (u User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// HasRole tell whether the user has one of the given roles, a user without role being a regular user
+func (u User) HasRole(roles ...UserRole) bool {
+	role := UserRoleUser
+	if u.Role != nil {
+		role = *u.Role
+	}
+
+	for _, v := range roles {
+		if v == role {
+			return true
+		}
+	}
+	return false
 }
 
 func (u User) Validate() error {
@@ -32,3 +100,27 @@ func (u User) ValidateLogin() error {
 		validation.Field(&u.Password, validation.Required, validation.Length(6, 64)),
 	)
 }
+
+type UserAdminActionType string
+
+const (
+	UserAdminSuspend       UserAdminActionType = "suspend"
+	UserAdminUnsuspend     UserAdminActionType = "unsuspend"
+	UserAdminResetPassword UserAdminActionType = "reset_password"
+)
+
+// UserAdminAction record an admin acting on a user account
+type UserAdminAction struct {
+	Id        *string              `json:"id"`
+	UserId    *string              `json:"user_id"`
+	AdminId   *string              `json:"admin_id"`
+	Action    *UserAdminActionType `json:"action"`
+	Reason    *string              `json:"reason"`
+	CreatedAt *time.Time           `json:"created_at"`
+}
+
+// UserDetail is a user as seen by the admins, with their latest actions on the account
+type UserDetail struct {
+	User
+	Actions []UserAdminAction `json:"actions"`
+}
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+)
+
+// WebhookEventTypes are the events a webhook can subscribe to
+var WebhookEventTypes = []EventType{
+	EventNewsCreated,
+	EventNewsUpdated,
+	EventUserRegistered,
+}
+
+type Webhook struct {
+	Id  *string `json:"id"`
+	Url *string `json:"url"`
+	// Secret is only returned when the webhook is created
+	Secret       *string     `json:"secret,omitempty"`
+	EventTypes   []EventType `json:"event_types"`
+	Active       *bool       `json:"active"`
+	FailureCount *int        `json:"failure_count"`
+	DisabledAt   *time.Time  `json:"disabled_at"`
+	CreatedBy    *string     `json:"created_by"`
+	CreatedAt    *time.Time  `json:"created_at"`
+	UpdatedAt    *time.Time  `json:"updated_at"`
+}
+
+func (w Webhook) Validate() error {
+	return validation.ValidateStruct(
+		&w,
+		validation.Field(&w.Url, validation.Required, is.URL, validation.Length(1, 2048)),
+		validation.Field(&w.Secret, validation.Length(16, 255)),
+		validation.Field(&w.EventTypes, validation.Required, validation.Each(validation.In(eventTypesToInterfaces(WebhookEventTypes)...))),
+	)
+}
+
+// ValidateUpdate validate the fields set on a partial update
+func (w Webhook) ValidateUpdate() error {
+	return validation.ValidateStruct(
+		&w,
+		validation.Field(&w.Url, validation.NilOrNotEmpty, is.URL, validation.Length(1, 2048)),
+		validation.Field(&w.Secret, validation.Length(16, 255)),
+		validation.Field(&w.EventTypes, validation.When(w.EventTypes != nil, validation.Required), validation.Each(validation.In(eventTypesToInterfaces(WebhookEventTypes)...))),
+	)
+}
+
+// Subscribed tell whether the webhook want to receive the event type
+func (w Webhook) Subscribed(eventType EventType) bool {
+	for _, v := range w.EventTypes {
+		if v == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func eventTypesToInterfaces(eventTypes []EventType) []interface{} {
+	res := make([]interface{}, 0, len(eventTypes))
+	for _, v := range eventTypes {
+		res = append(res, v)
+	}
+	return res
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+type WebhookDelivery struct {
+	Id            *string                `json:"id"`
+	WebhookId     *string                `json:"webhook_id"`
+	EventId       *string                `json:"event_id"`
+	EventType     *EventType             `json:"event_type"`
+	Payload       *string                `json:"payload"`
+	Status        *WebhookDeliveryStatus `json:"status"`
+	Attempts      *int                   `json:"attempts"`
+	NextAttemptAt *time.Time             `json:"next_attempt_at"`
+	StatusCode    *int                   `json:"status_code"`
+	ResponseBody  *string                `json:"response_body"`
+	Error         *string                `json:"error"`
+	DurationMs    *int64                 `json:"duration_ms"`
+	CreatedAt     *time.Time             `json:"created_at"`
+	UpdatedAt     *time.Time             `json:"updated_at"`
+}
@@ -0,0 +1,233 @@
+package moderation
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"tempo/model"
+	"tempo/repository"
+)
+
+var allow = model.ModerationResult{Verdict: model.ModerationAllow}
+
+type bannedPattern struct {
+	pattern *regexp.Regexp
+	verdict model.ModerationVerdict
+}
+
+// BannedWords match the content against a list of words and regular expressions
+type BannedWords struct {
+	patterns []bannedPattern
+}
+
+// LoadBannedWords read the list from a file with one entry per line. An entry is a word matched case insensitively
+// as a whole word, or a regular expression when prefixed by "regex:". The entries reject the content unless prefixed
+// by "flag:". Empty lines and lines starting with # are ignored.
+//
+//	casino
+//	flag:crypto
+//	regex:(?i)buy\s+now
+func LoadBannedWords(path string) (*BannedWords, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ParseBannedWords(f)
+}
+
+func ParseBannedWords(r io.Reader) (*BannedWords, error) {
+	res := &BannedWords{}
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		entry := strings.TrimSpace(scanner.Text())
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+
+		verdict := model.ModerationReject
+		if strings.HasPrefix(entry, "flag:") {
+			verdict = model.ModerationFlag
+			entry = strings.TrimPrefix(entry, "flag:")
+		}
+
+		expr := `(?i)\b` + regexp.QuoteMeta(entry) + `\b`
+		if strings.HasPrefix(entry, "regex:") {
+			expr = strings.TrimPrefix(entry, "regex:")
+		}
+		pattern, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("banned words line %d: %w", line, err)
+		}
+
+		res.patterns = append(res.patterns, bannedPattern{pattern: pattern, verdict: verdict})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (b *BannedWords) Name() string {
+	return "banned_words"
+}
+
+func (b *BannedWords) Check(ctx context.Context, content Content) (model.ModerationResult, error) {
+	res := allow
+	text := content.Text()
+	for _, v := range b.patterns {
+		match := v.pattern.FindString(text)
+		if match == "" || v.verdict.Severity() <= res.Verdict.Severity() {
+			continue
+		}
+
+		res = model.ModerationResult{
+			Verdict: v.verdict,
+			Reason:  fmt.Sprintf("contains the banned term %q", match),
+		}
+		if res.Verdict == model.ModerationReject {
+			break
+		}
+	}
+
+	return res, nil
+}
+
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+`)
+
+// LinkLimit flag the content with more than FlagAbove links and reject it above RejectAbove
+type LinkLimit struct {
+	FlagAbove   int
+	RejectAbove int
+}
+
+func (l LinkLimit) Name() string {
+	return "link_limit"
+}
+
+func (l LinkLimit) Check(ctx context.Context, content Content) (model.ModerationResult, error) {
+	count := len(linkPattern.FindAllString(content.Text(), -1))
+	switch {
+	case count > l.RejectAbove:
+		return model.ModerationResult{
+			Verdict: model.ModerationReject,
+			Reason:  fmt.Sprintf("contains %d links, at most %d are allowed", count, l.RejectAbove),
+		}, nil
+	case count > l.FlagAbove:
+		return model.ModerationResult{
+			Verdict: model.ModerationFlag,
+			Reason:  fmt.Sprintf("contains %d links", count),
+		}, nil
+	default:
+		return allow, nil
+	}
+}
+
+// SpamScore rate how much the content looks like spam from 0 to 1, flagging it from FlagScore and rejecting it from RejectScore
+type SpamScore struct {
+	FlagScore   float64
+	RejectScore float64
+}
+
+func (s SpamScore) Name() string {
+	return "spam_score"
+}
+
+func (s SpamScore) Check(ctx context.Context, content Content) (model.ModerationResult, error) {
+	score := Score(content.Text())
+	switch {
+	case score >= s.RejectScore:
+		return model.ModerationResult{
+			Verdict: model.ModerationReject,
+			Reason:  fmt.Sprintf("looks like spam, score %.2f", score),
+		}, nil
+	case score >= s.FlagScore:
+		return model.ModerationResult{
+			Verdict: model.ModerationFlag,
+			Reason:  fmt.Sprintf("looks like spam, score %.2f", score),
+		}, nil
+	default:
+		return allow, nil
+	}
+}
+
+// Score weight the share of capital letters, the exclamation marks and the long runs of a repeated character.
+// Short texts are only scored on the last two, a few capitals in them mean nothing.
+func Score(text string) float64 {
+	letters, upper, exclamations, runs := 0, 0, 0, 0
+
+	var prev rune
+	run := 0
+	for _, r := range text {
+		if unicode.IsLetter(r) {
+			letters++
+			if unicode.IsUpper(r) {
+				upper++
+			}
+		}
+		if r == '!' {
+			exclamations++
+		}
+
+		if r == prev {
+			run++
+			if run == 4 {
+				runs++
+			}
+		} else {
+			run = 1
+		}
+		prev = r
+	}
+
+	caps := 0.0
+	if letters >= 20 {
+		caps = float64(upper) / float64(letters)
+	}
+
+	return 0.6*caps + 0.2*ratio(exclamations, 5) + 0.2*ratio(runs, 3)
+}
+
+func ratio(n int, limit int) float64 {
+	if n >= limit {
+		return 1
+	}
+	return float64(n) / float64(limit)
+}
+
+// NewAccount flag the content of the authors registered for less than MinAge
+type NewAccount struct {
+	Users  repository.User
+	MinAge time.Duration
+}
+
+func (n NewAccount) Name() string {
+	return "new_account"
+}
+
+func (n NewAccount) Check(ctx context.Context, content Content) (model.ModerationResult, error) {
+	user, err := n.Users.Get(ctx, repository.UserGetFilter{Id: &content.AuthorId})
+	if err != nil {
+		return model.ModerationResult{}, err
+	}
+	if user.CreatedAt == nil || time.Since(*user.CreatedAt) >= n.MinAge {
+		return allow, nil
+	}
+
+	return model.ModerationResult{
+		Verdict: model.ModerationFlag,
+		Reason:  fmt.Sprintf("the author account is less than %s old", n.MinAge),
+	}, nil
+}
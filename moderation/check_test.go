@@ -0,0 +1,161 @@
+package moderation_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/moderation"
+	"tempo/repository"
+	"tempo/repository/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBannedWords_Check(t *testing.T) {
+	t.Parallel()
+	bannedWords, err := moderation.ParseBannedWords(strings.NewReader(`
+# comment
+casino
+flag:crypto
+regex:(?i)buy\s+now
+`))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		text    string
+		verdict model.ModerationVerdict
+	}{
+		{"ShouldAllow_WhenNoTermMatch", "a casinos review", model.ModerationAllow},
+		{"ShouldReject_WhenWordMatch", "the new CASINO in town", model.ModerationReject},
+		{"ShouldFlag_WhenFlaggedWordMatch", "crypto is up", model.ModerationFlag},
+		{"ShouldReject_WhenRegexMatch", "Buy   now!", model.ModerationReject},
+		{"ShouldReject_WhenFlaggedAndRejectedTermsMatch", "crypto casino", model.ModerationReject},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// CODE UNDER TEST
+			res, err := bannedWords.Check(context.TODO(), moderation.Content{Description: tt.text})
+
+			// EXPECTATION
+			require.NoError(t, err)
+			require.Equal(t, tt.verdict, res.Verdict)
+		})
+	}
+
+	t.Run("ShouldReturnError_WhenRegexIsInvalid", func(t *testing.T) {
+		t.Parallel()
+		// CODE UNDER TEST
+		_, err := moderation.ParseBannedWords(strings.NewReader("ok\nregex:(\n"))
+
+		// EXPECTATION
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "line 2")
+	})
+}
+
+func TestLinkLimit_Check(t *testing.T) {
+	t.Parallel()
+	check := moderation.LinkLimit{FlagAbove: 1, RejectAbove: 2}
+
+	tests := []struct {
+		name    string
+		text    string
+		verdict model.ModerationVerdict
+	}{
+		{"ShouldAllow_WhenUnderTheLimit", "see https://a.com", model.ModerationAllow},
+		{"ShouldFlag_WhenAboveFlagLimit", "see https://a.com and www.b.com", model.ModerationFlag},
+		{"ShouldReject_WhenAboveRejectLimit", "http://a.com http://b.com http://c.com", model.ModerationReject},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// CODE UNDER TEST
+			res, err := check.Check(context.TODO(), moderation.Content{Description: tt.text})
+
+			// EXPECTATION
+			require.NoError(t, err)
+			require.Equal(t, tt.verdict, res.Verdict)
+		})
+	}
+}
+
+func TestSpamScore_Check(t *testing.T) {
+	t.Parallel()
+	check := moderation.SpamScore{FlagScore: 0.5, RejectScore: 0.8}
+
+	tests := []struct {
+		name    string
+		text    string
+		verdict model.ModerationVerdict
+	}{
+		{"ShouldAllow_WhenTextIsRegular", "The city council approved the new budget on Monday.", model.ModerationAllow},
+		{"ShouldAllow_WhenShortTextIsCapitalized", "NASA LAUNCH", model.ModerationAllow},
+		{"ShouldFlag_WhenTextIsAllCaps", "THE CITY COUNCIL APPROVED THE NEW BUDGET", model.ModerationFlag},
+		{"ShouldReject_WhenTextIsShouted", "FREE MONEY FOR EVERYONE CLICK HERE!!!!! WOOOOOW!!!! NOOOOW", model.ModerationReject},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// CODE UNDER TEST
+			res, err := check.Check(context.TODO(), moderation.Content{Description: tt.text})
+
+			// EXPECTATION
+			require.NoError(t, err)
+			require.Equal(t, tt.verdict, res.Verdict, moderation.Score(tt.text))
+		})
+	}
+}
+
+func TestNewAccount_Check(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldFlag_WhenAccountIsRecent", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.CreatedAt = helper.Pointer(time.Now().Add(-time.Hour))
+			return user
+		})
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Id: fakeUser.Id}).Return(&fakeUser, nil).Once()
+		check := moderation.NewAccount{Users: userMock, MinAge: 24 * time.Hour}
+
+		// CODE UNDER TEST
+		res, err := check.Check(context.TODO(), moderation.Content{AuthorId: *fakeUser.Id})
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, model.ModerationFlag, res.Verdict)
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldAllow_WhenAccountIsOldEnough", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.CreatedAt = helper.Pointer(time.Now().Add(-48 * time.Hour))
+			return user
+		})
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Id: fakeUser.Id}).Return(&fakeUser, nil).Once()
+		check := moderation.NewAccount{Users: userMock, MinAge: 24 * time.Hour}
+
+		// CODE UNDER TEST
+		res, err := check.Check(context.TODO(), moderation.Content{AuthorId: *fakeUser.Id})
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, model.ModerationAllow, res.Verdict)
+		userMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,103 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"tempo/config"
+	"tempo/model"
+	"tempo/repository"
+)
+
+// Content is what the checks look at, the news as it will be persisted
+type Content struct {
+	AuthorId    string
+	Title       string
+	Description string
+}
+
+func (c Content) Text() string {
+	return c.Title + "\n" + c.Description
+}
+
+type Check interface {
+	Name() string
+	Check(ctx context.Context, content Content) (model.ModerationResult, error)
+}
+
+type Pipeline struct {
+	checks []Check
+}
+
+func NewPipeline(checks ...Check) *Pipeline {
+	return &Pipeline{checks: checks}
+}
+
+// Run apply every check and return the strictest verdict with the results that are not allow, it stop at the first rejection
+func (p *Pipeline) Run(ctx context.Context, content Content) (model.ModerationVerdict, []model.ModerationResult, error) {
+	verdict := model.ModerationAllow
+	var results []model.ModerationResult
+
+	for _, v := range p.checks {
+		res, err := v.Check(ctx, content)
+		if err != nil {
+			return "", nil, err
+		}
+		if res.Verdict == "" || res.Verdict == model.ModerationAllow {
+			continue
+		}
+
+		res.Check = v.Name()
+		results = append(results, res)
+		if res.Verdict.Severity() > verdict.Severity() {
+			verdict = res.Verdict
+		}
+		if verdict == model.ModerationReject {
+			break
+		}
+	}
+
+	return verdict, results, nil
+}
+
+// NewFromConfig build the pipeline of the configured checks, the banned words check match nothing without file
+func NewFromConfig(cfg config.Config, users repository.User) (*Pipeline, error) {
+	var checks []Check
+	for _, name := range strings.Split(cfg.Moderation.Checks, ",") {
+		switch strings.TrimSpace(name) {
+		case "":
+			continue
+		case "banned_words":
+			bannedWords := &BannedWords{}
+			if cfg.Moderation.BannedWordsFile != "" {
+				var err error
+				bannedWords, err = LoadBannedWords(cfg.Moderation.BannedWordsFile)
+				if err != nil {
+					return nil, err
+				}
+			}
+			checks = append(checks, bannedWords)
+		case "link_limit":
+			checks = append(checks, LinkLimit{
+				FlagAbove:   cfg.Moderation.LinkFlagAbove,
+				RejectAbove: cfg.Moderation.LinkRejectAbove,
+			})
+		case "spam_score":
+			checks = append(checks, SpamScore{
+				FlagScore:   cfg.Moderation.SpamFlagScore,
+				RejectScore: cfg.Moderation.SpamRejectScore,
+			})
+		case "new_account":
+			checks = append(checks, NewAccount{
+				Users:  users,
+				MinAge: time.Duration(cfg.Moderation.NewAccountHours) * time.Hour,
+			})
+		default:
+			return nil, fmt.Errorf("unknown moderation check %q", name)
+		}
+	}
+
+	return NewPipeline(checks...), nil
+}
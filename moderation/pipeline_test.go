@@ -0,0 +1,101 @@
+package moderation_test
+
+import (
+	"context"
+	"testing"
+
+	"tempo/config"
+	"tempo/model"
+	"tempo/moderation"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fixedCheck struct {
+	name    string
+	verdict model.ModerationVerdict
+	calls   *int
+}
+
+func (f fixedCheck) Name() string {
+	return f.name
+}
+
+func (f fixedCheck) Check(ctx context.Context, content moderation.Content) (model.ModerationResult, error) {
+	*f.calls++
+	return model.ModerationResult{Verdict: f.verdict, Reason: f.name}, nil
+}
+
+func TestPipeline_Run(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnTheFlags_WhenNothingRejects", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		calls := 0
+		pipeline := moderation.NewPipeline(
+			fixedCheck{"a", model.ModerationAllow, &calls},
+			fixedCheck{"b", model.ModerationFlag, &calls},
+			fixedCheck{"c", model.ModerationFlag, &calls},
+		)
+
+		// CODE UNDER TEST
+		verdict, results, err := pipeline.Run(context.TODO(), moderation.Content{})
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, model.ModerationFlag, verdict)
+		require.Len(t, results, 2)
+		require.Equal(t, "b", results[0].Check)
+		require.Equal(t, 3, calls)
+	})
+
+	t.Run("ShouldStop_WhenACheckRejects", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		calls := 0
+		pipeline := moderation.NewPipeline(
+			fixedCheck{"a", model.ModerationFlag, &calls},
+			fixedCheck{"b", model.ModerationReject, &calls},
+			fixedCheck{"c", model.ModerationFlag, &calls},
+		)
+
+		// CODE UNDER TEST
+		verdict, results, err := pipeline.Run(context.TODO(), moderation.Content{})
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, model.ModerationReject, verdict)
+		require.Len(t, results, 2)
+		require.Equal(t, 2, calls)
+	})
+}
+
+func TestNewFromConfig(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnError_WhenCheckIsUnknown", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		cfg := config.Config{}
+		cfg.Moderation.Checks = "link_limit,unknown"
+
+		// CODE UNDER TEST
+		_, err := moderation.NewFromConfig(cfg, nil)
+
+		// EXPECTATION
+		require.Error(t, err)
+	})
+
+	t.Run("ShouldReturnError_WhenBannedWordsFileIsMissing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		cfg := config.Config{}
+		cfg.Moderation.Checks = "banned_words"
+		cfg.Moderation.BannedWordsFile = "/not/found"
+
+		// CODE UNDER TEST
+		_, err := moderation.NewFromConfig(cfg, nil)
+
+		// EXPECTATION
+		require.Error(t, err)
+	})
+}
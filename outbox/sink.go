@@ -0,0 +1,129 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"tempo/model"
+)
+
+// Sink receive the relayed events, in offset order. Publish must only return nil once the whole batch is durably handed
+// over, the batch is published again otherwise so sinks get every event at least once.
+type Sink interface {
+	Publish(ctx context.Context, events []model.OutboxEvent) error
+	Close() error
+}
+
+// encode write the events as newline delimited json
+func encode(events []model.OutboxEvent) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, v := range events {
+		if err := enc.Encode(v); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+type WriterSink struct {
+	w io.Writer
+}
+
+// NewWriterSink write the events as NDJSON to w, typically stdout
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{w: w}
+}
+
+func (s *WriterSink) Publish(ctx context.Context, events []model.OutboxEvent) error {
+	b, err := encode(events)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.w.Write(b)
+	return err
+}
+
+func (s *WriterSink) Close() error {
+	return nil
+}
+
+type FileSink struct {
+	f *os.File
+}
+
+// NewFileSink append the events as NDJSON to the file at path, creating it when missing
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileSink{f: f}, nil
+}
+
+func (s *FileSink) Publish(ctx context.Context, events []model.OutboxEvent) error {
+	b, err := encode(events)
+	if err != nil {
+		return err
+	}
+
+	if _, err = s.f.Write(b); err != nil {
+		return err
+	}
+
+	return s.f.Sync()
+}
+
+func (s *FileSink) Close() error {
+	return s.f.Close()
+}
+
+type HttpSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewHttpSink POST each batch as an application/x-ndjson body to url, any non 2xx response fail the batch
+func NewHttpSink(url string, timeout time.Duration) *HttpSink {
+	return &HttpSink{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (s *HttpSink) Publish(ctx context.Context, events []model.OutboxEvent) error {
+	b, err := encode(events)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return model.NewStatusNotOKError(resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+func (s *HttpSink) Close() error {
+	return nil
+}
@@ -0,0 +1,120 @@
+package outbox_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"tempo/helper"
+	"tempo/model"
+	"tempo/outbox"
+
+	"github.com/stretchr/testify/require"
+)
+
+func fakeEvents() []model.OutboxEvent {
+	return []model.OutboxEvent{
+		{Offset: helper.Pointer(int64(1)), EventId: helper.Pointer("a"), Type: helper.Pointer(model.EventNewsCreated), Data: json.RawMessage(`{"id":"news"}`)},
+		{Offset: helper.Pointer(int64(2)), EventId: helper.Pointer("b"), Type: helper.Pointer(model.EventNewsUpdated), Data: json.RawMessage(`{"id":"news"}`)},
+	}
+}
+
+func decodeLines(t *testing.T, r io.Reader) []model.OutboxEvent {
+	var res []model.OutboxEvent
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		var e model.OutboxEvent
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		res = append(res, e)
+	}
+	return res
+}
+
+func TestWriterSink_Publish(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldWriteOneLinePerEvent", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		var buf bytes.Buffer
+		sink := outbox.NewWriterSink(&buf)
+
+		// CODE UNDER TEST
+		err := sink.Publish(context.Background(), fakeEvents())
+		require.NoError(t, err)
+
+		// EXPECTATION
+		lines := decodeLines(t, &buf)
+		require.Len(t, lines, 2)
+		require.Equal(t, int64(1), *lines[0].Offset)
+		require.JSONEq(t, `{"id":"news"}`, string(lines[1].Data))
+	})
+}
+
+func TestFileSink_Publish(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldAppendToTheFile", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		path := filepath.Join(t.TempDir(), "outbox.ndjson")
+		sink, err := outbox.NewFileSink(path)
+		require.NoError(t, err)
+
+		// CODE UNDER TEST
+		require.NoError(t, sink.Publish(context.Background(), fakeEvents()[:1]))
+		require.NoError(t, sink.Publish(context.Background(), fakeEvents()[1:]))
+		require.NoError(t, sink.Close())
+
+		// EXPECTATION
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		defer f.Close()
+		lines := decodeLines(t, f)
+		require.Len(t, lines, 2)
+		require.Equal(t, "b", *lines[1].EventId)
+	})
+}
+
+func TestHttpSink_Publish(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldPostTheBatch", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		var received []model.OutboxEvent
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+			received = decodeLines(t, r.Body)
+		}))
+		defer server.Close()
+		sink := outbox.NewHttpSink(server.URL, 0)
+
+		// CODE UNDER TEST
+		err := sink.Publish(context.Background(), fakeEvents())
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Len(t, received, 2)
+	})
+
+	t.Run("ShouldReturnError_WhenResponseIsNotOk", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+		sink := outbox.NewHttpSink(server.URL, 0)
+
+		// CODE UNDER TEST
+		err := sink.Publish(context.Background(), fakeEvents())
+
+		// EXPECTATION
+		require.Error(t, err)
+	})
+}
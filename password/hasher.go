@@ -0,0 +1,58 @@
+package password
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+
+	"tempo/config"
+	"tempo/helper"
+)
+
+// Hasher hash the passwords into PHC strings, which carry the algorithm, its parameters and the salt
+type Hasher interface {
+	Hash(password string) (string, error)
+	// Verify compare the password with a hash of the algorithm of the hasher, in constant time
+	Verify(password string, hash string) (bool, error)
+	// NeedsRehash tell whether the hash was made by another algorithm, or with other parameters than the hasher's
+	NeedsRehash(hash string) bool
+}
+
+var ErrUnknownHash = errors.New("unknown password hash")
+
+// Verify compare the password with a hash of any supported algorithm and tell whether the hash should be replaced
+// by one of the hasher. A hash without algorithm is a legacy SHA-1 of the password with its salt
+func Verify(hasher Hasher, password string, hash string, legacySalt string) (ok bool, rehash bool, err error) {
+	switch {
+	case strings.HasPrefix(hash, argon2idPrefix):
+		ok, err = Argon2id{}.Verify(password, hash)
+	case isBcrypt(hash):
+		ok, err = Bcrypt{}.Verify(password, hash)
+	case strings.HasPrefix(hash, "$"):
+		return false, false, ErrUnknownHash
+	default:
+		ok = subtle.ConstantTimeCompare([]byte(helper.Hash(legacySalt, password)), []byte(hash)) == 1
+	}
+	if err != nil || !ok {
+		return false, false, err
+	}
+
+	return true, hasher.NeedsRehash(hash), nil
+}
+
+// NewFromConfig return the hasher of the configured algorithm
+func NewFromConfig(cfg config.Config) (Hasher, error) {
+	switch cfg.Password.Algorithm {
+	case "", "argon2id":
+		return Argon2id{
+			Memory:  cfg.Password.Argon2Memory,
+			Time:    cfg.Password.Argon2Time,
+			Threads: uint8(cfg.Password.Argon2Threads),
+		}, nil
+	case "bcrypt":
+		return Bcrypt{Cost: cfg.Password.BcryptCost}, nil
+	default:
+		return nil, fmt.Errorf("unknown password algorithm %q", cfg.Password.Algorithm)
+	}
+}
@@ -0,0 +1,162 @@
+package password_test
+
+import (
+	"strings"
+	"testing"
+
+	"tempo/config"
+	"tempo/helper"
+	"tempo/password"
+
+	"github.com/stretchr/testify/require"
+)
+
+var argon2id = password.Argon2id{Memory: 1024, Time: 1, Threads: 1}
+
+func TestArgon2id(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldHashIntoAPhcString", func(t *testing.T) {
+		t.Parallel()
+		// CODE UNDER TEST
+		hash, err := argon2id.Hash("secret")
+		require.NoError(t, err)
+		other, err := argon2id.Hash("secret")
+		require.NoError(t, err)
+
+		// EXPECTATION
+		require.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"))
+		require.NotEqual(t, hash, other)
+		ok, err := argon2id.Verify("secret", hash)
+		require.NoError(t, err)
+		require.True(t, ok)
+		ok, err = argon2id.Verify("Secret", hash)
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+
+	t.Run("ShouldNeedRehash_WhenParametersChanged", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		hash, err := argon2id.Hash("secret")
+		require.NoError(t, err)
+
+		// CODE UNDER TEST
+		stronger := password.Argon2id{Memory: 2048, Time: 1, Threads: 1}
+
+		// EXPECTATION
+		require.False(t, argon2id.NeedsRehash(hash))
+		require.True(t, stronger.NeedsRehash(hash))
+		// the hash is verified with its own parameters
+		ok, err := stronger.Verify("secret", hash)
+		require.NoError(t, err)
+		require.True(t, ok)
+	})
+
+	t.Run("ShouldReturnError_WhenHashIsMalformed", func(t *testing.T) {
+		t.Parallel()
+		// CODE UNDER TEST
+		ok, err := argon2id.Verify("secret", "$argon2id$v=19$m=1024,t=1$c2FsdA$a2V5")
+
+		// EXPECTATION
+		require.ErrorIs(t, err, password.ErrUnknownHash)
+		require.False(t, ok)
+	})
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldVerifyLegacyHash_AndAskForRehash", func(t *testing.T) {
+		t.Parallel()
+		// CODE UNDER TEST
+		ok, rehash, err := password.Verify(argon2id, "secret", helper.Hash("salt", "secret"), "salt")
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.True(t, rehash)
+	})
+
+	t.Run("ShouldRejectLegacyHash_WhenPasswordIsIncorrect", func(t *testing.T) {
+		t.Parallel()
+		// CODE UNDER TEST
+		ok, rehash, err := password.Verify(argon2id, "other", helper.Hash("salt", "secret"), "salt")
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.False(t, ok)
+		require.False(t, rehash)
+	})
+
+	t.Run("ShouldVerifyBcryptHash_WhenHasherIsArgon2id", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		hash, err := password.Bcrypt{Cost: 4}.Hash("secret")
+		require.NoError(t, err)
+
+		// CODE UNDER TEST
+		ok, rehash, err := password.Verify(argon2id, "secret", hash, "")
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.True(t, rehash)
+	})
+
+	t.Run("ShouldNotAskForRehash_WhenHashIsOfTheHasher", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		hasher := password.Bcrypt{Cost: 4}
+		hash, err := hasher.Hash("secret")
+		require.NoError(t, err)
+
+		// CODE UNDER TEST
+		ok, rehash, err := password.Verify(hasher, "secret", hash, "")
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.False(t, rehash)
+	})
+
+	t.Run("ShouldReturnError_WhenAlgorithmIsUnknown", func(t *testing.T) {
+		t.Parallel()
+		// CODE UNDER TEST
+		ok, _, err := password.Verify(argon2id, "secret", "$scrypt$ln=15,r=8,p=1$c2FsdA$a2V5", "")
+
+		// EXPECTATION
+		require.ErrorIs(t, err, password.ErrUnknownHash)
+		require.False(t, ok)
+	})
+}
+
+func TestNewFromConfig(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnError_WhenAlgorithmIsUnknown", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		cfg := config.Config{}
+		cfg.Password.Algorithm = "md5"
+
+		// CODE UNDER TEST
+		res, err := password.NewFromConfig(cfg)
+
+		// EXPECTATION
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("ShouldReturnBcrypt", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		cfg := config.Config{}
+		cfg.Password.Algorithm = "bcrypt"
+		cfg.Password.BcryptCost = 11
+
+		// CODE UNDER TEST
+		res, err := password.NewFromConfig(cfg)
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, password.Bcrypt{Cost: 11}, res)
+	})
+}
@@ -0,0 +1,69 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+)
+
+// Limiter allow a number of hits per key in a fixed window. The hits are counted in the database, so the instances
+// share the count
+type Limiter struct {
+	repo   repository.RateLimit
+	name   string
+	limit  int
+	window time.Duration
+}
+
+// New return the limiter of the name, which prefix the buckets of its keys. A limit of 0 allow every hit
+func New(repo repository.RateLimit, name string, limit int, window time.Duration) *Limiter {
+	return &Limiter{
+		repo:   repo,
+		name:   name,
+		limit:  limit,
+		window: window,
+	}
+}
+
+// Allow count a hit of the key, and return a too many requests error once the limit of the window is exceeded
+func (l *Limiter) Allow(ctx context.Context, key string) error {
+	if l.limit <= 0 {
+		return nil
+	}
+
+	// the key is hashed as it can be longer than a bucket, like an email
+	hits, err := l.repo.Hit(ctx, l.name+":"+helper.Sha256(key), l.window)
+	if err != nil {
+		return err
+	}
+	if hits > l.limit {
+		return model.NewError("too many requests, try again later", model.ErrorTooManyRequests)
+	}
+
+	return nil
+}
+
+// Purge delete the ended windows every interval until the context is done, so the buckets of the keys seen once
+// don't pile up
+func Purge(ctx context.Context, repo repository.RateLimit, interval time.Duration) {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := repo.DeleteExpired(ctx, time.Now()); err != nil && ctx.Err() == nil {
+				helper.GetLogger(ctx).WithField("method", "ratelimit.Purge").WithError(err).
+					Warning("Failed delete the ended rate limit windows")
+			}
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tempo/helper"
+	"tempo/model"
+	"tempo/ratelimit"
+	"tempo/repository/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnErrorTooManyRequests_WhenTheLimitIsExceeded", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		repoMock := &mocks.RateLimit{}
+		bucket := "resend:" + helper.Sha256("email@gmail.com")
+		repoMock.On("Hit", mock.Anything, bucket, time.Hour).Return(2, nil).Once()
+		repoMock.On("Hit", mock.Anything, bucket, time.Hour).Return(3, nil).Once()
+
+		// CODE UNDER TEST
+		limiter := ratelimit.New(repoMock, "resend", 2, time.Hour)
+		allowed := limiter.Allow(context.Background(), "email@gmail.com")
+		exceeded := limiter.Allow(context.Background(), "email@gmail.com")
+
+		// EXPECTATION
+		require.NoError(t, allowed)
+		var e model.Error
+		require.ErrorAs(t, exceeded, &e)
+		require.Equal(t, model.ErrorTooManyRequests, e.Code)
+
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldAllowEveryHit_WhenLimitIsZero", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		repoMock := &mocks.RateLimit{}
+
+		// CODE UNDER TEST
+		limiter := ratelimit.New(repoMock, "resend", 0, time.Hour)
+		err := limiter.Allow(context.Background(), "email@gmail.com")
+
+		// EXPECTATION
+		require.NoError(t, err)
+
+		repoMock.AssertExpectations(t)
+	})
+}
+
+func TestPurge(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldDeleteTheEndedWindows_EveryInterval", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		purged := make(chan struct{})
+		repoMock := &mocks.RateLimit{}
+		repoMock.On("DeleteExpired", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+			return !before.After(time.Now())
+		})).Return(nil).Run(func(args mock.Arguments) {
+			close(purged)
+		}).Once()
+		ctx, cancel := context.WithCancel(context.Background())
+
+		// CODE UNDER TEST
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			ratelimit.Purge(ctx, repoMock, 10*time.Millisecond)
+		}()
+
+		// EXPECTATION
+		select {
+		case <-purged:
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "the ended windows were not deleted")
+		}
+		cancel()
+		<-done
+
+		repoMock.AssertExpectations(t)
+	})
+}
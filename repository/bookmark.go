@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"tempo/model"
+)
+
+type Bookmark interface {
+	Upsert(ctx context.Context, bookmark *model.Bookmark) (*model.Bookmark, error)
+	Delete(ctx context.Context, userId string, newsId string) error
+	List(ctx context.Context, filter BookmarkListFilter) ([]model.Bookmark, error)
+}
+
+type BookmarkListFilter struct {
+	UserId *string
+	Folder *string
+	// AfterCreatedAt and AfterId return only the bookmarks older than this position
+	AfterCreatedAt *time.Time
+	AfterId        *string
+	Limit          int
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"tempo/model"
+)
+
+// Collection keep the positions of the items contiguous from 0. The changes of the items lock the collection, and a
+// reorder is refused with a duplicate error when it is not made on the current version.
+type Collection interface {
+	Add(ctx context.Context, collection *model.Collection) (*model.Collection, error)
+	// Get return the collection with its items in their order
+	Get(ctx context.Context, id string) (*model.Collection, error)
+	// AddItem insert the news at the position, moving down the next ones, or append it when the position is nil
+	AddItem(ctx context.Context, id string, newsId string, position *int) (*model.Collection, error)
+	RemoveItem(ctx context.Context, id string, newsId string) (*model.Collection, error)
+	// Reorder set the order of the items, the news ids must be the ones of the collection
+	Reorder(ctx context.Context, id string, version int, newsIds []string) (*model.Collection, error)
+	// ListSeries return the collections the news belong to, with its published neighbours. The position is the one
+	// among the published news of the collection
+	ListSeries(ctx context.Context, newsId string) ([]model.NewsSeries, error)
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"tempo/model"
+)
+
+type Featured interface {
+	// Replace set the items of the slot in their order and audit the change, an item already in the slot keep who pinned it and when
+	Replace(ctx context.Context, slot string, items []model.FeaturedItem, userId string) ([]model.FeaturedItem, error)
+	// ListNews return the news of the slot by position, skipping the expired items and the news that are unpublished or deleted
+	ListNews(ctx context.Context, filter FeaturedNewsFilter) ([]model.FeaturedNews, error)
+	ListAudits(ctx context.Context, filter FeaturedAuditFilter) ([]model.FeaturedAudit, error)
+}
+
+type FeaturedNewsFilter struct {
+	Slot string
+	At   time.Time
+}
+
+// FeaturedAuditFilter list the audits of the slot from the newest
+type FeaturedAuditFilter struct {
+	Slot string
+	// BeforeCreatedAt and BeforeId return only the audits older than this position
+	BeforeCreatedAt *time.Time
+	BeforeId        *string
+	Limit           int
+}
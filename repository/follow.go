@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"tempo/model"
+)
+
+type Follow interface {
+	Add(ctx context.Context, follow *model.Follow) (*model.Follow, error)
+	Delete(ctx context.Context, followerId string, followeeId string) error
+	List(ctx context.Context, filter FollowListFilter) ([]model.Follow, error)
+	GetFolloweeIds(ctx context.Context, followerId string) ([]string, error)
+	GetFollowerIds(ctx context.Context, followeeId string) ([]string, error)
+}
+
+type FollowListFilter struct {
+	FollowerId *string
+	FolloweeId *string
+	// AfterCreatedAt and AfterId return only the follows older than this position
+	AfterCreatedAt *time.Time
+	AfterId        *string
+	Limit          int
+}
@@ -5,8 +5,11 @@ package mocks
 import (
 	context "context"
 	model "tempo/model"
+	time "time"
 
 	mock "github.com/stretchr/testify/mock"
+
+	repository "tempo/repository"
 )
 
 // News is an autogenerated mock type for the News type
@@ -92,6 +95,159 @@ func (_m *News) Update(ctx context.Context, id *string, user *model.News) (*mode
 	return r0, r1
 }
 
+// List provides a mock function with given fields: ctx, filter
+func (_m *News) List(ctx context.Context, filter repository.NewsListFilter) ([]model.News, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []model.News
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.NewsListFilter) ([]model.News, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.NewsListFilter) []model.News); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.News)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.NewsListFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PublishedRange provides a mock function with given fields: ctx
+func (_m *News) PublishedRange(ctx context.Context) (*time.Time, *time.Time, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *time.Time
+	var r1 *time.Time
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*time.Time, *time.Time, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *time.Time); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) *time.Time); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(ctx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CountPublished provides a mock function with given fields: ctx, bounds
+func (_m *News) CountPublished(ctx context.Context, bounds []time.Time) ([]int64, error) {
+	ret := _m.Called(ctx, bounds)
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []time.Time) ([]int64, error)); ok {
+		return rf(ctx, bounds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []time.Time) []int64); ok {
+		r0 = rf(ctx, bounds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []time.Time) error); ok {
+		r1 = rf(ctx, bounds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSimilar provides a mock function with given fields: ctx, filter
+func (_m *News) ListSimilar(ctx context.Context, filter repository.NewsSimilarFilter) ([]model.NewsDuplicate, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []model.NewsDuplicate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.NewsSimilarFilter) ([]model.NewsDuplicate, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.NewsSimilarFilter) []model.NewsDuplicate); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.NewsDuplicate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.NewsSimilarFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBackfill provides a mock function with given fields: ctx, filter
+func (_m *News) ListBackfill(ctx context.Context, filter repository.NewsBackfillFilter) ([]model.News, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []model.News
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.NewsBackfillFilter) ([]model.News, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.NewsBackfillFilter) []model.News); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.News)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.NewsBackfillFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetReading provides a mock function with given fields: ctx, id, news
+func (_m *News) SetReading(ctx context.Context, id string, news *model.News) error {
+	ret := _m.Called(ctx, id, news)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.News) error); ok {
+		r0 = rf(ctx, id, news)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewNews interface {
 	mock.TestingT
 	Cleanup(func())
@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 	model "tempo/model"
+	time "time"
 
 	mock "github.com/stretchr/testify/mock"
 
@@ -94,6 +95,242 @@ func (_m *User) Update(ctx context.Context, id string, user *model.User) (*model
 	return r0, r1
 }
 
+// List provides a mock function with given fields: ctx, filter
+func (_m *User) List(ctx context.Context, filter repository.UserListFilter) ([]model.User, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UserListFilter) ([]model.User, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UserListFilter) []model.User); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UserListFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Suspend provides a mock function with given fields: ctx, id, adminId, reason
+func (_m *User) Suspend(ctx context.Context, id string, adminId string, reason string) (*model.User, error) {
+	ret := _m.Called(ctx, id, adminId, reason)
+
+	var r0 *model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*model.User, error)); ok {
+		return rf(ctx, id, adminId, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *model.User); ok {
+		r0 = rf(ctx, id, adminId, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, id, adminId, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Unsuspend provides a mock function with given fields: ctx, id, adminId, reason
+func (_m *User) Unsuspend(ctx context.Context, id string, adminId string, reason string) (*model.User, error) {
+	ret := _m.Called(ctx, id, adminId, reason)
+
+	var r0 *model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*model.User, error)); ok {
+		return rf(ctx, id, adminId, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *model.User); ok {
+		r0 = rf(ctx, id, adminId, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, id, adminId, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetPassword provides a mock function with given fields: ctx, id, password, resetBy
+func (_m *User) SetPassword(ctx context.Context, id string, password string, resetBy *string) (*model.User, error) {
+	ret := _m.Called(ctx, id, password, resetBy)
+
+	var r0 *model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *string) (*model.User, error)); ok {
+		return rf(ctx, id, password, resetBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *string) *model.User); ok {
+		r0 = rf(ctx, id, password, resetBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *string) error); ok {
+		r1 = rf(ctx, id, password, resetBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpgradePassword provides a mock function with given fields: ctx, id, currentHash, hash
+func (_m *User) UpgradePassword(ctx context.Context, id string, currentHash string, hash string) error {
+	ret := _m.Called(ctx, id, currentHash, hash)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, id, currentHash, hash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeTokens provides a mock function with given fields: ctx, id
+func (_m *User) RevokeTokens(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VerifyEmail provides a mock function with given fields: ctx, id, email
+func (_m *User) VerifyEmail(ctx context.Context, id string, email string) (*model.User, error) {
+	ret := _m.Called(ctx, id, email)
+
+	var r0 *model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.User, error)); ok {
+		return rf(ctx, id, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.User); ok {
+		r0 = rf(ctx, id, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRestricted provides a mock function with given fields: ctx, revokedSince
+func (_m *User) ListRestricted(ctx context.Context, revokedSince time.Time) ([]model.User, error) {
+	ret := _m.Called(ctx, revokedSince)
+
+	var r0 []model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]model.User, error)); ok {
+		return rf(ctx, revokedSince)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []model.User); ok {
+		r0 = rf(ctx, revokedSince)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, revokedSince)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUpdated provides a mock function with given fields: ctx, since
+func (_m *User) ListUpdated(ctx context.Context, since time.Time) ([]model.User, error) {
+	ret := _m.Called(ctx, since)
+
+	var r0 []model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]model.User, error)); ok {
+		return rf(ctx, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []model.User); ok {
+		r0 = rf(ctx, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAdminActions provides a mock function with given fields: ctx, userId, limit
+func (_m *User) ListAdminActions(ctx context.Context, userId string, limit int) ([]model.UserAdminAction, error) {
+	ret := _m.Called(ctx, userId, limit)
+
+	var r0 []model.UserAdminAction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]model.UserAdminAction, error)); ok {
+		return rf(ctx, userId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []model.UserAdminAction); ok {
+		r0 = rf(ctx, userId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.UserAdminAction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, userId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewUser interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"tempo/model"
+)
+
+type Moderation interface {
+	Add(ctx context.Context, item *model.ModerationItem) (*model.ModerationItem, error)
+	Get(ctx context.Context, id string) (*model.ModerationItem, error)
+	List(ctx context.Context, filter ModerationListFilter) ([]model.ModerationItem, error)
+	// Resolve close a pending item, removing its news when the status is removed. It return a duplicate error when the item is already resolved
+	Resolve(ctx context.Context, id string, status model.ModerationStatus, resolvedBy string, note *string) (*model.ModerationItem, error)
+}
+
+// ModerationListFilter list the items from the oldest, like a queue
+type ModerationListFilter struct {
+	Status *model.ModerationStatus
+	// AfterCreatedAt and AfterId return only the items newer than this position
+	AfterCreatedAt *time.Time
+	AfterId        *string
+	Limit          int
+}
@@ -0,0 +1,115 @@
+package mysqlrepo
+
+import (
+	"context"
+	"errors"
+
+	"tempo/model"
+	"tempo/repository"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BookmarkRepo struct {
+	Db *gorm.DB
+}
+
+func NewBookmarkRepository(db *gorm.DB) repository.Bookmark {
+	return &BookmarkRepo{
+		Db: db,
+	}
+}
+
+func (b *BookmarkRepo) Upsert(ctx context.Context, bookmark *model.Bookmark) (*model.Bookmark, error) {
+	gormModel := Bookmark{}.FromModel(*bookmark)
+
+	err := b.Db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]interface{}{"folder": gormModel.Folder}),
+	}).Create(&gormModel).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return b.get(ctx, *bookmark.UserId, *bookmark.NewsId)
+}
+
+func (b *BookmarkRepo) Delete(ctx context.Context, userId string, newsId string) error {
+	res := b.Db.WithContext(ctx).
+		Where("user_id = ? AND news_id = ?", userId, newsId).
+		Delete(&Bookmark{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return model.NewNotFoundError()
+	}
+
+	return nil
+}
+
+func (b *BookmarkRepo) List(ctx context.Context, filter repository.BookmarkListFilter) ([]model.Bookmark, error) {
+	var gormModels []Bookmark
+
+	// the join hides bookmarks whose news has since been deleted or unpublished
+	q := b.Db.WithContext(ctx).
+		Joins("JOIN news ON news.id = bookmarks.news_id AND news.deleted_at IS NULL AND news.published_at IS NOT NULL")
+	if filter.UserId != nil {
+		q = q.Where("bookmarks.user_id = ?", *filter.UserId)
+	}
+	if filter.Folder != nil {
+		q = q.Where("bookmarks.folder = ?", *filter.Folder)
+	}
+	if filter.AfterCreatedAt != nil && filter.AfterId != nil {
+		q = q.Where("(bookmarks.created_at < ? OR (bookmarks.created_at = ? AND bookmarks.id < ?))",
+			*filter.AfterCreatedAt, *filter.AfterCreatedAt, *filter.AfterId)
+	}
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit)
+	}
+
+	err := q.Order("bookmarks.created_at DESC, bookmarks.id DESC").Find(&gormModels).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(gormModels) == 0 {
+		return []model.Bookmark{}, nil
+	}
+
+	newsIds := make([]string, 0, len(gormModels))
+	for _, v := range gormModels {
+		newsIds = append(newsIds, *v.NewsId)
+	}
+	var news []News
+	if err = b.Db.WithContext(ctx).Where("id IN ?", newsIds).Find(&news).Error; err != nil {
+		return nil, err
+	}
+	newsById := make(map[string]*model.News, len(news))
+	for _, v := range news {
+		newsById[*v.Id] = v.ToModel()
+	}
+
+	res := make([]model.Bookmark, 0, len(gormModels))
+	for _, v := range gormModels {
+		bookmark := v.ToModel()
+		bookmark.News = newsById[*v.NewsId]
+		res = append(res, *bookmark)
+	}
+
+	return res, nil
+}
+
+func (b *BookmarkRepo) get(ctx context.Context, userId string, newsId string) (*model.Bookmark, error) {
+	gormModel := Bookmark{}
+	err := b.Db.WithContext(ctx).
+		Where("user_id = ? AND news_id = ?", userId, newsId).
+		First(&gormModel).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.NewNotFoundError()
+		}
+		return nil, err
+	}
+
+	return gormModel.ToModel(), nil
+}
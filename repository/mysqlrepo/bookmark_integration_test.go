@@ -0,0 +1,184 @@
+//go:build integration
+// +build integration
+
+package mysqlrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mysqlrepo"
+	"tempo/storage"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookmarkRepository_Upsert(t *testing.T) {
+	t.Run("ShouldInsertBookmark", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		news := test.FakeNewsCreate(t, db, nil)
+		fakeBookmark := test.FakeBookmark(t, func(bookmark model.Bookmark) model.Bookmark {
+			bookmark.NewsId = news.Id
+			return bookmark
+		})
+
+		//-- code under test
+		bookmarkRepo := mysqlrepo.NewBookmarkRepository(db)
+		res, err := bookmarkRepo.Upsert(context.TODO(), &fakeBookmark)
+
+		//-- assert
+		require.NoError(t, err)
+		require.NotNil(t, res.Id)
+		require.Equal(t, *fakeBookmark.UserId, *res.UserId)
+		require.Equal(t, *fakeBookmark.NewsId, *res.NewsId)
+		require.Equal(t, *fakeBookmark.Folder, *res.Folder)
+		require.NotNil(t, res.CreatedAt)
+	})
+
+	t.Run("ShouldUpdateFolder_WhenBookmarkAlreadyExist", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		news := test.FakeNewsCreate(t, db, nil)
+		existing := test.FakeBookmarkCreate(t, db, func(bookmark model.Bookmark) model.Bookmark {
+			bookmark.NewsId = news.Id
+			return bookmark
+		})
+
+		//-- code under test
+		bookmarkRepo := mysqlrepo.NewBookmarkRepository(db)
+		res, err := bookmarkRepo.Upsert(context.TODO(), &model.Bookmark{
+			UserId: existing.UserId,
+			NewsId: existing.NewsId,
+			Folder: helper.Pointer("another folder"),
+		})
+
+		//-- assert
+		require.NoError(t, err)
+		require.Equal(t, *existing.Id, *res.Id)
+		require.Equal(t, "another folder", *res.Folder)
+	})
+}
+
+func TestBookmarkRepository_Delete(t *testing.T) {
+	t.Run("ShouldReturnNotFoundError_WhenBookmarkIsNotExist", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		//-- code under test
+		bookmarkRepo := mysqlrepo.NewBookmarkRepository(db)
+		err := bookmarkRepo.Delete(context.TODO(), "invalid-user", "invalid-news")
+
+		//-- assert
+		require.EqualError(t, err, model.NewNotFoundError().Error())
+	})
+
+	t.Run("ShouldDeleteBookmark", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		news := test.FakeNewsCreate(t, db, nil)
+		existing := test.FakeBookmarkCreate(t, db, func(bookmark model.Bookmark) model.Bookmark {
+			bookmark.NewsId = news.Id
+			return bookmark
+		})
+
+		//-- code under test
+		bookmarkRepo := mysqlrepo.NewBookmarkRepository(db)
+		err := bookmarkRepo.Delete(context.TODO(), *existing.UserId, *existing.NewsId)
+		require.NoError(t, err)
+
+		//-- assert
+		res, err := bookmarkRepo.List(context.TODO(), repository.BookmarkListFilter{
+			UserId: existing.UserId,
+		})
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+}
+
+func TestBookmarkRepository_List(t *testing.T) {
+	t.Run("ShouldSkipDeletedAndUnpublishedNews", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		userId := helper.Pointer("user-1")
+		published := test.FakeNewsCreate(t, db, nil)
+		unpublished := test.FakeNewsCreate(t, db, nil)
+		deleted := test.FakeNewsCreate(t, db, nil)
+		require.NoError(t, db.Model(&mysqlrepo.News{}).Where("id = ?", *unpublished.Id).Update("published_at", nil).Error)
+		require.NoError(t, db.Delete(&mysqlrepo.News{Id: deleted.Id}).Error)
+		for _, v := range []*model.News{published, unpublished, deleted} {
+			test.FakeBookmarkCreate(t, db, func(bookmark model.Bookmark) model.Bookmark {
+				bookmark.UserId = userId
+				bookmark.NewsId = v.Id
+				return bookmark
+			})
+		}
+
+		//-- code under test
+		bookmarkRepo := mysqlrepo.NewBookmarkRepository(db)
+		res, err := bookmarkRepo.List(context.TODO(), repository.BookmarkListFilter{
+			UserId: userId,
+		})
+
+		//-- assert
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.Equal(t, *published.Id, *res[0].NewsId)
+		require.Equal(t, *published.Title, *res[0].News.Title)
+	})
+
+	t.Run("ShouldPaginateNewestFirst", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		userId := helper.Pointer("user-2")
+		now := time.Now().Truncate(time.Second)
+		var bookmarks []*model.Bookmark
+		for i := 0; i < 3; i++ {
+			news := test.FakeNewsCreate(t, db, nil)
+			bookmarks = append(bookmarks, test.FakeBookmarkCreate(t, db, func(bookmark model.Bookmark) model.Bookmark {
+				bookmark.UserId = userId
+				bookmark.NewsId = news.Id
+				bookmark.CreatedAt = helper.Pointer(now.Add(time.Duration(i) * time.Minute))
+				return bookmark
+			}))
+		}
+
+		//-- code under test
+		bookmarkRepo := mysqlrepo.NewBookmarkRepository(db)
+		firstPage, err := bookmarkRepo.List(context.TODO(), repository.BookmarkListFilter{
+			UserId: userId,
+			Limit:  2,
+		})
+		require.NoError(t, err)
+		secondPage, err := bookmarkRepo.List(context.TODO(), repository.BookmarkListFilter{
+			UserId:         userId,
+			AfterCreatedAt: firstPage[1].CreatedAt,
+			AfterId:        firstPage[1].Id,
+			Limit:          2,
+		})
+		require.NoError(t, err)
+
+		//-- assert
+		require.Len(t, firstPage, 2)
+		require.Equal(t, *bookmarks[2].Id, *firstPage[0].Id)
+		require.Equal(t, *bookmarks[1].Id, *firstPage[1].Id)
+		require.Len(t, secondPage, 1)
+		require.Equal(t, *bookmarks[0].Id, *secondPage[0].Id)
+	})
+}
@@ -0,0 +1,49 @@
+package mysqlrepo
+
+import (
+	"tempo/model"
+	"time"
+
+	"github.com/segmentio/ksuid"
+	"gorm.io/gorm"
+)
+
+type Bookmark struct {
+	Id        *string
+	UserId    *string
+	NewsId    *string
+	Folder    *string
+	CreatedAt *time.Time
+}
+
+func (b Bookmark) FromModel(data model.Bookmark) *Bookmark {
+	return &Bookmark{
+		Id:        data.Id,
+		UserId:    data.UserId,
+		NewsId:    data.NewsId,
+		Folder:    data.Folder,
+		CreatedAt: data.CreatedAt,
+	}
+}
+
+func (b Bookmark) ToModel() *model.Bookmark {
+	return &model.Bookmark{
+		Id:        b.Id,
+		UserId:    b.UserId,
+		NewsId:    b.NewsId,
+		Folder:    b.Folder,
+		CreatedAt: b.CreatedAt,
+	}
+}
+
+func (b Bookmark) TableName() string {
+	return "bookmarks"
+}
+
+func (b *Bookmark) BeforeCreate(db *gorm.DB) error {
+	if b.Id == nil {
+		db.Statement.SetColumn("id", ksuid.New().String())
+	}
+
+	return nil
+}
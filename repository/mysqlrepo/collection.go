@@ -0,0 +1,340 @@
+package mysqlrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CollectionRepo struct {
+	Db *gorm.DB
+}
+
+func NewCollectionRepository(db *gorm.DB) repository.Collection {
+	return &CollectionRepo{
+		Db: db,
+	}
+}
+
+func (c *CollectionRepo) Add(ctx context.Context, collection *model.Collection) (*model.Collection, error) {
+	gormModel := Collection{}.FromModel(*collection)
+
+	if err := c.Db.WithContext(ctx).Create(&gormModel).Error; err != nil {
+		return nil, err
+	}
+
+	return c.Get(ctx, *gormModel.Id)
+}
+
+func (c *CollectionRepo) Get(ctx context.Context, id string) (*model.Collection, error) {
+	return getCollection(c.Db.WithContext(ctx), id)
+}
+
+func (c *CollectionRepo) AddItem(ctx context.Context, id string, newsId string, position *int) (*model.Collection, error) {
+	var res *model.Collection
+	err := c.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := lockCollection(tx, id); err != nil {
+			return err
+		}
+
+		var count int64
+		err := tx.Model(&CollectionItem{}).Where("collection_id = ?", id).Count(&count).Error
+		if err != nil {
+			return err
+		}
+
+		at := int(count)
+		if position != nil && *position < at {
+			at = *position
+			err = tx.Model(&CollectionItem{}).
+				Where("collection_id = ? AND position >= ?", id, at).
+				Update("position", gorm.Expr("position + 1")).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		err = tx.Create(&CollectionItem{
+			CollectionId: helper.Pointer(id),
+			NewsId:       helper.Pointer(newsId),
+			Position:     helper.Pointer(at),
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		res, err = changedCollection(tx, id)
+		return err
+	})
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return nil, model.NewDuplicateError()
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *CollectionRepo) RemoveItem(ctx context.Context, id string, newsId string) (*model.Collection, error) {
+	var res *model.Collection
+	err := c.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := lockCollection(tx, id); err != nil {
+			return err
+		}
+
+		var item CollectionItem
+		err := tx.Where("collection_id = ? AND news_id = ?", id, newsId).First(&item).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return model.NewNotFoundError()
+			}
+			return err
+		}
+
+		err = tx.Where("collection_id = ? AND news_id = ?", id, newsId).Delete(&CollectionItem{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Model(&CollectionItem{}).
+			Where("collection_id = ? AND position > ?", id, *item.Position).
+			Update("position", gorm.Expr("position - 1")).Error
+		if err != nil {
+			return err
+		}
+
+		res, err = changedCollection(tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *CollectionRepo) Reorder(ctx context.Context, id string, version int, newsIds []string) (*model.Collection, error) {
+	var res *model.Collection
+	err := c.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		collection, err := lockCollection(tx, id)
+		if err != nil {
+			return err
+		}
+		if helper.Val(collection.Version) != version {
+			return model.NewError("the collection was changed, reload it and retry", model.ErrorDuplicate)
+		}
+
+		// the items of deleted news are not shown, so they are not expected in the new order and are dropped
+		items, err := listCollectionItems(tx, id)
+		if err != nil {
+			return err
+		}
+		if len(items) != len(newsIds) {
+			return model.NewParameterError(helper.Pointer("the news ids must be the ones of the collection"))
+		}
+		current := make(map[string]struct{}, len(items))
+		for _, v := range items {
+			current[*v.NewsId] = struct{}{}
+		}
+		for _, v := range newsIds {
+			if _, ok := current[v]; !ok {
+				return model.NewParameterError(helper.Pointer(fmt.Sprintf("news %s is not in the collection", v)))
+			}
+		}
+
+		err = tx.Where("collection_id = ? AND news_id NOT IN ?", id, newsIds).Delete(&CollectionItem{}).Error
+		if err != nil {
+			return err
+		}
+		for i, v := range newsIds {
+			err = tx.Model(&CollectionItem{}).
+				Where("collection_id = ? AND news_id = ?", id, v).
+				Update("position", i).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		res, err = changedCollection(tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *CollectionRepo) ListSeries(ctx context.Context, newsId string) ([]model.NewsSeries, error) {
+	db := c.Db.WithContext(ctx)
+
+	var collections []Collection
+	err := db.Joins("JOIN collection_items ON collection_items.collection_id = collections.id").
+		Where("collection_items.news_id = ?", newsId).
+		Order("collections.created_at, collections.id").
+		Find(&collections).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(collections) == 0 {
+		return []model.NewsSeries{}, nil
+	}
+
+	collectionIds := make([]string, 0, len(collections))
+	for _, v := range collections {
+		collectionIds = append(collectionIds, *v.Id)
+	}
+	var items []CollectionItem
+	err = db.Where("collection_id IN ?", collectionIds).Order("collection_id, position").Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// the neighbours are only the published news, the news itself being listed even when its author preview it
+	newsIds := make([]string, 0, len(items))
+	for _, v := range items {
+		newsIds = append(newsIds, *v.NewsId)
+	}
+	var news []News
+	err = db.Where("id IN ? AND (published_at IS NOT NULL OR id = ?)", newsIds, newsId).Find(&news).Error
+	if err != nil {
+		return nil, err
+	}
+	newsById := make(map[string]*model.News, len(news))
+	for _, v := range news {
+		newsById[*v.Id] = v.ToModel()
+	}
+
+	itemsByCollection := make(map[string][]*model.News, len(collections))
+	for _, v := range items {
+		if n, ok := newsById[*v.NewsId]; ok {
+			itemsByCollection[*v.CollectionId] = append(itemsByCollection[*v.CollectionId], n)
+		}
+	}
+
+	res := make([]model.NewsSeries, 0, len(collections))
+	for _, collection := range collections {
+		series := itemsByCollection[*collection.Id]
+		for i, v := range series {
+			if *v.Id != newsId {
+				continue
+			}
+
+			item := model.NewsSeries{
+				CollectionId: collection.Id,
+				Title:        collection.Title,
+				Position:     helper.Pointer(i),
+			}
+			if i > 0 {
+				item.Previous = newsLink(series[i-1])
+			}
+			if i < len(series)-1 {
+				item.Next = newsLink(series[i+1])
+			}
+			res = append(res, item)
+		}
+	}
+
+	return res, nil
+}
+
+func getCollection(db *gorm.DB, id string) (*model.Collection, error) {
+	var gormModel Collection
+
+	err := db.Where("id = ?", id).First(&gormModel).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.NewNotFoundError()
+		}
+		return nil, err
+	}
+
+	res := gormModel.ToModel()
+	res.Items, err = listCollectionItems(db, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// lockCollection read the collection for update, the changes of its items are made one at a time
+func lockCollection(tx *gorm.DB, id string) (*Collection, error) {
+	var gormModel Collection
+
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&gormModel).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.NewNotFoundError()
+		}
+		return nil, err
+	}
+
+	return &gormModel, nil
+}
+
+// changedCollection increment the version of the collection after a change of its items, and return it
+func changedCollection(tx *gorm.DB, id string) (*model.Collection, error) {
+	err := tx.Model(&Collection{}).Where("id = ?", id).Update("version", gorm.Expr("version + 1")).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return getCollection(tx, id)
+}
+
+// listCollectionItems return the items in their order with their news, leaving out the deleted news
+func listCollectionItems(db *gorm.DB, id string) ([]model.CollectionItem, error) {
+	var gormModels []CollectionItem
+	err := db.Where("collection_id = ?", id).Order("position").Find(&gormModels).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(gormModels) == 0 {
+		return []model.CollectionItem{}, nil
+	}
+
+	newsIds := make([]string, 0, len(gormModels))
+	for _, v := range gormModels {
+		newsIds = append(newsIds, *v.NewsId)
+	}
+	var news []News
+	err = db.Where("id IN ?", newsIds).Find(&news).Error
+	if err != nil {
+		return nil, err
+	}
+	newsById := make(map[string]*model.News, len(news))
+	for _, v := range news {
+		newsById[*v.Id] = v.ToModel()
+	}
+
+	res := make([]model.CollectionItem, 0, len(gormModels))
+	for _, v := range gormModels {
+		n, ok := newsById[*v.NewsId]
+		if !ok {
+			continue
+		}
+		item := v.ToModel()
+		item.News = n
+		res = append(res, *item)
+	}
+
+	return res, nil
+}
+
+func newsLink(news *model.News) *model.NewsLink {
+	return &model.NewsLink{
+		Id:    news.Id,
+		Title: news.Title,
+		Href:  helper.Pointer("/news/" + *news.Id),
+	}
+}
@@ -0,0 +1,146 @@
+//go:build integration
+// +build integration
+
+package mysqlrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository/mysqlrepo"
+	"tempo/storage"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newsIdsOf(collection *model.Collection) []string {
+	ids := make([]string, 0, len(collection.Items))
+	for _, v := range collection.Items {
+		ids = append(ids, *v.NewsId)
+	}
+	return ids
+}
+
+func TestCollectionRepository_Items(t *testing.T) {
+	t.Run("ShouldKeepTheItemsInOrder_WhenTheyAreAddedRemovedAndReordered", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		first := test.FakeNewsCreate(t, db, nil)
+		second := test.FakeNewsCreate(t, db, nil)
+		third := test.FakeNewsCreate(t, db, nil)
+
+		collectionRepo := mysqlrepo.NewCollectionRepository(db)
+		collection, err := collectionRepo.Add(context.TODO(), &model.Collection{
+			UserId: helper.Pointer("author"),
+			Title:  helper.Pointer("The investigation"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 0, *collection.Version)
+
+		//-- code under test
+		_, err = collectionRepo.AddItem(context.TODO(), *collection.Id, *first.Id, nil)
+		require.NoError(t, err)
+		_, err = collectionRepo.AddItem(context.TODO(), *collection.Id, *third.Id, nil)
+		require.NoError(t, err)
+		inserted, err := collectionRepo.AddItem(context.TODO(), *collection.Id, *second.Id, helper.Pointer(1))
+		require.NoError(t, err)
+		_, err = collectionRepo.AddItem(context.TODO(), *collection.Id, *second.Id, nil)
+		require.True(t, model.IsDuplicateError(err))
+
+		_, staleErr := collectionRepo.Reorder(context.TODO(), *collection.Id, 1, []string{*third.Id, *second.Id, *first.Id})
+		reordered, err := collectionRepo.Reorder(context.TODO(), *collection.Id, *inserted.Version, []string{*third.Id, *second.Id, *first.Id})
+		require.NoError(t, err)
+		removed, err := collectionRepo.RemoveItem(context.TODO(), *collection.Id, *second.Id)
+		require.NoError(t, err)
+
+		//-- assert
+		require.Equal(t, []string{*first.Id, *second.Id, *third.Id}, newsIdsOf(inserted))
+		require.Equal(t, 3, *inserted.Version)
+		require.True(t, model.IsDuplicateError(staleErr))
+		require.Equal(t, []string{*third.Id, *second.Id, *first.Id}, newsIdsOf(reordered))
+		require.Equal(t, []string{*third.Id, *first.Id}, newsIdsOf(removed))
+		require.Equal(t, 1, *removed.Items[1].Position)
+		require.Equal(t, 5, *removed.Version)
+	})
+}
+
+func TestCollectionRepository_ListSeries(t *testing.T) {
+	t.Run("ShouldReturnThePreviousAndNextNews_SkippingTheDeletedAndUnpublishedOnes", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		published := func(news model.News) model.News {
+			news.PublishedAt = helper.Pointer(time.Now())
+			return news
+		}
+		first := test.FakeNewsCreate(t, db, published)
+		deleted := test.FakeNewsCreate(t, db, published)
+		middle := test.FakeNewsCreate(t, db, published)
+		draft := test.FakeNewsCreate(t, db, nil)
+		last := test.FakeNewsCreate(t, db, published)
+
+		collectionRepo := mysqlrepo.NewCollectionRepository(db)
+		collection, err := collectionRepo.Add(context.TODO(), &model.Collection{
+			UserId: helper.Pointer("author"),
+			Title:  helper.Pointer("The investigation"),
+		})
+		require.NoError(t, err)
+		for _, v := range []*model.News{first, deleted, middle, draft, last} {
+			_, err = collectionRepo.AddItem(context.TODO(), *collection.Id, *v.Id, nil)
+			require.NoError(t, err)
+		}
+		require.NoError(t, db.Where("id = ?", *deleted.Id).Delete(&mysqlrepo.News{}).Error)
+
+		//-- code under test
+		res, err := collectionRepo.ListSeries(context.TODO(), *middle.Id)
+
+		//-- assert
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.Equal(t, *collection.Id, *res[0].CollectionId)
+		require.Equal(t, 1, *res[0].Position)
+		require.Equal(t, *first.Id, *res[0].Previous.Id)
+		require.Equal(t, "/news/"+*first.Id, *res[0].Previous.Href)
+		require.Equal(t, *last.Id, *res[0].Next.Id)
+	})
+
+	t.Run("ShouldPlaceTheNews_WhenItIsUnpublished", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		first := test.FakeNewsCreate(t, db, func(news model.News) model.News {
+			news.PublishedAt = helper.Pointer(time.Now())
+			return news
+		})
+		draft := test.FakeNewsCreate(t, db, nil)
+
+		collectionRepo := mysqlrepo.NewCollectionRepository(db)
+		collection, err := collectionRepo.Add(context.TODO(), &model.Collection{
+			UserId: helper.Pointer("author"),
+			Title:  helper.Pointer("The investigation"),
+		})
+		require.NoError(t, err)
+		for _, v := range []*model.News{first, draft} {
+			_, err = collectionRepo.AddItem(context.TODO(), *collection.Id, *v.Id, nil)
+			require.NoError(t, err)
+		}
+
+		//-- code under test
+		res, err := collectionRepo.ListSeries(context.TODO(), *draft.Id)
+
+		//-- assert
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.Equal(t, 1, *res[0].Position)
+		require.Equal(t, *first.Id, *res[0].Previous.Id)
+		require.Nil(t, res[0].Next)
+	})
+}
@@ -0,0 +1,75 @@
+package mysqlrepo
+
+import (
+	"time"
+
+	"tempo/model"
+
+	"github.com/segmentio/ksuid"
+	"gorm.io/gorm"
+)
+
+type Collection struct {
+	Id          *string
+	UserId      *string
+	Title       *string
+	Description *string
+	Version     *int `gorm:"default:0"`
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+}
+
+func (c Collection) FromModel(data model.Collection) *Collection {
+	return &Collection{
+		Id:          data.Id,
+		UserId:      data.UserId,
+		Title:       data.Title,
+		Description: data.Description,
+		Version:     data.Version,
+		CreatedAt:   data.CreatedAt,
+		UpdatedAt:   data.UpdatedAt,
+	}
+}
+
+func (c Collection) ToModel() *model.Collection {
+	return &model.Collection{
+		Id:          c.Id,
+		UserId:      c.UserId,
+		Title:       c.Title,
+		Description: c.Description,
+		Version:     c.Version,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
+
+func (c Collection) TableName() string {
+	return "collections"
+}
+
+func (c *Collection) BeforeCreate(db *gorm.DB) error {
+	if c.Id == nil {
+		db.Statement.SetColumn("id", ksuid.New().String())
+	}
+
+	return nil
+}
+
+type CollectionItem struct {
+	CollectionId *string
+	NewsId       *string
+	Position     *int
+	AddedAt      *time.Time
+}
+
+func (c CollectionItem) ToModel() *model.CollectionItem {
+	return &model.CollectionItem{
+		NewsId:   c.NewsId,
+		Position: c.Position,
+		AddedAt:  c.AddedAt,
+	}
+}
+
+func (c CollectionItem) TableName() string {
+	return "collection_items"
+}
@@ -0,0 +1,135 @@
+package mysqlrepo
+
+import (
+	"context"
+	"time"
+
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+
+	"gorm.io/gorm"
+)
+
+type FeaturedRepo struct {
+	Db *gorm.DB
+}
+
+func NewFeaturedRepository(db *gorm.DB) repository.Featured {
+	return &FeaturedRepo{
+		Db: db,
+	}
+}
+
+func (f *FeaturedRepo) Replace(ctx context.Context, slot string, items []model.FeaturedItem, userId string) ([]model.FeaturedItem, error) {
+	var res []model.FeaturedItem
+	err := f.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current []FeaturedItem
+		err := tx.Where("slot = ?", slot).Find(&current).Error
+		if err != nil {
+			return err
+		}
+		pinned := make(map[string]FeaturedItem, len(current))
+		for _, v := range current {
+			pinned[*v.NewsId] = v
+		}
+
+		now := time.Now()
+		gormModels := make([]FeaturedItem, 0, len(items))
+		for i, v := range items {
+			gormModel := FeaturedItem{}.FromModel(v)
+			gormModel.Slot = helper.Pointer(slot)
+			gormModel.Position = helper.Pointer(i)
+			gormModel.PinnedBy = helper.Pointer(userId)
+			gormModel.PinnedAt = &now
+			if existing, ok := pinned[*v.NewsId]; ok {
+				gormModel.PinnedBy = existing.PinnedBy
+				gormModel.PinnedAt = existing.PinnedAt
+			}
+			gormModels = append(gormModels, *gormModel)
+		}
+
+		err = tx.Where("slot = ?", slot).Delete(&FeaturedItem{}).Error
+		if err != nil {
+			return err
+		}
+		if len(gormModels) > 0 {
+			if err = tx.Create(&gormModels).Error; err != nil {
+				return err
+			}
+		}
+
+		res = make([]model.FeaturedItem, 0, len(gormModels))
+		for _, v := range gormModels {
+			res = append(res, *v.ToModel())
+		}
+
+		audit := FeaturedAudit{}.FromModel(model.FeaturedAudit{
+			Slot:   helper.Pointer(slot),
+			UserId: helper.Pointer(userId),
+			Items:  res,
+		})
+		return tx.Create(&audit).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (f *FeaturedRepo) ListNews(ctx context.Context, filter repository.FeaturedNewsFilter) ([]model.FeaturedNews, error) {
+	var rows []struct {
+		News              `gorm:"embedded"`
+		Position          int
+		FeaturedExpiresAt *time.Time
+	}
+
+	err := f.Db.WithContext(ctx).Table("featured_items").
+		Select("news.*, featured_items.position, featured_items.expires_at AS featured_expires_at").
+		Joins("JOIN news ON news.id = featured_items.news_id").
+		Where("featured_items.slot = ?", filter.Slot).
+		Where("featured_items.expires_at IS NULL OR featured_items.expires_at > ?", filter.At).
+		Where("news.deleted_at IS NULL AND news.published_at IS NOT NULL AND news.published_at <= ?", filter.At).
+		Order("featured_items.position").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]model.FeaturedNews, 0, len(rows))
+	for _, v := range rows {
+		res = append(res, model.FeaturedNews{
+			News:      *v.News.ToModel(),
+			Position:  v.Position,
+			ExpiresAt: v.FeaturedExpiresAt,
+		})
+	}
+
+	return res, nil
+}
+
+func (f *FeaturedRepo) ListAudits(ctx context.Context, filter repository.FeaturedAuditFilter) ([]model.FeaturedAudit, error) {
+	var gormModels []FeaturedAudit
+
+	q := f.Db.WithContext(ctx).Where("slot = ?", filter.Slot)
+	if filter.BeforeCreatedAt != nil && filter.BeforeId != nil {
+		q = q.Where("(created_at < ? OR (created_at = ? AND id < ?))",
+			*filter.BeforeCreatedAt, *filter.BeforeCreatedAt, *filter.BeforeId)
+	}
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit)
+	}
+
+	err := q.Order("created_at DESC, id DESC").Find(&gormModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]model.FeaturedAudit, 0, len(gormModels))
+	for _, v := range gormModels {
+		res = append(res, *v.ToModel())
+	}
+
+	return res, nil
+}
@@ -0,0 +1,94 @@
+//go:build integration
+// +build integration
+
+package mysqlrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mysqlrepo"
+	"tempo/storage"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeaturedRepository_Replace(t *testing.T) {
+	t.Run("ShouldKeepWhoPinnedTheNews_WhenItStaysInTheSlot", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		featuredRepo := mysqlrepo.NewFeaturedRepository(db)
+		_, err := featuredRepo.Replace(context.TODO(), "sidebar", []model.FeaturedItem{
+			{NewsId: helper.Pointer("first")},
+		}, "admin-1")
+		require.NoError(t, err)
+
+		//-- code under test
+		res, err := featuredRepo.Replace(context.TODO(), "sidebar", []model.FeaturedItem{
+			{NewsId: helper.Pointer("second")},
+			{NewsId: helper.Pointer("first")},
+		}, "admin-2")
+
+		//-- assert
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		require.Equal(t, "second", *res[0].NewsId)
+		require.Equal(t, "admin-2", *res[0].PinnedBy)
+		require.Equal(t, "first", *res[1].NewsId)
+		require.Equal(t, 1, *res[1].Position)
+		require.Equal(t, "admin-1", *res[1].PinnedBy)
+
+		audits, err := featuredRepo.ListAudits(context.TODO(), repository.FeaturedAuditFilter{Slot: "sidebar"})
+		require.NoError(t, err)
+		require.Len(t, audits, 2)
+		require.Equal(t, "admin-2", *audits[0].UserId)
+		require.Len(t, audits[0].Items, 2)
+	})
+}
+
+func TestFeaturedRepository_ListNews(t *testing.T) {
+	t.Run("ShouldSkipExpiredItemsAndUnpublishedOrDeletedNews", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		shown := test.FakeNewsCreate(t, db, nil)
+		expired := test.FakeNewsCreate(t, db, nil)
+		unpublished := test.FakeNewsCreate(t, db, nil)
+		require.NoError(t, db.Model(&mysqlrepo.News{}).Where("id = ?", *unpublished.Id).Update("published_at", nil).Error)
+		deleted := test.FakeNewsCreate(t, db, nil)
+		require.NoError(t, db.Where("id = ?", *deleted.Id).Delete(&mysqlrepo.News{}).Error)
+		last := test.FakeNewsCreate(t, db, nil)
+
+		featuredRepo := mysqlrepo.NewFeaturedRepository(db)
+		_, err := featuredRepo.Replace(context.TODO(), "homepage_hero", []model.FeaturedItem{
+			{NewsId: shown.Id, ExpiresAt: helper.Pointer(time.Now().Add(time.Hour))},
+			{NewsId: expired.Id, ExpiresAt: helper.Pointer(time.Now().Add(time.Second))},
+			{NewsId: unpublished.Id},
+			{NewsId: deleted.Id},
+			{NewsId: last.Id},
+		}, "admin")
+		require.NoError(t, err)
+
+		//-- code under test
+		res, err := featuredRepo.ListNews(context.TODO(), repository.FeaturedNewsFilter{
+			Slot: "homepage_hero",
+			At:   time.Now().Add(time.Minute),
+		})
+
+		//-- assert
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		require.Equal(t, *shown.Id, *res[0].Id)
+		require.NotNil(t, res[0].ExpiresAt)
+		require.Equal(t, *last.Id, *res[1].Id)
+		require.Equal(t, 4, res[1].Position)
+	})
+}
@@ -0,0 +1,99 @@
+package mysqlrepo
+
+import (
+	"encoding/json"
+	"time"
+
+	"tempo/helper"
+	"tempo/model"
+
+	"github.com/segmentio/ksuid"
+	"gorm.io/gorm"
+)
+
+type FeaturedItem struct {
+	Slot      *string
+	NewsId    *string
+	Position  *int
+	ExpiresAt *time.Time
+	PinnedBy  *string
+	PinnedAt  *time.Time
+}
+
+func (f FeaturedItem) FromModel(data model.FeaturedItem) *FeaturedItem {
+	return &FeaturedItem{
+		Slot:      data.Slot,
+		NewsId:    data.NewsId,
+		Position:  data.Position,
+		ExpiresAt: data.ExpiresAt,
+		PinnedBy:  data.PinnedBy,
+		PinnedAt:  data.PinnedAt,
+	}
+}
+
+func (f FeaturedItem) ToModel() *model.FeaturedItem {
+	return &model.FeaturedItem{
+		Slot:      f.Slot,
+		NewsId:    f.NewsId,
+		Position:  f.Position,
+		ExpiresAt: f.ExpiresAt,
+		PinnedBy:  f.PinnedBy,
+		PinnedAt:  f.PinnedAt,
+	}
+}
+
+func (f FeaturedItem) TableName() string {
+	return "featured_items"
+}
+
+type FeaturedAudit struct {
+	Id     *string
+	Slot   *string
+	UserId *string
+	// Items is stored as a json array
+	Items     *string
+	CreatedAt *time.Time
+}
+
+func (f FeaturedAudit) FromModel(data model.FeaturedAudit) *FeaturedAudit {
+	var items *string
+	if data.Items != nil {
+		b, _ := json.Marshal(data.Items)
+		items = helper.Pointer(string(b))
+	}
+
+	return &FeaturedAudit{
+		Id:        data.Id,
+		Slot:      data.Slot,
+		UserId:    data.UserId,
+		Items:     items,
+		CreatedAt: data.CreatedAt,
+	}
+}
+
+func (f FeaturedAudit) ToModel() *model.FeaturedAudit {
+	items := []model.FeaturedItem{}
+	if f.Items != nil {
+		_ = json.Unmarshal([]byte(*f.Items), &items)
+	}
+
+	return &model.FeaturedAudit{
+		Id:        f.Id,
+		Slot:      f.Slot,
+		UserId:    f.UserId,
+		Items:     items,
+		CreatedAt: f.CreatedAt,
+	}
+}
+
+func (f FeaturedAudit) TableName() string {
+	return "featured_audits"
+}
+
+func (f *FeaturedAudit) BeforeCreate(db *gorm.DB) error {
+	if f.Id == nil {
+		db.Statement.SetColumn("id", ksuid.New().String())
+	}
+
+	return nil
+}
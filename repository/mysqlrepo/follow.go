@@ -0,0 +1,134 @@
+package mysqlrepo
+
+import (
+	"context"
+	"errors"
+
+	"tempo/model"
+	"tempo/repository"
+
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
+
+type FollowRepo struct {
+	Db *gorm.DB
+}
+
+func NewFollowRepository(db *gorm.DB) repository.Follow {
+	return &FollowRepo{
+		Db: db,
+	}
+}
+
+func (f *FollowRepo) Add(ctx context.Context, follow *model.Follow) (*model.Follow, error) {
+	gormModel := Follow{}.FromModel(*follow)
+
+	if err := f.Db.WithContext(ctx).Create(&gormModel).Error; err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return nil, model.NewDuplicateError()
+		}
+
+		return nil, err
+	}
+
+	return gormModel.ToModel(), nil
+}
+
+func (f *FollowRepo) Delete(ctx context.Context, followerId string, followeeId string) error {
+	res := f.Db.WithContext(ctx).
+		Where("follower_id = ? AND followee_id = ?", followerId, followeeId).
+		Delete(&Follow{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return model.NewNotFoundError()
+	}
+
+	return nil
+}
+
+func (f *FollowRepo) List(ctx context.Context, filter repository.FollowListFilter) ([]model.Follow, error) {
+	var gormModels []Follow
+
+	q := f.Db.WithContext(ctx)
+	if filter.FollowerId != nil {
+		q = q.Where("follower_id = ?", *filter.FollowerId)
+	}
+	if filter.FolloweeId != nil {
+		q = q.Where("followee_id = ?", *filter.FolloweeId)
+	}
+	if filter.AfterCreatedAt != nil && filter.AfterId != nil {
+		q = q.Where("(created_at < ? OR (created_at = ? AND id < ?))",
+			*filter.AfterCreatedAt, *filter.AfterCreatedAt, *filter.AfterId)
+	}
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit)
+	}
+
+	err := q.Order("created_at DESC, id DESC").Find(&gormModels).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(gormModels) == 0 {
+		return []model.Follow{}, nil
+	}
+
+	// attach the other side of the relation
+	otherId := func(v Follow) string {
+		if filter.FollowerId != nil {
+			return *v.FolloweeId
+		}
+		return *v.FollowerId
+	}
+	userIds := make([]string, 0, len(gormModels))
+	for _, v := range gormModels {
+		userIds = append(userIds, otherId(v))
+	}
+	var users []User
+	err = f.Db.WithContext(ctx).Select("id", "full_name").Where("id IN ?", userIds).Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	userById := make(map[string]*model.PublicUser, len(users))
+	for _, v := range users {
+		userById[*v.Id] = v.ToModel().Public()
+	}
+
+	res := make([]model.Follow, 0, len(gormModels))
+	for _, v := range gormModels {
+		follow := v.ToModel()
+		follow.User = userById[otherId(v)]
+		res = append(res, *follow)
+	}
+
+	return res, nil
+}
+
+func (f *FollowRepo) GetFolloweeIds(ctx context.Context, followerId string) ([]string, error) {
+	ids := []string{}
+	err := f.Db.WithContext(ctx).
+		Model(&Follow{}).
+		Where("follower_id = ?", followerId).
+		Pluck("followee_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (f *FollowRepo) GetFollowerIds(ctx context.Context, followeeId string) ([]string, error) {
+	ids := []string{}
+	err := f.Db.WithContext(ctx).
+		Model(&Follow{}).
+		Where("followee_id = ?", followeeId).
+		Pluck("follower_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,90 @@
+//go:build integration
+// +build integration
+
+package mysqlrepo_test
+
+import (
+	"context"
+	"testing"
+
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mysqlrepo"
+	"tempo/storage"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFollowRepository_Add(t *testing.T) {
+	t.Run("ShouldInsertFollow", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		follower := test.FakeUserCreate(t, db, nil)
+		followee := test.FakeUserCreate(t, db, nil)
+
+		//-- code under test
+		followRepo := mysqlrepo.NewFollowRepository(db)
+		res, err := followRepo.Add(context.TODO(), &model.Follow{
+			FollowerId: follower.Id,
+			FolloweeId: followee.Id,
+		})
+
+		//-- assert
+		require.NoError(t, err)
+		require.NotNil(t, res.Id)
+		require.Equal(t, *follower.Id, *res.FollowerId)
+		require.Equal(t, *followee.Id, *res.FolloweeId)
+	})
+
+	t.Run("ShouldReturnError_WhenAlreadyFollowing", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		follower := test.FakeUserCreate(t, db, nil)
+		followee := test.FakeUserCreate(t, db, nil)
+		followRepo := mysqlrepo.NewFollowRepository(db)
+		_, err := followRepo.Add(context.TODO(), &model.Follow{FollowerId: follower.Id, FolloweeId: followee.Id})
+		require.NoError(t, err)
+
+		//-- code under test
+		res, err := followRepo.Add(context.TODO(), &model.Follow{FollowerId: follower.Id, FolloweeId: followee.Id})
+
+		//-- assert
+		require.EqualError(t, err, model.NewDuplicateError().Error())
+		require.Nil(t, res)
+	})
+}
+
+func TestFollowRepository_List(t *testing.T) {
+	t.Run("ShouldAttachTheOtherSideOfTheRelation", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		follower := test.FakeUserCreate(t, db, nil)
+		followee := test.FakeUserCreate(t, db, nil)
+		followRepo := mysqlrepo.NewFollowRepository(db)
+		_, err := followRepo.Add(context.TODO(), &model.Follow{FollowerId: follower.Id, FolloweeId: followee.Id})
+		require.NoError(t, err)
+
+		//-- code under test
+		following, err := followRepo.List(context.TODO(), repository.FollowListFilter{FollowerId: follower.Id})
+		require.NoError(t, err)
+		followers, err := followRepo.List(context.TODO(), repository.FollowListFilter{FolloweeId: followee.Id})
+		require.NoError(t, err)
+		followeeIds, err := followRepo.GetFolloweeIds(context.TODO(), *follower.Id)
+		require.NoError(t, err)
+
+		//-- assert
+		require.Len(t, following, 1)
+		require.Equal(t, *followee.Id, *following[0].User.Id)
+		require.Equal(t, *followee.FullName, *following[0].User.FullName)
+		require.Len(t, followers, 1)
+		require.Equal(t, *follower.Id, *followers[0].User.Id)
+		require.Equal(t, []string{*followee.Id}, followeeIds)
+	})
+}
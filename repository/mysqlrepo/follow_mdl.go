@@ -0,0 +1,46 @@
+package mysqlrepo
+
+import (
+	"tempo/model"
+	"time"
+
+	"github.com/segmentio/ksuid"
+	"gorm.io/gorm"
+)
+
+type Follow struct {
+	Id         *string
+	FollowerId *string
+	FolloweeId *string
+	CreatedAt  *time.Time
+}
+
+func (f Follow) FromModel(data model.Follow) *Follow {
+	return &Follow{
+		Id:         data.Id,
+		FollowerId: data.FollowerId,
+		FolloweeId: data.FolloweeId,
+		CreatedAt:  data.CreatedAt,
+	}
+}
+
+func (f Follow) ToModel() *model.Follow {
+	return &model.Follow{
+		Id:         f.Id,
+		FollowerId: f.FollowerId,
+		FolloweeId: f.FolloweeId,
+		CreatedAt:  f.CreatedAt,
+	}
+}
+
+func (f Follow) TableName() string {
+	return "follows"
+}
+
+func (f *Follow) BeforeCreate(db *gorm.DB) error {
+	if f.Id == nil {
+		db.Statement.SetColumn("id", ksuid.New().String())
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package mysqlrepo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"tempo/model"
+	"tempo/repository"
+
+	"gorm.io/gorm"
+)
+
+type ModerationRepo struct {
+	Db *gorm.DB
+}
+
+func NewModerationRepository(db *gorm.DB) repository.Moderation {
+	return &ModerationRepo{
+		Db: db,
+	}
+}
+
+func (m *ModerationRepo) Add(ctx context.Context, item *model.ModerationItem) (*model.ModerationItem, error) {
+	gormModel := ModerationItem{}.FromModel(*item)
+
+	if err := m.Db.WithContext(ctx).Create(&gormModel).Error; err != nil {
+		return nil, err
+	}
+
+	return m.Get(ctx, *gormModel.Id)
+}
+
+func (m *ModerationRepo) Get(ctx context.Context, id string) (*model.ModerationItem, error) {
+	return getModerationItem(m.Db.WithContext(ctx), id)
+}
+
+func (m *ModerationRepo) List(ctx context.Context, filter repository.ModerationListFilter) ([]model.ModerationItem, error) {
+	var gormModels []ModerationItem
+
+	q := m.Db.WithContext(ctx)
+	if filter.Status != nil {
+		q = q.Where("status = ?", *filter.Status)
+	}
+	if filter.AfterCreatedAt != nil && filter.AfterId != nil {
+		q = q.Where("(created_at > ? OR (created_at = ? AND id > ?))",
+			*filter.AfterCreatedAt, *filter.AfterCreatedAt, *filter.AfterId)
+	}
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit)
+	}
+
+	err := q.Order("created_at ASC, id ASC").Find(&gormModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]model.ModerationItem, 0, len(gormModels))
+	for _, v := range gormModels {
+		res = append(res, *v.ToModel())
+	}
+
+	return res, nil
+}
+
+func (m *ModerationRepo) Resolve(ctx context.Context, id string, status model.ModerationStatus, resolvedBy string, note *string) (*model.ModerationItem, error) {
+	var res *model.ModerationItem
+	err := m.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		item, err := getModerationItem(tx, id)
+		if err != nil {
+			return err
+		}
+
+		updated := tx.Model(&ModerationItem{}).
+			Where("id = ? AND status = ?", id, model.ModerationPending).
+			Updates(map[string]interface{}{
+				"status":      status,
+				"resolved_by": resolvedBy,
+				"resolved_at": time.Now(),
+				"note":        note,
+			})
+		if updated.Error != nil {
+			return updated.Error
+		}
+		if updated.RowsAffected == 0 {
+			return model.NewError("moderation item is already resolved", model.ErrorDuplicate)
+		}
+
+		if status == model.ModerationRemoved {
+			if err = tx.Where("id = ?", *item.NewsId).Delete(&News{}).Error; err != nil {
+				return err
+			}
+		}
+
+		res, err = getModerationItem(tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func getModerationItem(db *gorm.DB, id string) (*model.ModerationItem, error) {
+	var gormModel ModerationItem
+
+	err := db.Where("id = ?", id).First(&gormModel).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.NewNotFoundError()
+		}
+		return nil, err
+	}
+
+	return gormModel.ToModel(), nil
+}
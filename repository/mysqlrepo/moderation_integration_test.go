@@ -0,0 +1,84 @@
+//go:build integration
+// +build integration
+
+package mysqlrepo_test
+
+import (
+	"context"
+	"testing"
+
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mysqlrepo"
+	"tempo/storage"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestModerationRepository_Resolve(t *testing.T) {
+	t.Run("ShouldRemoveTheNews_WhenStatusIsRemoved", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		fakeNews := test.FakeNews(t, nil)
+		newsRepo := mysqlrepo.NewNewsRepository(db)
+		news, err := newsRepo.Add(context.TODO(), &fakeNews)
+		require.NoError(t, err)
+
+		moderationRepo := mysqlrepo.NewModerationRepository(db)
+		item, err := moderationRepo.Add(context.TODO(), &model.ModerationItem{
+			NewsId: news.Id,
+			UserId: news.UserId,
+			Flags: []model.ModerationResult{{
+				Check:   "link_limit",
+				Verdict: model.ModerationFlag,
+				Reason:  "too many links",
+			}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, model.ModerationPending, *item.Status)
+
+		//-- code under test
+		resolved, err := moderationRepo.Resolve(context.TODO(), *item.Id, model.ModerationRemoved, "admin", helper.Pointer("spam"))
+
+		//-- assert
+		require.NoError(t, err)
+		require.Equal(t, model.ModerationRemoved, *resolved.Status)
+		require.Equal(t, "admin", *resolved.ResolvedBy)
+		require.NotNil(t, resolved.ResolvedAt)
+		require.Len(t, resolved.Flags, 1)
+
+		_, err = newsRepo.Get(context.TODO(), news.Id)
+		require.True(t, model.IsNotFoundError(err))
+	})
+
+	t.Run("ShouldReturnDuplicateError_WhenItemIsAlreadyResolved", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		moderationRepo := mysqlrepo.NewModerationRepository(db)
+		item, err := moderationRepo.Add(context.TODO(), &model.ModerationItem{
+			NewsId: helper.Pointer("news"),
+			UserId: helper.Pointer("user"),
+		})
+		require.NoError(t, err)
+		_, err = moderationRepo.Resolve(context.TODO(), *item.Id, model.ModerationApproved, "admin", nil)
+		require.NoError(t, err)
+
+		//-- code under test
+		_, err = moderationRepo.Resolve(context.TODO(), *item.Id, model.ModerationRemoved, "admin", nil)
+
+		//-- assert
+		require.True(t, model.IsDuplicateError(err))
+
+		pending, err := moderationRepo.List(context.TODO(), repository.ModerationListFilter{
+			Status: helper.Pointer(model.ModerationPending),
+		})
+		require.NoError(t, err)
+		require.Empty(t, pending)
+	})
+}
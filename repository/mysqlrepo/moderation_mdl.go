@@ -0,0 +1,79 @@
+package mysqlrepo
+
+import (
+	"encoding/json"
+	"time"
+
+	"tempo/helper"
+	"tempo/model"
+
+	"github.com/segmentio/ksuid"
+	"gorm.io/gorm"
+)
+
+type ModerationItem struct {
+	Id     *string
+	NewsId *string
+	UserId *string
+	Status *string `gorm:"default:pending"`
+	// Flags is stored as a json array
+	Flags      *string
+	ResolvedBy *string
+	ResolvedAt *time.Time
+	Note       *string
+	CreatedAt  *time.Time
+	UpdatedAt  *time.Time
+}
+
+func (m ModerationItem) FromModel(data model.ModerationItem) *ModerationItem {
+	var flags *string
+	if data.Flags != nil {
+		b, _ := json.Marshal(data.Flags)
+		flags = helper.Pointer(string(b))
+	}
+
+	return &ModerationItem{
+		Id:         data.Id,
+		NewsId:     data.NewsId,
+		UserId:     data.UserId,
+		Status:     (*string)(data.Status),
+		Flags:      flags,
+		ResolvedBy: data.ResolvedBy,
+		ResolvedAt: data.ResolvedAt,
+		Note:       data.Note,
+		CreatedAt:  data.CreatedAt,
+		UpdatedAt:  data.UpdatedAt,
+	}
+}
+
+func (m ModerationItem) ToModel() *model.ModerationItem {
+	var flags []model.ModerationResult
+	if m.Flags != nil {
+		_ = json.Unmarshal([]byte(*m.Flags), &flags)
+	}
+
+	return &model.ModerationItem{
+		Id:         m.Id,
+		NewsId:     m.NewsId,
+		UserId:     m.UserId,
+		Status:     (*model.ModerationStatus)(m.Status),
+		Flags:      flags,
+		ResolvedBy: m.ResolvedBy,
+		ResolvedAt: m.ResolvedAt,
+		Note:       m.Note,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+}
+
+func (m ModerationItem) TableName() string {
+	return "moderation_items"
+}
+
+func (m *ModerationItem) BeforeCreate(db *gorm.DB) error {
+	if m.Id == nil {
+		db.Statement.SetColumn("id", ksuid.New().String())
+	}
+
+	return nil
+}
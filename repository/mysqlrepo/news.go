@@ -3,7 +3,10 @@ package mysqlrepo
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
+	"tempo/helper"
 	"tempo/model"
 	"tempo/repository"
 
@@ -24,7 +27,23 @@ func NewNewsRepository(db *gorm.DB) repository.News {
 func (u *NewsRepo) Add(ctx context.Context, news *model.News) (*model.News, error) {
 	gormModel := News{}.FromModel(*news)
 
-	if err := u.Db.WithContext(ctx).Create(&gormModel).Error; err != nil {
+	err := u.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&gormModel).Error; err != nil {
+			return err
+		}
+
+		err := tx.Create(&NewsAuthor{
+			NewsId: gormModel.Id,
+			UserId: gormModel.UserId,
+			Role:   helper.Pointer(string(model.NewsAuthorOwner)),
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return addOutboxEvent(tx, model.EventNewsCreated, *gormModel.Id, gormModel.ToModel())
+	})
+	if err != nil {
 		var mysqlErr *mysql.MySQLError
 		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
 			return nil, model.NewDuplicateError()
@@ -37,37 +56,218 @@ func (u *NewsRepo) Add(ctx context.Context, news *model.News) (*model.News, erro
 }
 
 func (u *NewsRepo) Get(ctx context.Context, id *string) (*model.News, error) {
-	gormModel := News{}
-	q := u.Db.WithContext(ctx).Where("id = ?", *id)
+	return getNews(u.Db.WithContext(ctx), *id)
+}
+
+func (n *NewsRepo) Update(ctx context.Context, id *string, user *model.News) (*model.News, error) {
+	gormModel := News{}.FromModel(*user)
+
+	var res *model.News
+	err := n.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		_, err := getNews(tx, *id)
+		if err != nil {
+			return err
+		}
 
-	err := q.First(&gormModel).Error
+		err = tx.Model(&News{Id: id}).Updates(&gormModel).Error
+		if err != nil {
+			return err
+		}
+
+		res, err = getNews(tx, *id)
+		if err != nil {
+			return err
+		}
+
+		if gormModel.Title != nil || gormModel.Description != nil {
+			// the text changed, the near duplicates are found with the fingerprint of the updated news
+			res.Fingerprint = helper.Pointer(res.ComputeFingerprint())
+			err = tx.Model(&News{Id: id}).Update("fingerprint", *res.Fingerprint).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return addOutboxEvent(tx, model.EventNewsUpdated, *id, res)
+	})
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, model.NewNotFoundError()
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return nil, model.NewDuplicateError()
 		}
 		return nil, err
 	}
 
-	return gormModel.ToModel(), nil
+	return res, nil
 }
 
-func (n *NewsRepo) Update(ctx context.Context, id *string, user *model.News) (*model.News, error) {
-	_, err := n.Get(ctx, id)
+func (n *NewsRepo) List(ctx context.Context, filter repository.NewsListFilter) ([]model.News, error) {
+	var gormModels []News
+
+	q := n.Db.WithContext(ctx).Where("published_at IS NOT NULL")
+	if filter.UserIds != nil {
+		q = q.Where("user_id IN ?", filter.UserIds)
+	}
+	if filter.PublishedSince != nil {
+		q = q.Where("published_at >= ?", *filter.PublishedSince)
+	}
+	if filter.PublishedUntil != nil {
+		q = q.Where("published_at < ?", *filter.PublishedUntil)
+	}
+	if filter.BeforePublishedAt != nil && filter.BeforeId != nil {
+		q = q.Where("(published_at < ? OR (published_at = ? AND id < ?))",
+			*filter.BeforePublishedAt, *filter.BeforePublishedAt, *filter.BeforeId)
+	}
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit)
+	}
+
+	err := q.Order("published_at DESC, id DESC").Find(&gormModels).Error
 	if err != nil {
 		return nil, err
 	}
 
-	gormModel := News{}.FromModel(*user)
+	res := make([]model.News, 0, len(gormModels))
+	for _, v := range gormModels {
+		res = append(res, *v.ToModel())
+	}
+
+	return res, nil
+}
 
-	tx := n.Db.WithContext(ctx)
-	err = tx.Model(&News{Id: id}).Updates(&gormModel).Error
+func (n *NewsRepo) PublishedRange(ctx context.Context) (*time.Time, *time.Time, error) {
+	var row struct {
+		First *time.Time
+		Last  *time.Time
+	}
+
+	err := n.Db.WithContext(ctx).Model(&News{}).
+		Select("MIN(published_at) AS first, MAX(published_at) AS last").
+		Where("published_at IS NOT NULL").
+		Scan(&row).Error
 	if err != nil {
-		var mysqlErr *mysql.MySQLError
-		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
-			return nil, model.NewDuplicateError()
+		return nil, nil, err
+	}
+
+	return row.First, row.Last, nil
+}
+
+func (n *NewsRepo) CountPublished(ctx context.Context, bounds []time.Time) ([]int64, error) {
+	if len(bounds) < 2 {
+		return []int64{}, nil
+	}
+
+	var rows []struct {
+		Period int
+		Count  int64
+	}
+
+	// INTERVAL return the number of bounds lower or equal to its first argument, so the news of the first period
+	// are in period 1, with a single pass on the published_at index whatever the number of periods
+	args := make([]interface{}, 0, len(bounds))
+	for _, v := range bounds {
+		args = append(args, v.Unix())
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(bounds)), ", ")
+
+	err := n.Db.WithContext(ctx).Model(&News{}).
+		Select("INTERVAL(UNIX_TIMESTAMP(published_at), "+placeholders+") AS period, COUNT(*) AS count", args...).
+		Where("published_at >= ? AND published_at < ?", bounds[0], bounds[len(bounds)-1]).
+		Group("period").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]int64, len(bounds)-1)
+	for _, v := range rows {
+		if v.Period >= 1 && v.Period < len(bounds) {
+			res[v.Period-1] = v.Count
 		}
+	}
+
+	return res, nil
+}
+
+func (n *NewsRepo) ListSimilar(ctx context.Context, filter repository.NewsSimilarFilter) ([]model.NewsDuplicate, error) {
+	var rows []struct {
+		News     `gorm:"embedded"`
+		Distance int
+	}
+
+	q := n.Db.WithContext(ctx).Model(&News{}).
+		Select("news.*, BIT_COUNT(fingerprint ^ ?) AS distance", filter.Fingerprint).
+		Where("fingerprint IS NOT NULL AND BIT_COUNT(fingerprint ^ ?) <= ?", filter.Fingerprint, filter.MaxDistance)
+	if filter.ExcludeId != nil {
+		q = q.Where("id <> ?", *filter.ExcludeId)
+	}
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit)
+	}
+
+	err := q.Order("distance, created_at, id").Scan(&rows).Error
+	if err != nil {
 		return nil, err
 	}
 
-	return n.Get(ctx, id)
+	res := make([]model.NewsDuplicate, 0, len(rows))
+	for _, v := range rows {
+		res = append(res, model.NewsDuplicate{
+			News:     *v.News.ToModel(),
+			Distance: v.Distance,
+		})
+	}
+
+	return res, nil
+}
+
+func (n *NewsRepo) ListBackfill(ctx context.Context, filter repository.NewsBackfillFilter) ([]model.News, error) {
+	var gormModels []News
+
+	q := n.Db.WithContext(ctx).Unscoped()
+	if filter.AfterId != nil {
+		q = q.Where("id > ?", *filter.AfterId)
+	}
+	if filter.MissingReading {
+		q = q.Where("word_count IS NULL")
+	}
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit)
+	}
+
+	err := q.Order("id").Find(&gormModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]model.News, 0, len(gormModels))
+	for _, v := range gormModels {
+		res = append(res, *v.ToModel())
+	}
+
+	return res, nil
+}
+
+func (n *NewsRepo) SetReading(ctx context.Context, id string, news *model.News) error {
+	// setting updated_at to itself keep the column from being bumped on update
+	return n.Db.WithContext(ctx).Unscoped().Model(&News{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{
+		"excerpt":         news.Excerpt,
+		"word_count":      news.WordCount,
+		"reading_minutes": news.ReadingMinutes,
+		"updated_at":      gorm.Expr("updated_at"),
+	}).Error
+}
+
+func getNews(db *gorm.DB, id string) (*model.News, error) {
+	gormModel := News{}
+
+	err := db.Where("id = ?", id).First(&gormModel).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.NewNotFoundError()
+		}
+		return nil, err
+	}
+
+	return gormModel.ToModel(), nil
 }
@@ -0,0 +1,139 @@
+package mysqlrepo
+
+import (
+	"context"
+	"errors"
+
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NewsAuthorRepo struct {
+	Db *gorm.DB
+}
+
+func NewNewsAuthorRepository(db *gorm.DB) repository.NewsAuthor {
+	return &NewsAuthorRepo{
+		Db: db,
+	}
+}
+
+func (n *NewsAuthorRepo) Add(ctx context.Context, author *model.NewsAuthor) (*model.NewsAuthor, error) {
+	gormModel := NewsAuthor{}.FromModel(*author)
+
+	err := n.Db.WithContext(ctx).Create(&gormModel).Error
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return nil, model.NewDuplicateError()
+		}
+		return nil, err
+	}
+
+	return n.Get(ctx, *gormModel.NewsId, *gormModel.UserId)
+}
+
+func (n *NewsAuthorRepo) Get(ctx context.Context, newsId string, userId string) (*model.NewsAuthor, error) {
+	res, err := n.List(ctx, repository.NewsAuthorListFilter{NewsIds: []string{newsId}})
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range res {
+		if *v.UserId == userId {
+			return &v, nil
+		}
+	}
+
+	return nil, model.NewNotFoundError()
+}
+
+func (n *NewsAuthorRepo) List(ctx context.Context, filter repository.NewsAuthorListFilter) ([]model.NewsAuthor, error) {
+	var rows []struct {
+		NewsAuthor `gorm:"embedded"`
+		FullName   *string
+	}
+
+	q := n.Db.WithContext(ctx).Table("news_authors").
+		Select("news_authors.*, users.full_name").
+		Joins("LEFT JOIN users ON users.id = news_authors.user_id")
+	if filter.NewsIds != nil {
+		q = q.Where("news_authors.news_id IN ?", filter.NewsIds)
+	}
+	if filter.Roles != nil {
+		q = q.Where("news_authors.role IN ?", filter.Roles)
+	}
+
+	err := q.Order(clause.Expr{
+		SQL:  "FIELD(news_authors.role, ?, ?, ?), news_authors.created_at, news_authors.user_id",
+		Vars: []interface{}{model.NewsAuthorOwner, model.NewsAuthorCoAuthor, model.NewsAuthorEditor},
+	}).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]model.NewsAuthor, 0, len(rows))
+	for _, v := range rows {
+		author := v.NewsAuthor.ToModel()
+		author.FullName = v.FullName
+		res = append(res, *author)
+	}
+
+	return res, nil
+}
+
+func (n *NewsAuthorRepo) Remove(ctx context.Context, newsId string, userId string) error {
+	res := n.Db.WithContext(ctx).
+		Where("news_id = ? AND user_id = ? AND role <> ?", newsId, userId, model.NewsAuthorOwner).
+		Delete(&NewsAuthor{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return model.NewNotFoundError()
+	}
+
+	return nil
+}
+
+func (n *NewsAuthorRepo) TransferOwnership(ctx context.Context, newsId string, userId string) error {
+	return n.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var news News
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", newsId).First(&news).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return model.NewNotFoundError()
+			}
+			return err
+		}
+		if *news.UserId == userId {
+			return nil
+		}
+
+		err = tx.Model(&NewsAuthor{}).
+			Where("news_id = ? AND role = ?", newsId, model.NewsAuthorOwner).
+			Update("role", model.NewsAuthorCoAuthor).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Clauses(clause.OnConflict{
+			DoUpdates: clause.Assignments(map[string]interface{}{"role": model.NewsAuthorOwner}),
+		}).Create(&NewsAuthor{
+			NewsId:    &newsId,
+			UserId:    &userId,
+			Role:      helper.Pointer(string(model.NewsAuthorOwner)),
+			InvitedBy: news.UserId,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		// the owner is still the author of record on the news
+		return tx.Model(&News{}).Where("id = ?", newsId).Update("user_id", userId).Error
+	})
+}
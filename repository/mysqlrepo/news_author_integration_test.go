@@ -0,0 +1,63 @@
+//go:build integration
+// +build integration
+
+package mysqlrepo_test
+
+import (
+	"context"
+	"testing"
+
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mysqlrepo"
+	"tempo/storage"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewsAuthorRepository_TransferOwnership(t *testing.T) {
+	t.Run("ShouldSwapTheOwner_AndKeepThePreviousOneAsCoAuthor", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		owner := test.FakeUserCreate(t, db, nil)
+		editor := test.FakeUserCreate(t, db, nil)
+		news := test.FakeNewsCreate(t, db, func(news model.News) model.News {
+			news.UserId = owner.Id
+			return news
+		})
+
+		newsAuthorRepo := mysqlrepo.NewNewsAuthorRepository(db)
+		_, err := newsAuthorRepo.Add(context.TODO(), &model.NewsAuthor{
+			NewsId:    news.Id,
+			UserId:    editor.Id,
+			Role:      helper.Pointer(model.NewsAuthorEditor),
+			InvitedBy: owner.Id,
+		})
+		require.NoError(t, err)
+
+		//-- code under test
+		removeOwnerErr := newsAuthorRepo.Remove(context.TODO(), *news.Id, *owner.Id)
+		err = newsAuthorRepo.TransferOwnership(context.TODO(), *news.Id, *editor.Id)
+		require.NoError(t, err)
+
+		//-- assert
+		require.True(t, model.IsNotFoundError(removeOwnerErr))
+
+		authors, err := newsAuthorRepo.List(context.TODO(), repository.NewsAuthorListFilter{NewsIds: []string{*news.Id}})
+		require.NoError(t, err)
+		require.Len(t, authors, 2)
+		require.Equal(t, *editor.Id, *authors[0].UserId)
+		require.Equal(t, model.NewsAuthorOwner, *authors[0].Role)
+		require.Equal(t, *editor.FullName, *authors[0].FullName)
+		require.Equal(t, *owner.Id, *authors[1].UserId)
+		require.Equal(t, model.NewsAuthorCoAuthor, *authors[1].Role)
+
+		updated, err := mysqlrepo.NewNewsRepository(db).Get(context.TODO(), news.Id)
+		require.NoError(t, err)
+		require.Equal(t, *editor.Id, *updated.UserId)
+	})
+}
@@ -0,0 +1,39 @@
+package mysqlrepo
+
+import (
+	"time"
+
+	"tempo/model"
+)
+
+type NewsAuthor struct {
+	NewsId    *string
+	UserId    *string
+	Role      *string
+	InvitedBy *string
+	CreatedAt *time.Time
+}
+
+func (n NewsAuthor) FromModel(data model.NewsAuthor) *NewsAuthor {
+	return &NewsAuthor{
+		NewsId:    data.NewsId,
+		UserId:    data.UserId,
+		Role:      (*string)(data.Role),
+		InvitedBy: data.InvitedBy,
+		CreatedAt: data.CreatedAt,
+	}
+}
+
+func (n NewsAuthor) ToModel() *model.NewsAuthor {
+	return &model.NewsAuthor{
+		NewsId:    n.NewsId,
+		UserId:    n.UserId,
+		Role:      (*model.NewsAuthorRole)(n.Role),
+		InvitedBy: n.InvitedBy,
+		CreatedAt: n.CreatedAt,
+	}
+}
+
+func (n NewsAuthor) TableName() string {
+	return "news_authors"
+}
@@ -6,10 +6,12 @@ package mysqlrepo_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"tempo/helper"
 	"tempo/helper/test"
 	"tempo/model"
+	"tempo/repository"
 	"tempo/repository/mysqlrepo"
 	"tempo/storage"
 
@@ -135,3 +137,265 @@ func TestNewsRepository_Update(t *testing.T) {
 	})
 
 }
+
+func TestNewsRepository_Tags(t *testing.T) {
+	t.Run("ShouldStoreTheTags_UntilTheyAreReplaced", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		fakeNews := test.FakeNewsCreate(t, db, func(news model.News) model.News {
+			news.Tags = []string{"live", "politics"}
+			return news
+		})
+		newsRepo := mysqlrepo.NewNewsRepository(db)
+
+		//-- code under test
+		added, err := newsRepo.Get(context.TODO(), fakeNews.Id)
+		require.NoError(t, err)
+		untouched, err := newsRepo.Update(context.TODO(), fakeNews.Id, &model.News{Title: helper.Pointer("title")})
+		require.NoError(t, err)
+		replaced, err := newsRepo.Update(context.TODO(), fakeNews.Id, &model.News{Tags: []string{"sport"}})
+		require.NoError(t, err)
+		cleared, err := newsRepo.Update(context.TODO(), fakeNews.Id, &model.News{Tags: []string{}})
+		require.NoError(t, err)
+
+		//-- assert
+		require.Equal(t, []string{"live", "politics"}, added.Tags)
+		require.Equal(t, []string{"live", "politics"}, untouched.Tags)
+		require.Equal(t, []string{"sport"}, replaced.Tags)
+		require.Empty(t, cleared.Tags)
+	})
+
+	t.Run("ShouldAddTheNews_WhenItHasNoTags", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		newsRepo := mysqlrepo.NewNewsRepository(db)
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.Tags = nil
+			return news
+		})
+
+		//-- code under test
+		res, err := newsRepo.Add(context.TODO(), &fakeNews)
+
+		//-- assert
+		require.NoError(t, err)
+		require.Empty(t, res.Tags)
+	})
+}
+
+func TestNewsRepository_List(t *testing.T) {
+	t.Run("ShouldListPublishedNewsOfTheAuthorsNewestFirst", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		now := time.Now().Truncate(time.Second)
+		authorId := helper.Pointer(fake.CharactersN(7))
+		older := test.FakeNewsCreate(t, db, func(news model.News) model.News {
+			news.UserId = authorId
+			news.PublishedAt = helper.Pointer(now.Add(-time.Hour))
+			return news
+		})
+		newer := test.FakeNewsCreate(t, db, func(news model.News) model.News {
+			news.UserId = authorId
+			news.PublishedAt = helper.Pointer(now)
+			return news
+		})
+		unpublished := test.FakeNewsCreate(t, db, func(news model.News) model.News {
+			news.UserId = authorId
+			return news
+		})
+		require.NoError(t, db.Model(&mysqlrepo.News{}).Where("id = ?", *unpublished.Id).Update("published_at", nil).Error)
+		test.FakeNewsCreate(t, db, nil)
+
+		//-- code under test
+		newsRepo := mysqlrepo.NewNewsRepository(db)
+		firstPage, err := newsRepo.List(context.TODO(), repository.NewsListFilter{
+			UserIds: []string{*authorId},
+			Limit:   1,
+		})
+		require.NoError(t, err)
+		secondPage, err := newsRepo.List(context.TODO(), repository.NewsListFilter{
+			UserIds:           []string{*authorId},
+			BeforePublishedAt: firstPage[0].PublishedAt,
+			BeforeId:          firstPage[0].Id,
+		})
+		require.NoError(t, err)
+
+		//-- assert
+		require.Len(t, firstPage, 1)
+		require.Equal(t, *newer.Id, *firstPage[0].Id)
+		require.Len(t, secondPage, 1)
+		require.Equal(t, *older.Id, *secondPage[0].Id)
+	})
+}
+
+func TestNewsRepository_CountPublished(t *testing.T) {
+	t.Run("ShouldCountThePublishedNewsOfEachPeriod", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		january := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+		february := time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)
+		march := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+		for _, publishedAt := range []time.Time{january, february.Add(-time.Second), march.Add(-time.Second)} {
+			publishedAt := publishedAt
+			test.FakeNewsCreate(t, db, func(news model.News) model.News {
+				news.PublishedAt = &publishedAt
+				return news
+			})
+		}
+		deleted := test.FakeNewsCreate(t, db, func(news model.News) model.News {
+			news.PublishedAt = helper.Pointer(february)
+			return news
+		})
+		require.NoError(t, db.Delete(&mysqlrepo.News{}, "id = ?", *deleted.Id).Error)
+
+		//-- code under test
+		newsRepo := mysqlrepo.NewNewsRepository(db)
+		first, last, err := newsRepo.PublishedRange(context.TODO())
+		require.NoError(t, err)
+		counts, err := newsRepo.CountPublished(context.TODO(), []time.Time{january, february, march})
+		require.NoError(t, err)
+
+		//-- assert
+		require.True(t, january.Equal(*first))
+		require.True(t, march.Add(-time.Second).Equal(*last))
+		require.Equal(t, []int64{2, 1}, counts)
+	})
+}
+
+func TestNewsRepository_ListPublishedBetween(t *testing.T) {
+	t.Run("ShouldListOnlyTheNewsPublishedInThePeriod", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		since := time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)
+		until := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+		inside := test.FakeNewsCreate(t, db, func(news model.News) model.News {
+			news.PublishedAt = &since
+			return news
+		})
+		test.FakeNewsCreate(t, db, func(news model.News) model.News {
+			news.PublishedAt = &until
+			return news
+		})
+
+		//-- code under test
+		newsRepo := mysqlrepo.NewNewsRepository(db)
+		res, err := newsRepo.List(context.TODO(), repository.NewsListFilter{
+			PublishedSince: &since,
+			PublishedUntil: &until,
+		})
+		require.NoError(t, err)
+
+		//-- assert
+		require.Len(t, res, 1)
+		require.Equal(t, *inside.Id, *res[0].Id)
+	})
+}
+
+func TestNewsRepository_ListSimilar(t *testing.T) {
+	t.Run("ShouldReturnTheNewsWithinTheDistance_ClosestFirst", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		self := test.FakeNewsCreate(t, db, func(news model.News) model.News {
+			news.Fingerprint = helper.Pointer(uint64(0xF0))
+			return news
+		})
+		far := test.FakeNewsCreate(t, db, func(news model.News) model.News {
+			news.Fingerprint = helper.Pointer(uint64(0xF3))
+			return news
+		})
+		near := test.FakeNewsCreate(t, db, func(news model.News) model.News {
+			news.Fingerprint = helper.Pointer(uint64(0xF1))
+			return news
+		})
+		test.FakeNewsCreate(t, db, func(news model.News) model.News {
+			news.Fingerprint = helper.Pointer(uint64(0xFFFF))
+			return news
+		})
+		test.FakeNewsCreate(t, db, nil)
+
+		//-- code under test
+		newsRepo := mysqlrepo.NewNewsRepository(db)
+		res, err := newsRepo.ListSimilar(context.TODO(), repository.NewsSimilarFilter{
+			Fingerprint: 0xF0,
+			MaxDistance: 2,
+			ExcludeId:   self.Id,
+		})
+
+		//-- assert
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		require.Equal(t, *near.Id, *res[0].Id)
+		require.Equal(t, 1, res[0].Distance)
+		require.Equal(t, *far.Id, *res[1].Id)
+		require.Equal(t, 2, res[1].Distance)
+	})
+}
+
+func TestNewsRepository_UpdateFingerprint(t *testing.T) {
+	t.Run("ShouldRefreshTheFingerprint_WhenTextIsUpdated", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		news := test.FakeNewsCreate(t, db, nil)
+		newsRepo := mysqlrepo.NewNewsRepository(db)
+
+		//-- code under test
+		res, err := newsRepo.Update(context.TODO(), news.Id, &model.News{
+			Title: helper.Pointer("a new title"),
+		})
+
+		//-- assert
+		require.NoError(t, err)
+		updated, err := newsRepo.Get(context.TODO(), news.Id)
+		require.NoError(t, err)
+		require.NotNil(t, updated.Fingerprint)
+		require.Equal(t, res.ComputeFingerprint(), *updated.Fingerprint)
+	})
+}
+
+func TestNewsRepository_SetReading(t *testing.T) {
+	t.Run("ShouldStoreTheReadingMetadata_WithoutChangingUpdatedAt", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		updatedAt := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+		missing := test.FakeNewsCreate(t, db, nil)
+		done := test.FakeNewsCreate(t, db, nil)
+		require.NoError(t, db.Model(&mysqlrepo.News{}).Where("id = ?", *missing.Id).UpdateColumn("updated_at", updatedAt).Error)
+
+		news := &model.News{Description: helper.Pointer("The council approved the budget.")}
+		news.ComputeReading(280, 230, 500)
+
+		//-- code under test
+		newsRepo := mysqlrepo.NewNewsRepository(db)
+		err := newsRepo.SetReading(context.TODO(), *missing.Id, news)
+		require.NoError(t, err)
+		err = newsRepo.SetReading(context.TODO(), *done.Id, news)
+		require.NoError(t, err)
+		got, err := newsRepo.Get(context.TODO(), missing.Id)
+		require.NoError(t, err)
+		remaining, err := newsRepo.ListBackfill(context.TODO(), repository.NewsBackfillFilter{MissingReading: true})
+		require.NoError(t, err)
+
+		//-- assert
+		require.Equal(t, "The council approved the budget.", *got.Excerpt)
+		require.Equal(t, 5, *got.WordCount)
+		require.Equal(t, 1, *got.ReadingMinutes)
+		require.True(t, updatedAt.Equal(*got.UpdatedAt))
+		require.Empty(t, remaining)
+	})
+}
@@ -1,9 +1,12 @@
 package mysqlrepo
 
 import (
-	"tempo/model"
+	"strings"
 	"time"
 
+	"tempo/helper"
+	"tempo/model"
+
 	"github.com/segmentio/ksuid"
 	"gorm.io/gorm"
 )
@@ -13,8 +16,17 @@ type News struct {
 	UserId      *string
 	Title       *string
 	Description *string
+	// Tags are joined with commas, which the tags can't contain
+	Tags        *string `gorm:"default:''"`
+	PublishedAt *time.Time
 	CreatedAt   *time.Time
 	UpdatedAt   *time.Time
+	Fingerprint *uint64
+	DeletedAt   gorm.DeletedAt
+
+	Excerpt        *string
+	WordCount      *int
+	ReadingMinutes *int
 }
 
 func (n News) FromModel(data model.News) *News {
@@ -23,8 +35,15 @@ func (n News) FromModel(data model.News) *News {
 		UserId:      data.UserId,
 		Title:       data.Title,
 		Description: data.Description,
+		Tags:        joinTags(data.Tags),
+		PublishedAt: data.PublishedAt,
 		CreatedAt:   data.CreatedAt,
 		UpdatedAt:   data.UpdatedAt,
+		Fingerprint: data.Fingerprint,
+
+		Excerpt:        data.Excerpt,
+		WordCount:      data.WordCount,
+		ReadingMinutes: data.ReadingMinutes,
 	}
 }
 
@@ -34,11 +53,32 @@ func (n News) ToModel() *model.News {
 		UserId:      n.UserId,
 		Title:       n.Title,
 		Description: n.Description,
+		Tags:        splitTags(n.Tags),
+		PublishedAt: n.PublishedAt,
 		CreatedAt:   n.CreatedAt,
 		UpdatedAt:   n.UpdatedAt,
+		Fingerprint: n.Fingerprint,
+
+		Excerpt:        n.Excerpt,
+		WordCount:      n.WordCount,
+		ReadingMinutes: n.ReadingMinutes,
 	}
 }
 
+func joinTags(tags []string) *string {
+	if tags == nil {
+		return nil
+	}
+	return helper.Pointer(strings.Join(tags, ","))
+}
+
+func splitTags(tags *string) []string {
+	if tags == nil || *tags == "" {
+		return nil
+	}
+	return strings.Split(*tags, ",")
+}
+
 func (n News) TableName() string {
 	return "news"
 }
@@ -47,6 +87,9 @@ func (n *News) BeforeCreate(db *gorm.DB) error {
 	if n.Id == nil {
 		db.Statement.SetColumn("id", ksuid.New().String())
 	}
+	if n.PublishedAt == nil {
+		db.Statement.SetColumn("published_at", time.Now())
+	}
 
 	return nil
 }
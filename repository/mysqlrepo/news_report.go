@@ -0,0 +1,253 @@
+package mysqlrepo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NewsReportRepo struct {
+	Db *gorm.DB
+}
+
+func NewNewsReportRepository(db *gorm.DB) repository.NewsReport {
+	return &NewsReportRepo{
+		Db: db,
+	}
+}
+
+func (n *NewsReportRepo) Add(ctx context.Context, report *model.NewsReport, hideThreshold int) (*model.NewsReport, error) {
+	gormModel := NewsReport{}.FromModel(*report)
+
+	err := n.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// the news row is locked so the reports of the news are counted one at a time
+		news, err := lockNews(tx, *report.NewsId)
+		if err != nil {
+			return err
+		}
+
+		var reported int64
+		err = tx.Model(&NewsReport{}).
+			Where("news_id = ? AND user_id = ? AND resolution_id IS NULL", *report.NewsId, *report.UserId).
+			Count(&reported).Error
+		if err != nil {
+			return err
+		}
+		if reported > 0 {
+			return model.NewError("news is already reported by the user", model.ErrorDuplicate)
+		}
+
+		if err = tx.Create(gormModel).Error; err != nil {
+			return err
+		}
+
+		if hideThreshold <= 0 || news.PublishedAt == nil {
+			return nil
+		}
+
+		var open int64
+		err = tx.Model(&NewsReport{}).
+			Where("news_id = ? AND resolution_id IS NULL", *report.NewsId).
+			Count(&open).Error
+		if err != nil {
+			return err
+		}
+		if open < int64(hideThreshold) {
+			return nil
+		}
+
+		err = tx.Create(&NewsReportHold{
+			NewsId:      news.Id,
+			PublishedAt: news.PublishedAt,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&News{}).Where("id = ?", *news.Id).Update("published_at", nil).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res NewsReport
+	if err = n.Db.WithContext(ctx).Where("id = ?", *gormModel.Id).First(&res).Error; err != nil {
+		return nil, err
+	}
+
+	return res.ToModel(), nil
+}
+
+func (n *NewsReportRepo) ListGroups(ctx context.Context, filter repository.NewsReportGroupFilter) ([]model.NewsReportGroup, error) {
+	var groups []struct {
+		NewsId          string
+		ReportCount     int
+		FirstReportedAt time.Time
+		LastReportedAt  time.Time
+	}
+
+	q := n.Db.WithContext(ctx).Model(&NewsReport{}).
+		Select("news_id, COUNT(*) AS report_count, MIN(created_at) AS first_reported_at, MAX(created_at) AS last_reported_at").
+		Where("resolution_id IS NULL").
+		Group("news_id").
+		Order("report_count DESC, first_reported_at ASC, news_id ASC")
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit)
+	}
+	if err := q.Scan(&groups).Error; err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return []model.NewsReportGroup{}, nil
+	}
+
+	newsIds := make([]string, 0, len(groups))
+	for _, v := range groups {
+		newsIds = append(newsIds, v.NewsId)
+	}
+
+	var news []News
+	if err := n.Db.WithContext(ctx).Unscoped().Where("id IN ?", newsIds).Find(&news).Error; err != nil {
+		return nil, err
+	}
+	newsById := make(map[string]*model.News, len(news))
+	for _, v := range news {
+		newsById[*v.Id] = v.ToModel()
+	}
+
+	var holds []NewsReportHold
+	if err := n.Db.WithContext(ctx).Where("news_id IN ?", newsIds).Find(&holds).Error; err != nil {
+		return nil, err
+	}
+	hidden := make(map[string]bool, len(holds))
+	for _, v := range holds {
+		hidden[*v.NewsId] = true
+	}
+
+	var reports []NewsReport
+	err := n.Db.WithContext(ctx).
+		Where("news_id IN ? AND resolution_id IS NULL", newsIds).
+		Order("created_at ASC, id ASC").
+		Find(&reports).Error
+	if err != nil {
+		return nil, err
+	}
+	reportsByNews := make(map[string][]model.NewsReport, len(groups))
+	for _, v := range reports {
+		reportsByNews[*v.NewsId] = append(reportsByNews[*v.NewsId], *v.ToModel())
+	}
+
+	res := make([]model.NewsReportGroup, 0, len(groups))
+	for _, v := range groups {
+		res = append(res, model.NewsReportGroup{
+			NewsId:          helper.Pointer(v.NewsId),
+			News:            newsById[v.NewsId],
+			ReportCount:     v.ReportCount,
+			Hidden:          hidden[v.NewsId],
+			FirstReportedAt: helper.Pointer(v.FirstReportedAt),
+			LastReportedAt:  helper.Pointer(v.LastReportedAt),
+			Reports:         reportsByNews[v.NewsId],
+		})
+	}
+
+	return res, nil
+}
+
+func (n *NewsReportRepo) Resolve(ctx context.Context, newsId string, action model.NewsReportAction, resolvedBy string, note *string) (*model.NewsReportResolution, error) {
+	var res *model.NewsReportResolution
+	err := n.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// a deleted news can still have open reports, they are resolved like the others
+		if _, err := lockNews(tx.Unscoped(), newsId); err != nil {
+			return err
+		}
+
+		var open int64
+		err := tx.Model(&NewsReport{}).
+			Where("news_id = ? AND resolution_id IS NULL", newsId).
+			Count(&open).Error
+		if err != nil {
+			return err
+		}
+		if open == 0 {
+			return model.NewNotFoundError()
+		}
+
+		resolution := NewsReportResolution{
+			NewsId:      helper.Pointer(newsId),
+			Action:      helper.Pointer(string(action)),
+			ReportCount: helper.Pointer(int(open)),
+			ResolvedBy:  helper.Pointer(resolvedBy),
+			Note:        note,
+		}
+		if err = tx.Create(&resolution).Error; err != nil {
+			return err
+		}
+
+		err = tx.Model(&NewsReport{}).
+			Where("news_id = ? AND resolution_id IS NULL", newsId).
+			Update("resolution_id", *resolution.Id).Error
+		if err != nil {
+			return err
+		}
+
+		var hold NewsReportHold
+		err = tx.Where("news_id = ?", newsId).First(&hold).Error
+		held := err == nil
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		switch action {
+		case model.NewsReportDismiss:
+			if held {
+				err = tx.Model(&News{}).Where("id = ?", newsId).Update("published_at", hold.PublishedAt).Error
+			}
+		case model.NewsReportUnpublish:
+			err = tx.Model(&News{}).Where("id = ?", newsId).Update("published_at", nil).Error
+		case model.NewsReportDelete:
+			err = tx.Where("id = ?", newsId).Delete(&News{}).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		if held {
+			if err = tx.Where("news_id = ?", newsId).Delete(&NewsReportHold{}).Error; err != nil {
+				return err
+			}
+		}
+
+		var created NewsReportResolution
+		if err = tx.Where("id = ?", *resolution.Id).First(&created).Error; err != nil {
+			return err
+		}
+		res = created.ToModel()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func lockNews(db *gorm.DB, id string) (*News, error) {
+	var news News
+
+	err := db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&news).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.NewNotFoundError()
+		}
+		return nil, err
+	}
+
+	return &news, nil
+}
@@ -0,0 +1,118 @@
+//go:build integration
+// +build integration
+
+package mysqlrepo_test
+
+import (
+	"context"
+	"testing"
+
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mysqlrepo"
+	"tempo/storage"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewsReportRepository_Add(t *testing.T) {
+	t.Run("ShouldHideTheNews_WhenTheReportsReachTheThreshold", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		news := test.FakeNewsCreate(t, db, nil)
+		newsReportRepo := mysqlrepo.NewNewsReportRepository(db)
+		report := func(userId string) error {
+			_, err := newsReportRepo.Add(context.TODO(), &model.NewsReport{
+				NewsId: news.Id,
+				UserId: helper.Pointer(userId),
+				Reason: helper.Pointer(model.NewsReportSpam),
+			}, 2)
+			return err
+		}
+
+		//-- code under test
+		require.NoError(t, report("first"))
+		duplicateErr := report("first")
+		afterFirst, err := mysqlrepo.NewNewsRepository(db).Get(context.TODO(), news.Id)
+		require.NoError(t, err)
+		require.NoError(t, report("second"))
+
+		//-- assert
+		require.True(t, model.IsDuplicateError(duplicateErr))
+		require.NotNil(t, afterFirst.PublishedAt)
+
+		hidden, err := mysqlrepo.NewNewsRepository(db).Get(context.TODO(), news.Id)
+		require.NoError(t, err)
+		require.Nil(t, hidden.PublishedAt)
+
+		groups, err := newsReportRepo.ListGroups(context.TODO(), repository.NewsReportGroupFilter{Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, groups, 1)
+		require.Equal(t, 2, groups[0].ReportCount)
+		require.True(t, groups[0].Hidden)
+		require.Len(t, groups[0].Reports, 2)
+	})
+}
+
+func TestNewsReportRepository_Resolve(t *testing.T) {
+	t.Run("ShouldPublishTheNewsAgain_WhenTheReportsAreDismissed", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		news := test.FakeNewsCreate(t, db, nil)
+		newsReportRepo := mysqlrepo.NewNewsReportRepository(db)
+		_, err := newsReportRepo.Add(context.TODO(), &model.NewsReport{
+			NewsId: news.Id,
+			UserId: helper.Pointer("user"),
+			Reason: helper.Pointer(model.NewsReportMisinformation),
+		}, 1)
+		require.NoError(t, err)
+
+		//-- code under test
+		res, err := newsReportRepo.Resolve(context.TODO(), *news.Id, model.NewsReportDismiss, "admin", helper.Pointer("satire"))
+		require.NoError(t, err)
+		_, resolvedErr := newsReportRepo.Resolve(context.TODO(), *news.Id, model.NewsReportDelete, "admin", nil)
+
+		//-- assert
+		require.Equal(t, model.NewsReportDismiss, *res.Action)
+		require.Equal(t, 1, *res.ReportCount)
+		require.True(t, model.IsNotFoundError(resolvedErr))
+
+		published, err := mysqlrepo.NewNewsRepository(db).Get(context.TODO(), news.Id)
+		require.NoError(t, err)
+		require.NotNil(t, published.PublishedAt)
+		require.True(t, news.PublishedAt.Equal(*published.PublishedAt))
+
+		groups, err := newsReportRepo.ListGroups(context.TODO(), repository.NewsReportGroupFilter{})
+		require.NoError(t, err)
+		require.Empty(t, groups)
+	})
+
+	t.Run("ShouldDeleteTheNews_WhenTheActionIsDelete", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		news := test.FakeNewsCreate(t, db, nil)
+		newsReportRepo := mysqlrepo.NewNewsReportRepository(db)
+		_, err := newsReportRepo.Add(context.TODO(), &model.NewsReport{
+			NewsId: news.Id,
+			UserId: helper.Pointer("user"),
+			Reason: helper.Pointer(model.NewsReportHate),
+		}, 0)
+		require.NoError(t, err)
+
+		//-- code under test
+		_, err = newsReportRepo.Resolve(context.TODO(), *news.Id, model.NewsReportDelete, "admin", nil)
+		require.NoError(t, err)
+
+		//-- assert
+		_, err = mysqlrepo.NewNewsRepository(db).Get(context.TODO(), news.Id)
+		require.True(t, model.IsNotFoundError(err))
+	})
+}
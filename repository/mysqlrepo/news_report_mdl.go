@@ -0,0 +1,101 @@
+package mysqlrepo
+
+import (
+	"time"
+
+	"tempo/model"
+
+	"github.com/segmentio/ksuid"
+	"gorm.io/gorm"
+)
+
+type NewsReport struct {
+	Id           *string
+	NewsId       *string
+	UserId       *string
+	Reason       *string
+	Note         *string
+	ResolutionId *string
+	CreatedAt    *time.Time
+}
+
+func (n NewsReport) FromModel(data model.NewsReport) *NewsReport {
+	return &NewsReport{
+		Id:           data.Id,
+		NewsId:       data.NewsId,
+		UserId:       data.UserId,
+		Reason:       (*string)(data.Reason),
+		Note:         data.Note,
+		ResolutionId: data.ResolutionId,
+		CreatedAt:    data.CreatedAt,
+	}
+}
+
+func (n NewsReport) ToModel() *model.NewsReport {
+	return &model.NewsReport{
+		Id:           n.Id,
+		NewsId:       n.NewsId,
+		UserId:       n.UserId,
+		Reason:       (*model.NewsReportReason)(n.Reason),
+		Note:         n.Note,
+		ResolutionId: n.ResolutionId,
+		CreatedAt:    n.CreatedAt,
+	}
+}
+
+func (n NewsReport) TableName() string {
+	return "news_reports"
+}
+
+func (n *NewsReport) BeforeCreate(db *gorm.DB) error {
+	if n.Id == nil {
+		db.Statement.SetColumn("id", ksuid.New().String())
+	}
+
+	return nil
+}
+
+type NewsReportResolution struct {
+	Id          *string
+	NewsId      *string
+	Action      *string
+	ReportCount *int
+	ResolvedBy  *string
+	Note        *string
+	CreatedAt   *time.Time
+}
+
+func (n NewsReportResolution) ToModel() *model.NewsReportResolution {
+	return &model.NewsReportResolution{
+		Id:          n.Id,
+		NewsId:      n.NewsId,
+		Action:      (*model.NewsReportAction)(n.Action),
+		ReportCount: n.ReportCount,
+		ResolvedBy:  n.ResolvedBy,
+		Note:        n.Note,
+		CreatedAt:   n.CreatedAt,
+	}
+}
+
+func (n NewsReportResolution) TableName() string {
+	return "news_report_resolutions"
+}
+
+func (n *NewsReportResolution) BeforeCreate(db *gorm.DB) error {
+	if n.Id == nil {
+		db.Statement.SetColumn("id", ksuid.New().String())
+	}
+
+	return nil
+}
+
+// NewsReportHold keep the publication time of a news hidden by its reports, to publish it again when they are dismissed
+type NewsReportHold struct {
+	NewsId      *string
+	PublishedAt *time.Time
+	CreatedAt   *time.Time
+}
+
+func (n NewsReportHold) TableName() string {
+	return "news_report_holds"
+}
@@ -0,0 +1,142 @@
+package mysqlrepo
+
+import (
+	"context"
+	"time"
+
+	"tempo/model"
+	"tempo/repository"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationRepo struct {
+	Db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) repository.Notification {
+	return &NotificationRepo{
+		Db: db,
+	}
+}
+
+func (n *NotificationRepo) AddBatch(ctx context.Context, notifications []model.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	gormModels := make([]Notification, 0, len(notifications))
+	for _, v := range notifications {
+		gormModels = append(gormModels, *Notification{}.FromModel(v))
+	}
+
+	return n.Db.WithContext(ctx).Create(&gormModels).Error
+}
+
+func (n *NotificationRepo) List(ctx context.Context, filter repository.NotificationListFilter) ([]model.Notification, error) {
+	var gormModels []Notification
+
+	q := n.Db.WithContext(ctx)
+	if filter.UserId != nil {
+		q = q.Where("user_id = ?", *filter.UserId)
+	}
+	if filter.UnreadOnly {
+		q = q.Where("read_at IS NULL")
+	}
+	if filter.AfterCreatedAt != nil && filter.AfterId != nil {
+		q = q.Where("(created_at < ? OR (created_at = ? AND id < ?))",
+			*filter.AfterCreatedAt, *filter.AfterCreatedAt, *filter.AfterId)
+	}
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit)
+	}
+
+	err := q.Order("created_at DESC, id DESC").Find(&gormModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]model.Notification, 0, len(gormModels))
+	for _, v := range gormModels {
+		res = append(res, *v.ToModel())
+	}
+
+	return res, nil
+}
+
+func (n *NotificationRepo) CountUnread(ctx context.Context, userId string) (int64, error) {
+	var count int64
+	err := n.Db.WithContext(ctx).
+		Model(&Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userId).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (n *NotificationRepo) MarkRead(ctx context.Context, userId string, ids []string) (int64, error) {
+	q := n.Db.WithContext(ctx).
+		Model(&Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userId)
+	if len(ids) > 0 {
+		q = q.Where("id IN ?", ids)
+	}
+
+	res := q.Update("read_at", time.Now())
+	if res.Error != nil {
+		return 0, res.Error
+	}
+
+	return res.RowsAffected, nil
+}
+
+func (n *NotificationRepo) GetPreferences(ctx context.Context, userId string) ([]model.NotificationPreference, error) {
+	var gormModels []NotificationPreference
+	err := n.Db.WithContext(ctx).Where("user_id = ?", userId).Find(&gormModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]model.NotificationPreference, 0, len(gormModels))
+	for _, v := range gormModels {
+		res = append(res, *v.ToModel())
+	}
+
+	return res, nil
+}
+
+func (n *NotificationRepo) UpsertPreferences(ctx context.Context, preferences []model.NotificationPreference) error {
+	if len(preferences) == 0 {
+		return nil
+	}
+
+	gormModels := make([]NotificationPreference, 0, len(preferences))
+	for _, v := range preferences {
+		gormModels = append(gormModels, *NotificationPreference{}.FromModel(v))
+	}
+
+	return n.Db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{"enabled"}),
+	}).Create(&gormModels).Error
+}
+
+func (n *NotificationRepo) GetOptedOutUserIds(ctx context.Context, notificationType model.NotificationType, userIds []string) ([]string, error) {
+	ids := []string{}
+	if len(userIds) == 0 {
+		return ids, nil
+	}
+
+	err := n.Db.WithContext(ctx).
+		Model(&NotificationPreference{}).
+		Where("type = ? AND enabled = ? AND user_id IN ?", notificationType, false, userIds).
+		Pluck("user_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,77 @@
+//go:build integration
+// +build integration
+
+package mysqlrepo_test
+
+import (
+	"context"
+	"testing"
+
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mysqlrepo"
+	"tempo/storage"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationRepository_List(t *testing.T) {
+	t.Run("ShouldFilterUnreadAndMarkRead", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		user := test.FakeUserCreate(t, db, nil)
+		actor := test.FakeUserCreate(t, db, nil)
+		notificationRepo := mysqlrepo.NewNotificationRepository(db)
+		err := notificationRepo.AddBatch(context.TODO(), []model.Notification{
+			{UserId: user.Id, Type: helper.Pointer(model.NotificationNewsCommented), ActorId: actor.Id},
+			{UserId: user.Id, Type: helper.Pointer(model.NotificationNewsReacted), ActorId: actor.Id},
+		})
+		require.NoError(t, err)
+
+		all, err := notificationRepo.List(context.TODO(), repository.NotificationListFilter{UserId: user.Id})
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+
+		//-- code under test
+		updated, err := notificationRepo.MarkRead(context.TODO(), *user.Id, []string{*all[0].Id})
+		require.NoError(t, err)
+		unread, err := notificationRepo.List(context.TODO(), repository.NotificationListFilter{UserId: user.Id, UnreadOnly: true})
+		require.NoError(t, err)
+		count, err := notificationRepo.CountUnread(context.TODO(), *user.Id)
+		require.NoError(t, err)
+
+		//-- assert
+		require.Equal(t, int64(1), updated)
+		require.Len(t, unread, 1)
+		require.Equal(t, *all[1].Id, *unread[0].Id)
+		require.Equal(t, int64(1), count)
+	})
+}
+
+func TestNotificationRepository_GetOptedOutUserIds(t *testing.T) {
+	t.Run("ShouldReturnOnlyUsersThatDisabledTheType", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		optedOut := test.FakeUserCreate(t, db, nil)
+		optedIn := test.FakeUserCreate(t, db, nil)
+		notificationRepo := mysqlrepo.NewNotificationRepository(db)
+		err := notificationRepo.UpsertPreferences(context.TODO(), []model.NotificationPreference{
+			{UserId: optedOut.Id, Type: model.NotificationNewsReacted, Enabled: false},
+			{UserId: optedIn.Id, Type: model.NotificationNewsReacted, Enabled: true},
+		})
+		require.NoError(t, err)
+
+		//-- code under test
+		res, err := notificationRepo.GetOptedOutUserIds(context.TODO(), model.NotificationNewsReacted, []string{*optedOut.Id, *optedIn.Id})
+
+		//-- assert
+		require.NoError(t, err)
+		require.Equal(t, []string{*optedOut.Id}, res)
+	})
+}
@@ -0,0 +1,81 @@
+package mysqlrepo
+
+import (
+	"tempo/model"
+	"time"
+
+	"github.com/segmentio/ksuid"
+	"gorm.io/gorm"
+)
+
+type Notification struct {
+	Id        *string
+	UserId    *string
+	Type      *model.NotificationType
+	ActorId   *string
+	NewsId    *string
+	ReadAt    *time.Time
+	CreatedAt *time.Time
+}
+
+func (n Notification) FromModel(data model.Notification) *Notification {
+	return &Notification{
+		Id:        data.Id,
+		UserId:    data.UserId,
+		Type:      data.Type,
+		ActorId:   data.ActorId,
+		NewsId:    data.NewsId,
+		ReadAt:    data.ReadAt,
+		CreatedAt: data.CreatedAt,
+	}
+}
+
+func (n Notification) ToModel() *model.Notification {
+	return &model.Notification{
+		Id:        n.Id,
+		UserId:    n.UserId,
+		Type:      n.Type,
+		ActorId:   n.ActorId,
+		NewsId:    n.NewsId,
+		ReadAt:    n.ReadAt,
+		CreatedAt: n.CreatedAt,
+	}
+}
+
+func (n Notification) TableName() string {
+	return "notifications"
+}
+
+func (n *Notification) BeforeCreate(db *gorm.DB) error {
+	if n.Id == nil {
+		db.Statement.SetColumn("id", ksuid.New().String())
+	}
+
+	return nil
+}
+
+type NotificationPreference struct {
+	UserId  *string
+	Type    model.NotificationType
+	Enabled bool
+}
+
+func (n NotificationPreference) FromModel(data model.NotificationPreference) *NotificationPreference {
+	return &NotificationPreference{
+		UserId:  data.UserId,
+		Type:    data.Type,
+		Enabled: data.Enabled,
+	}
+}
+
+func (n NotificationPreference) ToModel() *model.NotificationPreference {
+	return &model.NotificationPreference{
+		UserId:  n.UserId,
+		Type:    n.Type,
+		Enabled: n.Enabled,
+	}
+}
+
+func (n NotificationPreference) TableName() string {
+	return "notification_preferences"
+}
@@ -0,0 +1,135 @@
+package mysqlrepo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+
+	"github.com/segmentio/ksuid"
+	"gorm.io/gorm"
+)
+
+const outboxRelayLock = "tempo_outbox_relay"
+
+type OutboxRepo struct {
+	Db *gorm.DB
+
+	mu sync.Mutex
+	// lockConn hold the connection owning the relay lock, mysql named locks belong to a session
+	lockConn *sql.Conn
+}
+
+func NewOutboxRepository(db *gorm.DB) repository.Outbox {
+	return &OutboxRepo{
+		Db: db,
+	}
+}
+
+// addOutboxEvent record the event in the outbox, tx must be the transaction of the change so both are committed together
+func addOutboxEvent(tx *gorm.DB, eventType model.EventType, aggregateId string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	return tx.Create(&Outbox{
+		EventId:     helper.Pointer(ksuid.New().String()),
+		EventType:   &eventType,
+		AggregateId: &aggregateId,
+		Payload:     helper.Pointer(string(payload)),
+		OccurredAt:  helper.Pointer(time.Now().UTC()),
+	}).Error
+}
+
+func (o *OutboxRepo) List(ctx context.Context, filter repository.OutboxListFilter) ([]model.OutboxEvent, error) {
+	var gormModels []Outbox
+
+	q := o.Db.WithContext(ctx)
+	if filter.FromOffset != nil {
+		q = q.Where("id >= ?", *filter.FromOffset)
+	}
+	if filter.PendingOnly {
+		q = q.Where("dispatched_at IS NULL")
+	}
+	if filter.OccurredBefore != nil {
+		q = q.Where("occurred_at < ?", filter.OccurredBefore.UTC())
+	}
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit)
+	}
+
+	err := q.Order("id ASC").Find(&gormModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]model.OutboxEvent, 0, len(gormModels))
+	for _, v := range gormModels {
+		res = append(res, *v.ToModel())
+	}
+
+	return res, nil
+}
+
+func (o *OutboxRepo) MarkDispatched(ctx context.Context, offsets []int64) error {
+	if len(offsets) == 0 {
+		return nil
+	}
+
+	return o.Db.WithContext(ctx).
+		Model(&Outbox{}).
+		Where("id IN ? AND dispatched_at IS NULL", offsets).
+		Update("dispatched_at", time.Now()).Error
+}
+
+func (o *OutboxRepo) TryLock(ctx context.Context) (bool, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.lockConn != nil {
+		return true, nil
+	}
+
+	sqlDB, err := o.Db.DB()
+	if err != nil {
+		return false, err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var acquired sql.NullInt64
+	err = conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, 0)", outboxRelayLock).Scan(&acquired)
+	if err != nil || !acquired.Valid || acquired.Int64 != 1 {
+		_ = conn.Close()
+		return false, err
+	}
+	o.lockConn = conn
+
+	return true, nil
+}
+
+func (o *OutboxRepo) Unlock(ctx context.Context) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.lockConn == nil {
+		return nil
+	}
+
+	_, err := o.lockConn.ExecContext(ctx, "SELECT RELEASE_LOCK(?)", outboxRelayLock)
+	closeErr := o.lockConn.Close()
+	o.lockConn = nil
+	if err != nil {
+		return err
+	}
+
+	return closeErr
+}
@@ -0,0 +1,121 @@
+//go:build integration
+// +build integration
+
+package mysqlrepo_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mysqlrepo"
+	"tempo/storage"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxRepository_List(t *testing.T) {
+	t.Run("ShouldRecordNewsChangesInOrder", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		news := test.FakeNewsCreate(t, db, nil)
+		newsRepo := mysqlrepo.NewNewsRepository(db)
+		_, err := newsRepo.Update(context.TODO(), news.Id, &model.News{Title: helper.Pointer("updated title")})
+		require.NoError(t, err)
+
+		//-- code under test
+		outboxRepo := mysqlrepo.NewOutboxRepository(db)
+		res, err := outboxRepo.List(context.TODO(), repository.OutboxListFilter{PendingOnly: true})
+
+		//-- assert
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		require.Equal(t, model.EventNewsCreated, *res[0].Type)
+		require.Equal(t, model.EventNewsUpdated, *res[1].Type)
+		require.Equal(t, *news.Id, *res[1].AggregateId)
+		require.Less(t, *res[0].Offset, *res[1].Offset)
+
+		var data model.News
+		require.NoError(t, json.Unmarshal(res[1].Data, &data))
+		require.Equal(t, "updated title", *data.Title)
+	})
+
+	t.Run("ShouldSkipDispatchedEvents", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		test.FakeNewsCreate(t, db, nil)
+		test.FakeNewsCreate(t, db, nil)
+		outboxRepo := mysqlrepo.NewOutboxRepository(db)
+		all, err := outboxRepo.List(context.TODO(), repository.OutboxListFilter{})
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+
+		//-- code under test
+		err = outboxRepo.MarkDispatched(context.TODO(), []int64{*all[0].Offset})
+		require.NoError(t, err)
+		pending, err := outboxRepo.List(context.TODO(), repository.OutboxListFilter{PendingOnly: true})
+		require.NoError(t, err)
+		replay, err := outboxRepo.List(context.TODO(), repository.OutboxListFilter{FromOffset: all[0].Offset})
+		require.NoError(t, err)
+
+		//-- assert
+		require.Len(t, pending, 1)
+		require.Equal(t, *all[1].Offset, *pending[0].Offset)
+		require.Len(t, replay, 2)
+	})
+}
+
+func TestOutboxRepository_TryLock(t *testing.T) {
+	t.Run("ShouldAllowOnlyOneRelay", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		first := mysqlrepo.NewOutboxRepository(db)
+		second := mysqlrepo.NewOutboxRepository(db)
+
+		//-- code under test
+		firstLocked, err := first.TryLock(context.TODO())
+		require.NoError(t, err)
+		secondLocked, err := second.TryLock(context.TODO())
+		require.NoError(t, err)
+		require.NoError(t, first.Unlock(context.TODO()))
+		afterUnlock, err := second.TryLock(context.TODO())
+		require.NoError(t, err)
+		require.NoError(t, second.Unlock(context.TODO()))
+
+		//-- assert
+		require.True(t, firstLocked)
+		require.False(t, secondLocked)
+		require.True(t, afterUnlock)
+	})
+
+	t.Run("ShouldSkipEventsYoungerThanTheCutoff", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		cutoff := time.Now()
+		test.FakeNewsCreate(t, db, nil)
+		outboxRepo := mysqlrepo.NewOutboxRepository(db)
+
+		//-- code under test
+		before, err := outboxRepo.List(context.TODO(), repository.OutboxListFilter{PendingOnly: true, OccurredBefore: &cutoff})
+		require.NoError(t, err)
+		after, err := outboxRepo.List(context.TODO(), repository.OutboxListFilter{PendingOnly: true, OccurredBefore: helper.Pointer(time.Now().Add(time.Second))})
+		require.NoError(t, err)
+
+		//-- assert
+		require.Len(t, before, 0)
+		require.Len(t, after, 1)
+	})
+}
@@ -0,0 +1,39 @@
+package mysqlrepo
+
+import (
+	"encoding/json"
+	"time"
+
+	"tempo/model"
+)
+
+type Outbox struct {
+	Id           *int64 `gorm:"primaryKey;autoIncrement"`
+	EventId      *string
+	EventType    *model.EventType
+	AggregateId  *string
+	Payload      *string
+	OccurredAt   *time.Time
+	DispatchedAt *time.Time
+}
+
+func (o Outbox) ToModel() *model.OutboxEvent {
+	var data json.RawMessage
+	if o.Payload != nil {
+		data = json.RawMessage(*o.Payload)
+	}
+
+	return &model.OutboxEvent{
+		Offset:       o.Id,
+		EventId:      o.EventId,
+		Type:         o.EventType,
+		AggregateId:  o.AggregateId,
+		Data:         data,
+		OccurredAt:   o.OccurredAt,
+		DispatchedAt: o.DispatchedAt,
+	}
+}
+
+func (o Outbox) TableName() string {
+	return "outbox"
+}
@@ -0,0 +1,79 @@
+package mysqlrepo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"tempo/model"
+	"tempo/repository"
+
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
+
+type PasswordResetRepo struct {
+	Db *gorm.DB
+}
+
+func NewPasswordResetRepository(db *gorm.DB) repository.PasswordReset {
+	return &PasswordResetRepo{
+		Db: db,
+	}
+}
+
+func (p *PasswordResetRepo) Add(ctx context.Context, reset *model.PasswordReset) (*model.PasswordReset, error) {
+	gormModel := PasswordReset{}.FromModel(*reset)
+
+	if err := p.Db.WithContext(ctx).Create(gormModel).Error; err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return nil, model.NewDuplicateError()
+		}
+		return nil, err
+	}
+
+	return gormModel.ToModel(), nil
+}
+
+func (p *PasswordResetRepo) GetByHash(ctx context.Context, tokenHash string) (*model.PasswordReset, error) {
+	gormModel := PasswordReset{}
+
+	err := p.Db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&gormModel).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.NewNotFoundError()
+		}
+		return nil, err
+	}
+
+	return gormModel.ToModel(), nil
+}
+
+func (p *PasswordResetRepo) Use(ctx context.Context, id string) error {
+	return p.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		current := PasswordReset{}
+		err := tx.Where("id = ?", id).First(&current).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return model.NewNotFoundError()
+			}
+			return err
+		}
+
+		// only one of concurrent uses of the same token mark it as used
+		now := time.Now()
+		res := tx.Model(&PasswordReset{}).Where("id = ? AND used_at IS NULL", id).Update("used_at", now)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return model.NewError("password reset is already used", model.ErrorDuplicate)
+		}
+
+		// the other links emailed before can't set the password again
+		return tx.Model(&PasswordReset{}).
+			Where("user_id = ? AND used_at IS NULL", *current.UserId).
+			Update("used_at", now).Error
+	})
+}
@@ -0,0 +1,77 @@
+//go:build integration
+// +build integration
+
+package mysqlrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository/mysqlrepo"
+	"tempo/storage"
+
+	"github.com/stretchr/testify/require"
+)
+
+func fakePasswordReset(userId string, token string) *model.PasswordReset {
+	return &model.PasswordReset{
+		UserId:    helper.Pointer(userId),
+		TokenHash: helper.Pointer(helper.Sha256(token)),
+		ExpiresAt: helper.Pointer(time.Now().Add(time.Hour)),
+	}
+}
+
+func TestPasswordResetRepository_GetByHash(t *testing.T) {
+	t.Run("ShouldReturnTheResetOfTheHash", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		passwordResetRepo := mysqlrepo.NewPasswordResetRepository(db)
+		_, err := passwordResetRepo.Add(context.TODO(), fakePasswordReset("user", "token"))
+		require.NoError(t, err)
+
+		//-- code under test
+		res, err := passwordResetRepo.GetByHash(context.TODO(), helper.Sha256("token"))
+		require.NoError(t, err)
+		_, notFoundErr := passwordResetRepo.GetByHash(context.TODO(), helper.Sha256("other"))
+
+		//-- assert
+		require.NotNil(t, res.Id)
+		require.Equal(t, "user", *res.UserId)
+		require.Nil(t, res.UsedAt)
+		require.True(t, model.IsNotFoundError(notFoundErr))
+	})
+}
+
+func TestPasswordResetRepository_Use(t *testing.T) {
+	t.Run("ShouldUseTheResetOnce_WithTheOtherResetsOfTheUser", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		passwordResetRepo := mysqlrepo.NewPasswordResetRepository(db)
+		current, err := passwordResetRepo.Add(context.TODO(), fakePasswordReset("user", "current"))
+		require.NoError(t, err)
+		_, err = passwordResetRepo.Add(context.TODO(), fakePasswordReset("user", "previous"))
+		require.NoError(t, err)
+		_, err = passwordResetRepo.Add(context.TODO(), fakePasswordReset("other", "other"))
+		require.NoError(t, err)
+
+		//-- code under test
+		err = passwordResetRepo.Use(context.TODO(), *current.Id)
+		require.NoError(t, err)
+		usedAgainErr := passwordResetRepo.Use(context.TODO(), *current.Id)
+
+		//-- assert
+		require.True(t, model.IsDuplicateError(usedAgainErr))
+		for token, used := range map[string]bool{"current": true, "previous": true, "other": false} {
+			res, err := passwordResetRepo.GetByHash(context.TODO(), helper.Sha256(token))
+			require.NoError(t, err)
+			require.Equal(t, used, res.UsedAt != nil, token)
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package mysqlrepo
+
+import (
+	"time"
+
+	"tempo/model"
+
+	"github.com/segmentio/ksuid"
+	"gorm.io/gorm"
+)
+
+type PasswordReset struct {
+	Id        *string
+	UserId    *string
+	TokenHash *string
+	ExpiresAt *time.Time
+	UsedAt    *time.Time
+	CreatedAt *time.Time
+}
+
+func (p PasswordReset) FromModel(data model.PasswordReset) *PasswordReset {
+	return &PasswordReset{
+		Id:        data.Id,
+		UserId:    data.UserId,
+		TokenHash: data.TokenHash,
+		ExpiresAt: data.ExpiresAt,
+		UsedAt:    data.UsedAt,
+		CreatedAt: data.CreatedAt,
+	}
+}
+
+func (p PasswordReset) ToModel() *model.PasswordReset {
+	return &model.PasswordReset{
+		Id:        p.Id,
+		UserId:    p.UserId,
+		TokenHash: p.TokenHash,
+		ExpiresAt: p.ExpiresAt,
+		UsedAt:    p.UsedAt,
+		CreatedAt: p.CreatedAt,
+	}
+}
+
+func (p PasswordReset) TableName() string {
+	return "password_resets"
+}
+
+func (p *PasswordReset) BeforeCreate(db *gorm.DB) error {
+	if p.Id == nil {
+		db.Statement.SetColumn("id", ksuid.New().String())
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package mysqlrepo
+
+import (
+	"context"
+	"time"
+
+	"tempo/repository"
+
+	"gorm.io/gorm"
+)
+
+type RateLimitRepo struct {
+	Db *gorm.DB
+}
+
+func NewRateLimitRepository(db *gorm.DB) repository.RateLimit {
+	return &RateLimitRepo{
+		Db: db,
+	}
+}
+
+func (r *RateLimitRepo) Hit(ctx context.Context, bucket string, window time.Duration) (int, error) {
+	now := time.Now()
+	expired := now.Add(-window)
+
+	var hits int
+	err := r.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// the assignments are applied in order, so window_start is still the previous one when hits is computed
+		err := tx.Exec(`INSERT INTO rate_limits (bucket, window_start, hits, expires_at) VALUES (?, ?, 1, ?)
+			ON DUPLICATE KEY UPDATE
+				hits = IF(window_start <= ?, 1, hits + 1),
+				expires_at = IF(window_start <= ?, VALUES(expires_at), expires_at),
+				window_start = IF(window_start <= ?, VALUES(window_start), window_start)`,
+			bucket, now, now.Add(window), expired, expired, expired).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&RateLimit{}).Select("hits").Where("bucket = ?", bucket).Scan(&hits).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return hits, nil
+}
+
+func (r *RateLimitRepo) DeleteExpired(ctx context.Context, before time.Time) error {
+	return r.Db.WithContext(ctx).Where("expires_at <= ?", before).Delete(&RateLimit{}).Error
+}
@@ -0,0 +1,66 @@
+//go:build integration
+// +build integration
+
+package mysqlrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tempo/repository/mysqlrepo"
+	"tempo/storage"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitRepository_Hit(t *testing.T) {
+	t.Run("ShouldCountTheHitsOfTheBucket_UntilTheWindowEnds", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		rateLimitRepo := mysqlrepo.NewRateLimitRepository(db)
+
+		//-- code under test
+		var hits []int
+		for i := 0; i < 3; i++ {
+			res, err := rateLimitRepo.Hit(context.TODO(), "bucket", time.Hour)
+			require.NoError(t, err)
+			hits = append(hits, res)
+		}
+		other, err := rateLimitRepo.Hit(context.TODO(), "other", time.Hour)
+		require.NoError(t, err)
+		// a window already ended start a new one
+		restarted, err := rateLimitRepo.Hit(context.TODO(), "bucket", -time.Second)
+		require.NoError(t, err)
+
+		//-- assert
+		require.Equal(t, []int{1, 2, 3}, hits)
+		require.Equal(t, 1, other)
+		require.Equal(t, 1, restarted)
+	})
+}
+
+func TestRateLimitRepository_DeleteExpired(t *testing.T) {
+	t.Run("ShouldDeleteOnlyTheEndedWindows", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		rateLimitRepo := mysqlrepo.NewRateLimitRepository(db)
+		_, err := rateLimitRepo.Hit(context.TODO(), "ended", time.Second)
+		require.NoError(t, err)
+		_, err = rateLimitRepo.Hit(context.TODO(), "running", time.Hour)
+		require.NoError(t, err)
+
+		//-- code under test
+		err = rateLimitRepo.DeleteExpired(context.TODO(), time.Now().Add(time.Minute))
+
+		//-- assert
+		require.NoError(t, err)
+		var buckets []string
+		require.NoError(t, db.Model(&mysqlrepo.RateLimit{}).Pluck("bucket", &buckets).Error)
+		require.Equal(t, []string{"running"}, buckets)
+	})
+}
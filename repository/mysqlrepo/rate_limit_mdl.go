@@ -0,0 +1,16 @@
+package mysqlrepo
+
+import (
+	"time"
+)
+
+type RateLimit struct {
+	Bucket      *string `gorm:"primaryKey"`
+	WindowStart *time.Time
+	Hits        *int
+	ExpiresAt   *time.Time
+}
+
+func (r RateLimit) TableName() string {
+	return "rate_limits"
+}
@@ -0,0 +1,91 @@
+package mysqlrepo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"tempo/model"
+	"tempo/repository"
+
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
+
+type RefreshTokenRepo struct {
+	Db *gorm.DB
+}
+
+func NewRefreshTokenRepository(db *gorm.DB) repository.RefreshToken {
+	return &RefreshTokenRepo{
+		Db: db,
+	}
+}
+
+func (r *RefreshTokenRepo) Add(ctx context.Context, token *model.RefreshToken) (*model.RefreshToken, error) {
+	gormModel := RefreshToken{}.FromModel(*token)
+
+	if err := r.Db.WithContext(ctx).Create(gormModel).Error; err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return nil, model.NewDuplicateError()
+		}
+		return nil, err
+	}
+
+	return gormModel.ToModel(), nil
+}
+
+func (r *RefreshTokenRepo) GetByHash(ctx context.Context, tokenHash string) (*model.RefreshToken, error) {
+	gormModel := RefreshToken{}
+
+	err := r.Db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&gormModel).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.NewNotFoundError()
+		}
+		return nil, err
+	}
+
+	return gormModel.ToModel(), nil
+}
+
+func (r *RefreshTokenRepo) Rotate(ctx context.Context, id string, next *model.RefreshToken) (*model.RefreshToken, error) {
+	gormModel := RefreshToken{}.FromModel(*next)
+
+	err := r.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// only one of concurrent rotations of the same token mark it as used
+		res := tx.Model(&RefreshToken{}).
+			Where("id = ? AND used_at IS NULL AND revoked_at IS NULL", id).
+			Update("used_at", time.Now())
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return model.NewError("refresh token is already used", model.ErrorDuplicate)
+		}
+
+		return tx.Create(gormModel).Error
+	})
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return nil, model.NewDuplicateError()
+		}
+		return nil, err
+	}
+
+	return gormModel.ToModel(), nil
+}
+
+func (r *RefreshTokenRepo) RevokeFamily(ctx context.Context, familyId string) error {
+	return r.Db.WithContext(ctx).Model(&RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyId).
+		Update("revoked_at", time.Now()).Error
+}
+
+func (r *RefreshTokenRepo) RevokeUser(ctx context.Context, userId string) error {
+	return r.Db.WithContext(ctx).Model(&RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userId).
+		Update("revoked_at", time.Now()).Error
+}
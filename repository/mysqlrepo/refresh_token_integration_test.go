@@ -0,0 +1,152 @@
+//go:build integration
+// +build integration
+
+package mysqlrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository/mysqlrepo"
+	"tempo/storage"
+
+	"github.com/stretchr/testify/require"
+)
+
+func fakeRefreshToken(userId string, familyId *string, token string) *model.RefreshToken {
+	return &model.RefreshToken{
+		UserId:    helper.Pointer(userId),
+		FamilyId:  familyId,
+		TokenHash: helper.Pointer(helper.Sha256(token)),
+		ExpiresAt: helper.Pointer(time.Now().Add(time.Hour)),
+	}
+}
+
+func TestRefreshTokenRepository_Add(t *testing.T) {
+	t.Run("ShouldStartANewFamily_WhenFamilyIsNotSet", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		//-- code under test
+		refreshTokenRepo := mysqlrepo.NewRefreshTokenRepository(db)
+		res, err := refreshTokenRepo.Add(context.TODO(), fakeRefreshToken("user", nil, "first"))
+
+		//-- assert
+		require.NoError(t, err)
+		require.NotNil(t, res.Id)
+		require.Equal(t, *res.Id, *res.FamilyId)
+
+		got, err := refreshTokenRepo.GetByHash(context.TODO(), helper.Sha256("first"))
+		require.NoError(t, err)
+		require.Equal(t, *res.Id, *got.Id)
+		require.Nil(t, got.UsedAt)
+	})
+	t.Run("ShouldKeepTheMicrosecondsOfTheCreation", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		//-- code under test
+		before := time.Now().Truncate(time.Microsecond)
+		refreshTokenRepo := mysqlrepo.NewRefreshTokenRepository(db)
+		_, err := refreshTokenRepo.Add(context.TODO(), fakeRefreshToken("user", nil, "first"))
+		require.NoError(t, err)
+		got, err := refreshTokenRepo.GetByHash(context.TODO(), helper.Sha256("first"))
+
+		//-- assert
+		require.NoError(t, err)
+		require.NotNil(t, got.CreatedAt)
+		// compared to tokens_valid_after, a token created later in the same second must stay valid
+		require.False(t, got.CreatedAt.Before(before))
+	})
+}
+
+func TestRefreshTokenRepository_Rotate(t *testing.T) {
+	t.Run("ShouldRotateOnlyOnce", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		refreshTokenRepo := mysqlrepo.NewRefreshTokenRepository(db)
+		first, err := refreshTokenRepo.Add(context.TODO(), fakeRefreshToken("user", nil, "first"))
+		require.NoError(t, err)
+
+		//-- code under test
+		second, err := refreshTokenRepo.Rotate(context.TODO(), *first.Id, fakeRefreshToken("user", first.FamilyId, "second"))
+		require.NoError(t, err)
+		_, replayErr := refreshTokenRepo.Rotate(context.TODO(), *first.Id, fakeRefreshToken("user", first.FamilyId, "third"))
+
+		//-- assert
+		require.Equal(t, *first.FamilyId, *second.FamilyId)
+		require.True(t, model.IsDuplicateError(replayErr))
+
+		used, err := refreshTokenRepo.GetByHash(context.TODO(), helper.Sha256("first"))
+		require.NoError(t, err)
+		require.NotNil(t, used.UsedAt)
+
+		_, err = refreshTokenRepo.GetByHash(context.TODO(), helper.Sha256("third"))
+		require.True(t, model.IsNotFoundError(err))
+	})
+}
+
+func TestRefreshTokenRepository_RevokeFamily(t *testing.T) {
+	t.Run("ShouldRevokeOnlyTheTokensOfTheFamily", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		refreshTokenRepo := mysqlrepo.NewRefreshTokenRepository(db)
+		first, err := refreshTokenRepo.Add(context.TODO(), fakeRefreshToken("user", nil, "first"))
+		require.NoError(t, err)
+		_, err = refreshTokenRepo.Rotate(context.TODO(), *first.Id, fakeRefreshToken("user", first.FamilyId, "second"))
+		require.NoError(t, err)
+		_, err = refreshTokenRepo.Add(context.TODO(), fakeRefreshToken("user", nil, "other"))
+		require.NoError(t, err)
+
+		//-- code under test
+		err = refreshTokenRepo.RevokeFamily(context.TODO(), *first.FamilyId)
+
+		//-- assert
+		require.NoError(t, err)
+		second, err := refreshTokenRepo.GetByHash(context.TODO(), helper.Sha256("second"))
+		require.NoError(t, err)
+		require.NotNil(t, second.RevokedAt)
+		other, err := refreshTokenRepo.GetByHash(context.TODO(), helper.Sha256("other"))
+		require.NoError(t, err)
+		require.Nil(t, other.RevokedAt)
+	})
+}
+
+func TestRefreshTokenRepository_RevokeUser(t *testing.T) {
+	t.Run("ShouldRevokeOnlyTheTokensOfTheUser", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		refreshTokenRepo := mysqlrepo.NewRefreshTokenRepository(db)
+		_, err := refreshTokenRepo.Add(context.TODO(), fakeRefreshToken("user", nil, "first"))
+		require.NoError(t, err)
+		_, err = refreshTokenRepo.Add(context.TODO(), fakeRefreshToken("user", nil, "second"))
+		require.NoError(t, err)
+		_, err = refreshTokenRepo.Add(context.TODO(), fakeRefreshToken("another user", nil, "other"))
+		require.NoError(t, err)
+
+		//-- code under test
+		err = refreshTokenRepo.RevokeUser(context.TODO(), "user")
+
+		//-- assert
+		require.NoError(t, err)
+		for _, v := range []string{"first", "second"} {
+			res, err := refreshTokenRepo.GetByHash(context.TODO(), helper.Sha256(v))
+			require.NoError(t, err)
+			require.NotNil(t, res.RevokedAt)
+		}
+		other, err := refreshTokenRepo.GetByHash(context.TODO(), helper.Sha256("other"))
+		require.NoError(t, err)
+		require.Nil(t, other.RevokedAt)
+	})
+}
@@ -0,0 +1,64 @@
+package mysqlrepo
+
+import (
+	"time"
+
+	"tempo/model"
+
+	"github.com/segmentio/ksuid"
+	"gorm.io/gorm"
+)
+
+type RefreshToken struct {
+	Id        *string
+	UserId    *string
+	FamilyId  *string
+	TokenHash *string
+	ExpiresAt *time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+	CreatedAt *time.Time
+}
+
+func (r RefreshToken) FromModel(data model.RefreshToken) *RefreshToken {
+	return &RefreshToken{
+		Id:        data.Id,
+		UserId:    data.UserId,
+		FamilyId:  data.FamilyId,
+		TokenHash: data.TokenHash,
+		ExpiresAt: data.ExpiresAt,
+		UsedAt:    data.UsedAt,
+		RevokedAt: data.RevokedAt,
+		CreatedAt: data.CreatedAt,
+	}
+}
+
+func (r RefreshToken) ToModel() *model.RefreshToken {
+	return &model.RefreshToken{
+		Id:        r.Id,
+		UserId:    r.UserId,
+		FamilyId:  r.FamilyId,
+		TokenHash: r.TokenHash,
+		ExpiresAt: r.ExpiresAt,
+		UsedAt:    r.UsedAt,
+		RevokedAt: r.RevokedAt,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func (r RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
+
+func (r *RefreshToken) BeforeCreate(db *gorm.DB) error {
+	if r.Id == nil {
+		id := ksuid.New().String()
+		db.Statement.SetColumn("id", id)
+		if r.FamilyId == nil {
+			// the first token of a login start its family
+			db.Statement.SetColumn("family_id", id)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package mysqlrepo
+
+import (
+	"context"
+	"time"
+
+	"tempo/model"
+	"tempo/repository"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RevokedTokenRepo struct {
+	Db *gorm.DB
+}
+
+func NewRevokedTokenRepository(db *gorm.DB) repository.RevokedToken {
+	return &RevokedTokenRepo{
+		Db: db,
+	}
+}
+
+func (r *RevokedTokenRepo) Add(ctx context.Context, token *model.RevokedToken) error {
+	return r.Db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).
+		Create(RevokedToken{}.FromModel(*token)).Error
+}
+
+func (r *RevokedTokenRepo) List(ctx context.Context, expiresAfter time.Time, createdSince *time.Time) ([]model.RevokedToken, error) {
+	var gormModels []RevokedToken
+
+	q := r.Db.WithContext(ctx).Where("expires_at > ?", expiresAfter)
+	if createdSince != nil {
+		q = q.Where("created_at >= ?", *createdSince)
+	}
+
+	if err := q.Find(&gormModels).Error; err != nil {
+		return nil, err
+	}
+
+	res := make([]model.RevokedToken, 0, len(gormModels))
+	for _, v := range gormModels {
+		res = append(res, *v.ToModel())
+	}
+
+	return res, nil
+}
+
+func (r *RevokedTokenRepo) DeleteExpired(ctx context.Context, before time.Time) error {
+	return r.Db.WithContext(ctx).Where("expires_at <= ?", before).Delete(&RevokedToken{}).Error
+}
@@ -0,0 +1,99 @@
+//go:build integration
+// +build integration
+
+package mysqlrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository/mysqlrepo"
+	"tempo/storage"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevokedTokenRepository_Add(t *testing.T) {
+	t.Run("ShouldIgnoreTheToken_WhenItIsAlreadyRevoked", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		token := model.RevokedToken{
+			Jti:       helper.Pointer("jti"),
+			UserId:    helper.Pointer("user"),
+			ExpiresAt: helper.Pointer(time.Now().Add(time.Hour)),
+		}
+
+		//-- code under test
+		revokedTokenRepo := mysqlrepo.NewRevokedTokenRepository(db)
+		err := revokedTokenRepo.Add(context.TODO(), &token)
+		require.NoError(t, err)
+		err = revokedTokenRepo.Add(context.TODO(), &token)
+
+		//-- assert
+		require.NoError(t, err)
+		res, err := revokedTokenRepo.List(context.TODO(), time.Now(), nil)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.Equal(t, "jti", *res[0].Jti)
+	})
+}
+
+func TestRevokedTokenRepository_List(t *testing.T) {
+	t.Run("ShouldReturnTheTokensNotExpired_RevokedSince", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		now := time.Now().Truncate(time.Second)
+		revokedTokenRepo := mysqlrepo.NewRevokedTokenRepository(db)
+		for _, v := range []model.RevokedToken{
+			{Jti: helper.Pointer("old"), UserId: helper.Pointer("user"), ExpiresAt: helper.Pointer(now.Add(time.Hour)), CreatedAt: helper.Pointer(now.Add(-time.Hour))},
+			{Jti: helper.Pointer("new"), UserId: helper.Pointer("user"), ExpiresAt: helper.Pointer(now.Add(time.Hour)), CreatedAt: helper.Pointer(now)},
+			{Jti: helper.Pointer("expired"), UserId: helper.Pointer("user"), ExpiresAt: helper.Pointer(now.Add(-time.Minute)), CreatedAt: helper.Pointer(now)},
+		} {
+			require.NoError(t, revokedTokenRepo.Add(context.TODO(), &v))
+		}
+
+		//-- code under test
+		all, err := revokedTokenRepo.List(context.TODO(), now, nil)
+		require.NoError(t, err)
+		since, err := revokedTokenRepo.List(context.TODO(), now, helper.Pointer(now.Add(-time.Minute)))
+		require.NoError(t, err)
+
+		//-- assert
+		require.Len(t, all, 2)
+		require.Len(t, since, 1)
+		require.Equal(t, "new", *since[0].Jti)
+	})
+}
+
+func TestRevokedTokenRepository_DeleteExpired(t *testing.T) {
+	t.Run("ShouldDeleteOnlyTheExpiredTokens", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		now := time.Now()
+		revokedTokenRepo := mysqlrepo.NewRevokedTokenRepository(db)
+		require.NoError(t, revokedTokenRepo.Add(context.TODO(), &model.RevokedToken{
+			Jti: helper.Pointer("expired"), UserId: helper.Pointer("user"), ExpiresAt: helper.Pointer(now.Add(-time.Minute)),
+		}))
+		require.NoError(t, revokedTokenRepo.Add(context.TODO(), &model.RevokedToken{
+			Jti: helper.Pointer("valid"), UserId: helper.Pointer("user"), ExpiresAt: helper.Pointer(now.Add(time.Minute)),
+		}))
+
+		//-- code under test
+		err := revokedTokenRepo.DeleteExpired(context.TODO(), now)
+
+		//-- assert
+		require.NoError(t, err)
+		var count int64
+		require.NoError(t, db.Model(&mysqlrepo.RevokedToken{}).Count(&count).Error)
+		require.Equal(t, int64(1), count)
+	})
+}
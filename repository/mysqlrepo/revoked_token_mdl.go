@@ -0,0 +1,36 @@
+package mysqlrepo
+
+import (
+	"time"
+
+	"tempo/model"
+)
+
+type RevokedToken struct {
+	Jti       *string `gorm:"primaryKey"`
+	UserId    *string
+	ExpiresAt *time.Time
+	CreatedAt *time.Time
+}
+
+func (r RevokedToken) FromModel(data model.RevokedToken) *RevokedToken {
+	return &RevokedToken{
+		Jti:       data.Jti,
+		UserId:    data.UserId,
+		ExpiresAt: data.ExpiresAt,
+		CreatedAt: data.CreatedAt,
+	}
+}
+
+func (r RevokedToken) ToModel() *model.RevokedToken {
+	return &model.RevokedToken{
+		Jti:       r.Jti,
+		UserId:    r.UserId,
+		ExpiresAt: r.ExpiresAt,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func (r RevokedToken) TableName() string {
+	return "revoked_tokens"
+}
@@ -0,0 +1,51 @@
+package mysqlrepo
+
+import (
+	"context"
+	"time"
+
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+
+	"gorm.io/gorm"
+)
+
+type SitemapRepo struct {
+	Db *gorm.DB
+}
+
+func NewSitemapRepository(db *gorm.DB) repository.Sitemap {
+	return &SitemapRepo{
+		Db: db,
+	}
+}
+
+func (s *SitemapRepo) ListEntries(ctx context.Context, since *time.Time) ([]model.SitemapEntry, error) {
+	// the soft delete and every other update of a news bump its updated_at
+	q := s.Db.WithContext(ctx).Unscoped().Model(&News{}).Select("id, title, published_at, updated_at, deleted_at")
+	if since != nil {
+		q = q.Where("updated_at >= ?", *since)
+	} else {
+		q = q.Where("deleted_at IS NULL AND published_at IS NOT NULL")
+	}
+
+	var gormModels []News
+	err := q.Find(&gormModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]model.SitemapEntry, 0, len(gormModels))
+	for _, v := range gormModels {
+		res = append(res, model.SitemapEntry{
+			NewsId:      helper.Val(v.Id),
+			Title:       helper.Val(v.Title),
+			PublishedAt: helper.Val(v.PublishedAt),
+			UpdatedAt:   helper.Val(v.UpdatedAt),
+			Listed:      v.PublishedAt != nil && !v.DeletedAt.Valid,
+		})
+	}
+
+	return res, nil
+}
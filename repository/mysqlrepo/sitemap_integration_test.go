@@ -0,0 +1,58 @@
+//go:build integration
+// +build integration
+
+package mysqlrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tempo/helper/test"
+	"tempo/repository/mysqlrepo"
+	"tempo/storage"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSitemapRepository_ListEntries(t *testing.T) {
+	t.Run("ShouldOnlyListThePublishedNews_WhenLoadingAll", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		published := test.FakeNewsCreate(t, db, nil)
+		deleted := test.FakeNewsCreate(t, db, nil)
+		require.NoError(t, db.Where("id = ?", *deleted.Id).Delete(&mysqlrepo.News{}).Error)
+
+		//-- code under test
+		sitemapRepo := mysqlrepo.NewSitemapRepository(db)
+		res, err := sitemapRepo.ListEntries(context.TODO(), nil)
+
+		//-- assert
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.Equal(t, *published.Id, res[0].NewsId)
+		require.True(t, res[0].Listed)
+	})
+
+	t.Run("ShouldReturnTheDeletedNews_WhenListingTheChanges", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		since := time.Now().Add(-time.Minute)
+		deleted := test.FakeNewsCreate(t, db, nil)
+		require.NoError(t, db.Where("id = ?", *deleted.Id).Delete(&mysqlrepo.News{}).Error)
+
+		//-- code under test
+		sitemapRepo := mysqlrepo.NewSitemapRepository(db)
+		res, err := sitemapRepo.ListEntries(context.TODO(), &since)
+
+		//-- assert
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.Equal(t, *deleted.Id, res[0].NewsId)
+		require.False(t, res[0].Listed)
+	})
+}
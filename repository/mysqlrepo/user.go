@@ -3,12 +3,16 @@ package mysqlrepo
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
+	"tempo/helper"
 	"tempo/model"
 	"tempo/repository"
 
 	"github.com/go-sql-driver/mysql"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type UserRepo struct {
@@ -24,7 +28,14 @@ func NewUserRepository(db *gorm.DB) repository.User {
 func (u *UserRepo) Add(ctx context.Context, user *model.User) (*model.User, error) {
 	gormModel := User{}.FromModel(*user)
 
-	if err := u.Db.WithContext(ctx).Create(&gormModel).Error; err != nil {
+	err := u.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&gormModel).Error; err != nil {
+			return err
+		}
+
+		return addOutboxEvent(tx, model.EventUserRegistered, *gormModel.Id, gormModel.ToModel())
+	})
+	if err != nil {
 		var mysqlErr *mysql.MySQLError
 		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
 			return nil, model.NewDuplicateError()
@@ -37,47 +48,306 @@ func (u *UserRepo) Add(ctx context.Context, user *model.User) (*model.User, erro
 }
 
 func (u *UserRepo) Get(ctx context.Context, filter repository.UserGetFilter) (*model.User, error) {
-	user := User{
-		Id:    filter.Id,
-		Email: filter.Email,
+	return getUser(u.Db.WithContext(ctx), filter)
+}
+
+func (u *UserRepo) Update(ctx context.Context, id string, user *model.User) (*model.User, error) {
+	gormModel := User{}.FromModel(*user)
+
+	var res *model.User
+	err := u.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		current, err := getUser(tx, repository.UserGetFilter{Id: &id})
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&User{Id: &id}).Updates(&gormModel).Error
+		if err != nil {
+			return err
+		}
+
+		// the new email has to be verified again
+		if user.Email != nil && *user.Email != helper.Val(current.Email) {
+			err = tx.Model(&User{}).Where("id = ?", id).Update("email_verified_at", nil).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		res, err = getUser(tx, repository.UserGetFilter{Id: &id})
+		if err != nil {
+			return err
+		}
+
+		return addOutboxEvent(tx, model.EventUserUpdated, id, res)
+	})
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return nil, model.NewDuplicateError()
+		}
+		return nil, err
 	}
 
+	return res, nil
+}
+
+func (u *UserRepo) List(ctx context.Context, filter repository.UserListFilter) ([]model.User, error) {
+	var gormModels []User
+
 	q := u.Db.WithContext(ctx)
-	if filter.Id != nil {
-		q = q.Where("id = ?", filter.Id)
+	if filter.Ids != nil {
+		q = q.Where("id IN ?", filter.Ids)
 	}
-	if filter.Email != nil {
-		q = q.Where("email = ?", filter.Email)
+	if helper.Val(filter.Query) != "" {
+		like := "%" + escapeLike(*filter.Query) + "%"
+		q = q.Where("(email LIKE ? OR full_name LIKE ?)", like, like)
+	}
+	if filter.Suspended != nil {
+		if *filter.Suspended {
+			q = q.Where("suspended_at IS NOT NULL")
+		} else {
+			q = q.Where("suspended_at IS NULL")
+		}
+	}
+	if filter.BeforeCreatedAt != nil && filter.BeforeId != nil {
+		q = q.Where("(created_at < ? OR (created_at = ? AND id < ?))",
+			*filter.BeforeCreatedAt, *filter.BeforeCreatedAt, *filter.BeforeId)
+	}
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit)
 	}
 
-	err := q.First(&user).Error
+	err := q.Order("created_at DESC, id DESC").Find(&gormModels).Error
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, model.NewNotFoundError()
+		return nil, err
+	}
+
+	res := make([]model.User, 0, len(gormModels))
+	for _, v := range gormModels {
+		res = append(res, *v.ToModel())
+	}
+
+	return res, nil
+}
+
+func (u *UserRepo) Suspend(ctx context.Context, id string, adminId string, reason string) (*model.User, error) {
+	return u.changeSuspension(ctx, id, adminId, model.UserAdminSuspend, &reason)
+}
+
+func (u *UserRepo) Unsuspend(ctx context.Context, id string, adminId string, reason string) (*model.User, error) {
+	return u.changeSuspension(ctx, id, adminId, model.UserAdminUnsuspend, &reason)
+}
+
+func (u *UserRepo) changeSuspension(ctx context.Context, id string, adminId string, action model.UserAdminActionType, reason *string) (*model.User, error) {
+	var res *model.User
+	err := u.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		_, err := getUser(tx.Clauses(clause.Locking{Strength: "UPDATE"}), repository.UserGetFilter{Id: &id})
+		if err != nil {
+			return err
 		}
+
+		updated := tx.Model(&User{})
+		if action == model.UserAdminSuspend {
+			updated = updated.Where("id = ? AND suspended_at IS NULL", id).Updates(map[string]interface{}{
+				"suspended_at":     time.Now(),
+				"suspended_reason": reason,
+				"suspended_by":     adminId,
+			})
+		} else {
+			updated = updated.Where("id = ? AND suspended_at IS NOT NULL", id).Updates(map[string]interface{}{
+				"suspended_at":     nil,
+				"suspended_reason": nil,
+				"suspended_by":     nil,
+			})
+		}
+		if updated.Error != nil {
+			return updated.Error
+		}
+		if updated.RowsAffected == 0 {
+			if action == model.UserAdminSuspend {
+				return model.NewError("user is already suspended", model.ErrorDuplicate)
+			}
+			return model.NewError("user is not suspended", model.ErrorDuplicate)
+		}
+
+		if err = addUserAdminAction(tx, id, adminId, action, reason); err != nil {
+			return err
+		}
+
+		res, err = getUser(tx, repository.UserGetFilter{Id: &id})
+		if err != nil {
+			return err
+		}
+
+		return addOutboxEvent(tx, model.EventUserUpdated, id, res)
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	return user.ToModel(), nil
+	return res, nil
 }
 
-func (u *UserRepo) Update(ctx context.Context, id string, user *model.User) (*model.User, error) {
-	_, err := u.Get(ctx, repository.UserGetFilter{Id: &id})
+func (u *UserRepo) SetPassword(ctx context.Context, id string, password string, resetBy *string) (*model.User, error) {
+	var res *model.User
+	err := u.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		_, err := getUser(tx, repository.UserGetFilter{Id: &id})
+		if err != nil {
+			return err
+		}
+
+		// the column keep microseconds like the issued at of the tokens, so a token issued before in the same second is
+		// revoked too
+		err = tx.Model(&User{}).Where("id = ?", id).Updates(map[string]interface{}{
+			"password":           password,
+			"password_salt":      nil,
+			"tokens_valid_after": time.Now(),
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		if resetBy != nil {
+			if err = addUserAdminAction(tx, id, *resetBy, model.UserAdminResetPassword, nil); err != nil {
+				return err
+			}
+		}
+
+		res, err = getUser(tx, repository.UserGetFilter{Id: &id})
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	gormModel := User{}.FromModel(*user)
+	return res, nil
+}
 
-	tx := u.Db.WithContext(ctx)
-	err = tx.Model(&User{Id: &id}).Updates(&gormModel).Error
+func (u *UserRepo) UpgradePassword(ctx context.Context, id string, currentHash string, hash string) error {
+	// the PHC hashes carry their salt
+	return u.Db.WithContext(ctx).Model(&User{}).Where("id = ? AND password = ?", id, currentHash).Updates(map[string]interface{}{
+		"password":      hash,
+		"password_salt": nil,
+	}).Error
+}
+
+func (u *UserRepo) RevokeTokens(ctx context.Context, id string) error {
+	return u.Db.WithContext(ctx).Model(&User{}).Where("id = ?", id).
+		Update("tokens_valid_after", time.Now()).Error
+}
+
+func (u *UserRepo) VerifyEmail(ctx context.Context, id string, email string) (*model.User, error) {
+	var res *model.User
+	err := u.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		user, err := getUser(tx, repository.UserGetFilter{Id: &id})
+		if err != nil {
+			return err
+		}
+		if helper.Val(user.Email) != email {
+			return model.NewNotFoundError()
+		}
+		if user.IsEmailVerified() {
+			res = user
+			return nil
+		}
+
+		err = tx.Model(&User{}).Where("id = ?", id).Update("email_verified_at", time.Now()).Error
+		if err != nil {
+			return err
+		}
+
+		res, err = getUser(tx, repository.UserGetFilter{Id: &id})
+		return err
+	})
 	if err != nil {
-		var mysqlErr *mysql.MySQLError
-		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
-			return nil, model.NewDuplicateError()
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (u *UserRepo) ListRestricted(ctx context.Context, revokedSince time.Time) ([]model.User, error) {
+	return listTokenStates(u.Db.WithContext(ctx).
+		Where("suspended_at IS NOT NULL OR tokens_valid_after >= ? OR role <> ?", revokedSince, model.UserRoleUser))
+}
+
+func (u *UserRepo) ListUpdated(ctx context.Context, since time.Time) ([]model.User, error) {
+	return listTokenStates(u.Db.WithContext(ctx).Where("updated_at >= ?", since))
+}
+
+// listTokenStates read the columns deciding whether the access tokens of the users are accepted, and what they grant
+func listTokenStates(q *gorm.DB) ([]model.User, error) {
+	var gormModels []User
+	err := q.Select("id", "role", "suspended_at", "tokens_valid_after").Find(&gormModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]model.User, 0, len(gormModels))
+	for _, v := range gormModels {
+		res = append(res, *v.ToModel())
+	}
+
+	return res, nil
+}
+
+func (u *UserRepo) ListAdminActions(ctx context.Context, userId string, limit int) ([]model.UserAdminAction, error) {
+	var gormModels []UserAdminAction
+
+	q := u.Db.WithContext(ctx).Where("user_id = ?", userId)
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	err := q.Order("created_at DESC, id DESC").Find(&gormModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]model.UserAdminAction, 0, len(gormModels))
+	for _, v := range gormModels {
+		res = append(res, *v.ToModel())
+	}
+
+	return res, nil
+}
+
+func addUserAdminAction(tx *gorm.DB, userId string, adminId string, action model.UserAdminActionType, reason *string) error {
+	return tx.Create(&UserAdminAction{
+		UserId:  &userId,
+		AdminId: &adminId,
+		Action:  helper.Pointer(string(action)),
+		Reason:  reason,
+	}).Error
+}
+
+func getUser(db *gorm.DB, filter repository.UserGetFilter) (*model.User, error) {
+	user := User{
+		Id:    filter.Id,
+		Email: filter.Email,
+	}
+
+	q := db
+	if filter.Id != nil {
+		q = q.Where("id = ?", filter.Id)
+	}
+	if filter.Email != nil {
+		q = q.Where("email = ?", filter.Email)
+	}
+
+	err := q.First(&user).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.NewNotFoundError()
 		}
 		return nil, err
 	}
 
-	return u.Get(ctx, repository.UserGetFilter{Id: &id})
+	return user.ToModel(), nil
+}
+
+// escapeLike escape the wildcards of a LIKE pattern so the text is matched as is
+func escapeLike(text string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(text)
 }
@@ -6,6 +6,7 @@ package mysqlrepo_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"tempo/helper"
 	"tempo/helper/test"
@@ -170,3 +171,292 @@ func TestUserRepository_Update(t *testing.T) {
 	})
 
 }
+
+func TestUserRepository_List(t *testing.T) {
+	t.Run("ShouldReturnOnlyTheUsersOfTheIds", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		user1 := test.FakeUserCreate(t, db, nil)
+		user2 := test.FakeUserCreate(t, db, nil)
+		test.FakeUserCreate(t, db, nil)
+
+		//-- code under test
+		userRepo := mysqlrepo.NewUserRepository(db)
+		res, err := userRepo.List(context.TODO(), repository.UserListFilter{
+			Ids: []string{*user1.Id, *user2.Id, "unknown"},
+		})
+
+		//-- assert
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		require.ElementsMatch(t, []string{*user1.Id, *user2.Id}, []string{*res[0].Id, *res[1].Id})
+	})
+}
+
+func TestUserRepository_Suspend(t *testing.T) {
+	t.Run("ShouldSuspendAndUnsuspendTheUser", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		admin := test.FakeUserCreate(t, db, nil)
+		user := test.FakeUserCreate(t, db, nil)
+		userRepo := mysqlrepo.NewUserRepository(db)
+
+		//-- code under test
+		suspended, err := userRepo.Suspend(context.TODO(), *user.Id, *admin.Id, "spam")
+		require.NoError(t, err)
+		_, errAgain := userRepo.Suspend(context.TODO(), *user.Id, *admin.Id, "spam")
+		unsuspended, err := userRepo.Unsuspend(context.TODO(), *user.Id, *admin.Id, "appeal")
+		require.NoError(t, err)
+		_, errUnsuspendAgain := userRepo.Unsuspend(context.TODO(), *user.Id, *admin.Id, "appeal")
+		actions, err := userRepo.ListAdminActions(context.TODO(), *user.Id, 10)
+		require.NoError(t, err)
+
+		//-- assert
+		require.True(t, suspended.IsSuspended())
+		require.Equal(t, "spam", *suspended.SuspendedReason)
+		require.Equal(t, *admin.Id, *suspended.SuspendedBy)
+		require.EqualError(t, errAgain, "user is already suspended")
+		require.False(t, unsuspended.IsSuspended())
+		require.EqualError(t, errUnsuspendAgain, "user is not suspended")
+		require.Len(t, actions, 2)
+		require.ElementsMatch(t, []model.UserAdminActionType{model.UserAdminSuspend, model.UserAdminUnsuspend},
+			[]model.UserAdminActionType{*actions[0].Action, *actions[1].Action})
+	})
+}
+
+func TestUserRepository_ListSearch(t *testing.T) {
+	t.Run("ShouldFilterByQueryAndSuspension", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		admin := test.FakeUserCreate(t, db, nil)
+		john := test.FakeUserCreate(t, db, func(user model.User) model.User {
+			user.FullName = helper.Pointer("John 100% Doe")
+			return user
+		})
+		test.FakeUserCreate(t, db, func(user model.User) model.User {
+			user.FullName = helper.Pointer("John Smith")
+			return user
+		})
+		userRepo := mysqlrepo.NewUserRepository(db)
+		_, err := userRepo.Suspend(context.TODO(), *john.Id, *admin.Id, "spam")
+		require.NoError(t, err)
+
+		//-- code under test
+		byQuery, err := userRepo.List(context.TODO(), repository.UserListFilter{Query: helper.Pointer("100%")})
+		require.NoError(t, err)
+		suspended, err := userRepo.List(context.TODO(), repository.UserListFilter{Suspended: helper.Pointer(true)})
+		require.NoError(t, err)
+		active, err := userRepo.List(context.TODO(), repository.UserListFilter{
+			Query:     helper.Pointer("john"),
+			Suspended: helper.Pointer(false),
+		})
+		require.NoError(t, err)
+
+		//-- assert
+		require.Len(t, byQuery, 1)
+		require.Equal(t, *john.Id, *byQuery[0].Id)
+		require.Len(t, suspended, 1)
+		require.Equal(t, *john.Id, *suspended[0].Id)
+		require.Len(t, active, 1)
+		require.Equal(t, "John Smith", *active[0].FullName)
+	})
+}
+
+func TestUserRepository_SetPassword(t *testing.T) {
+	t.Run("ShouldRecordTheAdminAction_WhenResetByAnAdmin", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		admin := test.FakeUserCreate(t, db, nil)
+		user := test.FakeUserCreate(t, db, nil)
+		userRepo := mysqlrepo.NewUserRepository(db)
+
+		//-- code under test
+		reset, err := userRepo.SetPassword(context.TODO(), *user.Id, "hash", admin.Id)
+		require.NoError(t, err)
+		changed, err := userRepo.SetPassword(context.TODO(), *user.Id, "other hash", nil)
+		require.NoError(t, err)
+		actions, err := userRepo.ListAdminActions(context.TODO(), *user.Id, 10)
+		require.NoError(t, err)
+
+		//-- assert
+		require.Equal(t, "hash", *reset.Password)
+		require.Nil(t, reset.PasswordSalt)
+		require.NotNil(t, reset.TokensValidAfter)
+		require.Equal(t, "other hash", *changed.Password)
+		require.Len(t, actions, 1)
+		require.Equal(t, model.UserAdminResetPassword, *actions[0].Action)
+	})
+}
+
+func TestUserRepository_UpgradePassword(t *testing.T) {
+	t.Run("ShouldReplaceTheHash_OnlyWhenItIsUnchanged", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		user := test.FakeUserCreate(t, db, nil)
+		userRepo := mysqlrepo.NewUserRepository(db)
+
+		//-- code under test
+		err := userRepo.UpgradePassword(context.TODO(), *user.Id, "stale hash", "lost hash")
+		require.NoError(t, err)
+		err = userRepo.UpgradePassword(context.TODO(), *user.Id, *user.Password, "upgraded hash")
+		require.NoError(t, err)
+		res, err := userRepo.Get(context.TODO(), repository.UserGetFilter{Id: user.Id})
+		require.NoError(t, err)
+
+		//-- assert
+		require.Equal(t, "upgraded hash", *res.Password)
+		require.Nil(t, res.PasswordSalt)
+		require.Nil(t, res.TokensValidAfter)
+	})
+}
+
+func TestUserRepository_RevokeTokens(t *testing.T) {
+	t.Run("ShouldRejectTheTokensIssuedBefore_KeepingThePassword", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		user := test.FakeUserCreate(t, db, nil)
+		userRepo := mysqlrepo.NewUserRepository(db)
+
+		//-- code under test
+		before := time.Now().Truncate(time.Microsecond)
+		err := userRepo.RevokeTokens(context.TODO(), *user.Id)
+		require.NoError(t, err)
+		res, err := userRepo.Get(context.TODO(), repository.UserGetFilter{Id: user.Id})
+		require.NoError(t, err)
+
+		//-- assert
+		require.NotNil(t, res.TokensValidAfter)
+		// the microseconds are kept, a token issued earlier in the same second is revoked too
+		require.False(t, res.TokensValidAfter.Before(before))
+		require.WithinDuration(t, time.Now(), *res.TokensValidAfter, 5*time.Second)
+		require.Equal(t, *user.Password, *res.Password)
+	})
+}
+
+func TestUserRepository_ListRestricted(t *testing.T) {
+	t.Run("ShouldListTheSuspendedUsersAndTheRecentRevocations", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		admin := test.FakeUserCreate(t, db, nil)
+		suspended := test.FakeUserCreate(t, db, nil)
+		revoked := test.FakeUserCreate(t, db, nil)
+		userRepo := mysqlrepo.NewUserRepository(db)
+		_, err := userRepo.Suspend(context.TODO(), *suspended.Id, *admin.Id, "spam")
+		require.NoError(t, err)
+		err = userRepo.RevokeTokens(context.TODO(), *revoked.Id)
+		require.NoError(t, err)
+
+		//-- code under test
+		recent, err := userRepo.ListRestricted(context.TODO(), time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		later, err := userRepo.ListRestricted(context.TODO(), time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		//-- assert
+		require.Len(t, recent, 2)
+		require.ElementsMatch(t, []string{*suspended.Id, *revoked.Id}, []string{*recent[0].Id, *recent[1].Id})
+		require.Len(t, later, 1)
+		require.Equal(t, *suspended.Id, *later[0].Id)
+		require.True(t, later[0].IsSuspended())
+		require.Nil(t, later[0].Email)
+	})
+
+	t.Run("ShouldListTheUsersWithAnotherRole", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		test.FakeUserCreate(t, db, nil)
+		admin := test.FakeUserCreate(t, db, func(user model.User) model.User {
+			user.Role = helper.Pointer(model.UserRoleAdmin)
+			return user
+		})
+		userRepo := mysqlrepo.NewUserRepository(db)
+
+		//-- code under test
+		res, err := userRepo.ListRestricted(context.TODO(), time.Now().Add(-time.Hour))
+
+		//-- assert
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.Equal(t, *admin.Id, *res[0].Id)
+		require.Equal(t, model.UserRoleAdmin, *res[0].Role)
+	})
+}
+
+func TestUserRepository_ListUpdated(t *testing.T) {
+	t.Run("ShouldListTheUsersUpdatedSince", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		test.FakeUserCreate(t, db, nil)
+		revoked := test.FakeUserCreate(t, db, nil)
+		userRepo := mysqlrepo.NewUserRepository(db)
+		since := time.Now().Add(time.Second)
+		time.Sleep(2 * time.Second)
+		err := userRepo.RevokeTokens(context.TODO(), *revoked.Id)
+		require.NoError(t, err)
+
+		//-- code under test
+		res, err := userRepo.ListUpdated(context.TODO(), since)
+		require.NoError(t, err)
+
+		//-- assert
+		require.Len(t, res, 1)
+		require.Equal(t, *revoked.Id, *res[0].Id)
+		require.NotNil(t, res[0].TokensValidAfter)
+	})
+}
+
+func TestUserRepository_VerifyEmail(t *testing.T) {
+	t.Run("ShouldVerifyTheEmail_UntilItChange", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		user := test.FakeUserCreate(t, db, nil)
+		userRepo := mysqlrepo.NewUserRepository(db)
+
+		//-- code under test
+		res, err := userRepo.VerifyEmail(context.TODO(), *user.Id, *user.Email)
+		require.NoError(t, err)
+		updated, err := userRepo.Update(context.TODO(), *user.Id, &model.User{Email: helper.Pointer(fake.EmailAddress())})
+		require.NoError(t, err)
+
+		//-- assert
+		require.True(t, res.IsEmailVerified())
+		require.WithinDuration(t, time.Now(), *res.EmailVerifiedAt, 5*time.Second)
+		require.False(t, updated.IsEmailVerified())
+	})
+
+	t.Run("ShouldReturnErrorNotFound_WhenTheEmailIsNotTheCurrentOne", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		user := test.FakeUserCreate(t, db, nil)
+		userRepo := mysqlrepo.NewUserRepository(db)
+
+		//-- code under test
+		res, err := userRepo.VerifyEmail(context.TODO(), *user.Id, "previous@gmail.com")
+
+		//-- assert
+		require.True(t, model.IsNotFoundError(err))
+		require.Nil(t, res)
+	})
+}
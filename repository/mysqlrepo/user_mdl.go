@@ -9,33 +9,51 @@ import (
 )
 
 type User struct {
-	Id           *string
-	Email        *string
-	FullName     *string
-	Password     *string
-	PasswordSalt *string
-	CreatedAt    *time.Time
+	Id               *string
+	Email            *string
+	FullName         *string
+	Role             *string `gorm:"default:user"`
+	Password         *string
+	PasswordSalt     *string
+	CreatedAt        *time.Time
+	SuspendedAt      *time.Time
+	SuspendedReason  *string
+	SuspendedBy      *string
+	TokensValidAfter *time.Time
+	EmailVerifiedAt  *time.Time
 }
 
 func (u User) FromModel(data model.User) *User {
 	return &User{
-		Id:           data.Id,
-		Email:        data.Email,
-		FullName:     data.FullName,
-		Password:     data.Password,
-		PasswordSalt: data.PasswordSalt,
-		CreatedAt:    data.CreatedAt,
+		Id:               data.Id,
+		Email:            data.Email,
+		FullName:         data.FullName,
+		Role:             (*string)(data.Role),
+		Password:         data.Password,
+		PasswordSalt:     data.PasswordSalt,
+		CreatedAt:        data.CreatedAt,
+		SuspendedAt:      data.SuspendedAt,
+		SuspendedReason:  data.SuspendedReason,
+		SuspendedBy:      data.SuspendedBy,
+		TokensValidAfter: data.TokensValidAfter,
+		EmailVerifiedAt:  data.EmailVerifiedAt,
 	}
 }
 
 func (u User) ToModel() *model.User {
 	return &model.User{
-		Id:           u.Id,
-		Email:        u.Email,
-		FullName:     u.FullName,
-		Password:     u.Password,
-		PasswordSalt: u.PasswordSalt,
-		CreatedAt:    u.CreatedAt,
+		Id:               u.Id,
+		Email:            u.Email,
+		FullName:         u.FullName,
+		Role:             (*model.UserRole)(u.Role),
+		Password:         u.Password,
+		PasswordSalt:     u.PasswordSalt,
+		CreatedAt:        u.CreatedAt,
+		SuspendedAt:      u.SuspendedAt,
+		SuspendedReason:  u.SuspendedReason,
+		SuspendedBy:      u.SuspendedBy,
+		TokensValidAfter: u.TokensValidAfter,
+		EmailVerifiedAt:  u.EmailVerifiedAt,
 	}
 }
 
@@ -50,3 +68,35 @@ func (u *User) BeforeCreate(db *gorm.DB) error {
 
 	return nil
 }
+
+type UserAdminAction struct {
+	Id        *string
+	UserId    *string
+	AdminId   *string
+	Action    *string
+	Reason    *string
+	CreatedAt *time.Time
+}
+
+func (u UserAdminAction) ToModel() *model.UserAdminAction {
+	return &model.UserAdminAction{
+		Id:        u.Id,
+		UserId:    u.UserId,
+		AdminId:   u.AdminId,
+		Action:    (*model.UserAdminActionType)(u.Action),
+		Reason:    u.Reason,
+		CreatedAt: u.CreatedAt,
+	}
+}
+
+func (u UserAdminAction) TableName() string {
+	return "user_admin_actions"
+}
+
+func (u *UserAdminAction) BeforeCreate(db *gorm.DB) error {
+	if u.Id == nil {
+		db.Statement.SetColumn("id", ksuid.New().String())
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package mysqlrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"tempo/model"
+	"tempo/repository"
+
+	"gorm.io/gorm"
+)
+
+type WebhookRepo struct {
+	Db *gorm.DB
+}
+
+func NewWebhookRepository(db *gorm.DB) repository.Webhook {
+	return &WebhookRepo{
+		Db: db,
+	}
+}
+
+func (w *WebhookRepo) Add(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error) {
+	gormModel := Webhook{}.FromModel(*webhook)
+
+	if err := w.Db.WithContext(ctx).Create(&gormModel).Error; err != nil {
+		return nil, err
+	}
+
+	return w.Get(ctx, *gormModel.Id)
+}
+
+func (w *WebhookRepo) Get(ctx context.Context, id string) (*model.Webhook, error) {
+	var gormModel Webhook
+
+	err := w.Db.WithContext(ctx).Where("id = ?", id).First(&gormModel).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.NewNotFoundError()
+		}
+		return nil, err
+	}
+
+	return gormModel.ToModel(), nil
+}
+
+func (w *WebhookRepo) List(ctx context.Context, filter repository.WebhookListFilter) ([]model.Webhook, error) {
+	var gormModels []Webhook
+
+	q := w.Db.WithContext(ctx)
+	if filter.ActiveOnly {
+		q = q.Where("active = ?", true)
+	}
+	if filter.EventType != nil {
+		q = q.Where("event_types LIKE ?", fmt.Sprintf("%%%q%%", *filter.EventType))
+	}
+
+	err := q.Order("created_at ASC, id ASC").Find(&gormModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]model.Webhook, 0, len(gormModels))
+	for _, v := range gormModels {
+		res = append(res, *v.ToModel())
+	}
+
+	return res, nil
+}
+
+func (w *WebhookRepo) Update(ctx context.Context, id string, webhook *model.Webhook) (*model.Webhook, error) {
+	_, err := w.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	gormModel := Webhook{}.FromModel(*webhook)
+	updates := map[string]interface{}{}
+	if gormModel.Url != nil {
+		updates["url"] = *gormModel.Url
+	}
+	if gormModel.EventTypes != nil {
+		updates["event_types"] = *gormModel.EventTypes
+	}
+	if gormModel.Secret != nil {
+		updates["secret"] = *gormModel.Secret
+	}
+	if gormModel.Active != nil {
+		updates["active"] = *gormModel.Active
+		// toggling the webhook by hand start its failure streak over
+		updates["failure_count"] = 0
+		if *gormModel.Active {
+			updates["disabled_at"] = nil
+		} else {
+			updates["disabled_at"] = time.Now()
+		}
+	}
+
+	if len(updates) > 0 {
+		err = w.Db.WithContext(ctx).Model(&Webhook{Id: &id}).Updates(updates).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return w.Get(ctx, id)
+}
+
+func (w *WebhookRepo) Delete(ctx context.Context, id string) error {
+	return w.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("id = ?", id).Delete(&Webhook{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return model.NewNotFoundError()
+		}
+
+		return tx.Where("webhook_id = ?", id).Delete(&WebhookDelivery{}).Error
+	})
+}
+
+func (w *WebhookRepo) RecordSuccess(ctx context.Context, id string) error {
+	return w.Db.WithContext(ctx).
+		Model(&Webhook{}).
+		Where("id = ? AND failure_count > 0", id).
+		Update("failure_count", 0).Error
+}
+
+func (w *WebhookRepo) RecordFailure(ctx context.Context, id string, disableAfter int) error {
+	// mysql apply the assignments left to right, so disabled_at and active must be computed before failure_count change
+	return w.Db.WithContext(ctx).Exec(`UPDATE webhooks SET
+		disabled_at = IF(active AND failure_count + 1 >= ?, NOW(), disabled_at),
+		active = IF(failure_count + 1 >= ?, FALSE, active),
+		failure_count = failure_count + 1
+		WHERE id = ?`, disableAfter, disableAfter, id).Error
+}
+
+func (w *WebhookRepo) AddDelivery(ctx context.Context, delivery *model.WebhookDelivery) (*model.WebhookDelivery, error) {
+	gormModel := WebhookDelivery{}.FromModel(*delivery)
+
+	if err := w.Db.WithContext(ctx).Create(&gormModel).Error; err != nil {
+		return nil, err
+	}
+
+	return w.GetDelivery(ctx, *gormModel.WebhookId, *gormModel.Id)
+}
+
+func (w *WebhookRepo) GetDelivery(ctx context.Context, webhookId string, id string) (*model.WebhookDelivery, error) {
+	var gormModel WebhookDelivery
+
+	err := w.Db.WithContext(ctx).Where("id = ? AND webhook_id = ?", id, webhookId).First(&gormModel).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, model.NewNotFoundError()
+		}
+		return nil, err
+	}
+
+	return gormModel.ToModel(), nil
+}
+
+func (w *WebhookRepo) ListDeliveries(ctx context.Context, filter repository.WebhookDeliveryListFilter) ([]model.WebhookDelivery, error) {
+	var gormModels []WebhookDelivery
+
+	q := w.Db.WithContext(ctx)
+	if filter.WebhookId != nil {
+		q = q.Where("webhook_id = ?", *filter.WebhookId)
+	}
+	if filter.Status != nil {
+		q = q.Where("status = ?", *filter.Status)
+	}
+	if filter.AfterCreatedAt != nil && filter.AfterId != nil {
+		q = q.Where("(created_at < ? OR (created_at = ? AND id < ?))",
+			*filter.AfterCreatedAt, *filter.AfterCreatedAt, *filter.AfterId)
+	}
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit)
+	}
+
+	err := q.Order("created_at DESC, id DESC").Find(&gormModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]model.WebhookDelivery, 0, len(gormModels))
+	for _, v := range gormModels {
+		res = append(res, *v.ToModel())
+	}
+
+	return res, nil
+}
+
+func (w *WebhookRepo) UpdateDelivery(ctx context.Context, id string, delivery *model.WebhookDelivery) error {
+	gormModel := WebhookDelivery{}.FromModel(*delivery)
+
+	return w.Db.WithContext(ctx).
+		Model(&WebhookDelivery{Id: &id}).
+		Select("status", "attempts", "next_attempt_at", "status_code", "response_body", "error", "duration_ms").
+		Updates(gormModel).Error
+}
+
+func (w *WebhookRepo) ListDueDeliveries(ctx context.Context, now time.Time, limit int) ([]model.WebhookDelivery, error) {
+	var gormModels []WebhookDelivery
+
+	err := w.Db.WithContext(ctx).
+		Where("status = ? AND next_attempt_at <= ?", model.WebhookDeliveryPending, now).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Find(&gormModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]model.WebhookDelivery, 0, len(gormModels))
+	for _, v := range gormModels {
+		res = append(res, *v.ToModel())
+	}
+
+	return res, nil
+}
+
+func (w *WebhookRepo) ClaimDelivery(ctx context.Context, id string, now time.Time, leaseUntil time.Time) (bool, error) {
+	res := w.Db.WithContext(ctx).
+		Model(&WebhookDelivery{}).
+		Where("id = ? AND status = ? AND next_attempt_at <= ?", id, model.WebhookDeliveryPending, now).
+		Update("next_attempt_at", leaseUntil)
+	if res.Error != nil {
+		return false, res.Error
+	}
+
+	return res.RowsAffected == 1, nil
+}
@@ -0,0 +1,83 @@
+//go:build integration
+// +build integration
+
+package mysqlrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository/mysqlrepo"
+	"tempo/storage"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookRepository_RecordFailure(t *testing.T) {
+	t.Run("ShouldDisableWebhook_WhenFailuresReachTheLimit", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		webhookRepo := mysqlrepo.NewWebhookRepository(db)
+		webhook, err := webhookRepo.Add(context.TODO(), &model.Webhook{
+			Url:        helper.Pointer("https://example.com/hook"),
+			Secret:     helper.Pointer("whsec_0123456789abcdef"),
+			EventTypes: []model.EventType{model.EventNewsCreated},
+		})
+		require.NoError(t, err)
+		require.True(t, *webhook.Active)
+
+		//-- code under test
+		require.NoError(t, webhookRepo.RecordFailure(context.TODO(), *webhook.Id, 2))
+		afterFirst, err := webhookRepo.Get(context.TODO(), *webhook.Id)
+		require.NoError(t, err)
+		require.NoError(t, webhookRepo.RecordFailure(context.TODO(), *webhook.Id, 2))
+		afterSecond, err := webhookRepo.Get(context.TODO(), *webhook.Id)
+		require.NoError(t, err)
+
+		//-- assert
+		require.True(t, *afterFirst.Active)
+		require.Equal(t, 1, *afterFirst.FailureCount)
+		require.False(t, *afterSecond.Active)
+		require.Equal(t, 2, *afterSecond.FailureCount)
+		require.NotNil(t, afterSecond.DisabledAt)
+		require.Equal(t, []model.EventType{model.EventNewsCreated}, afterSecond.EventTypes)
+	})
+}
+
+func TestWebhookRepository_ClaimDelivery(t *testing.T) {
+	t.Run("ShouldOnlyClaimOnce", func(t *testing.T) {
+		//-- init
+		db := storage.MySqlDbConn(&dbName)
+		defer cleanDB(t, db)
+
+		webhookRepo := mysqlrepo.NewWebhookRepository(db)
+		delivery, err := webhookRepo.AddDelivery(context.TODO(), &model.WebhookDelivery{
+			WebhookId:     helper.Pointer("webhook"),
+			EventId:       helper.Pointer("event"),
+			EventType:     helper.Pointer(model.EventNewsCreated),
+			Payload:       helper.Pointer("{}"),
+			NextAttemptAt: helper.Pointer(time.Now().Add(-time.Minute)),
+		})
+		require.NoError(t, err)
+		now := time.Now()
+
+		due, err := webhookRepo.ListDueDeliveries(context.TODO(), now, 10)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+
+		//-- code under test
+		first, err := webhookRepo.ClaimDelivery(context.TODO(), *delivery.Id, now, now.Add(time.Minute))
+		require.NoError(t, err)
+		second, err := webhookRepo.ClaimDelivery(context.TODO(), *delivery.Id, now, now.Add(time.Minute))
+		require.NoError(t, err)
+
+		//-- assert
+		require.True(t, first)
+		require.False(t, second)
+	})
+}
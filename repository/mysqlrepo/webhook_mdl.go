@@ -0,0 +1,146 @@
+package mysqlrepo
+
+import (
+	"encoding/json"
+	"time"
+
+	"tempo/helper"
+	"tempo/model"
+
+	"github.com/segmentio/ksuid"
+	"gorm.io/gorm"
+)
+
+type Webhook struct {
+	Id  *string
+	Url *string
+	// EventTypes is stored as a json array
+	EventTypes   *string
+	Secret       *string
+	Active       *bool `gorm:"default:true"`
+	FailureCount *int  `gorm:"default:0"`
+	DisabledAt   *time.Time
+	CreatedBy    *string
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+}
+
+func (w Webhook) FromModel(data model.Webhook) *Webhook {
+	var eventTypes *string
+	if data.EventTypes != nil {
+		b, _ := json.Marshal(data.EventTypes)
+		eventTypes = helper.Pointer(string(b))
+	}
+
+	return &Webhook{
+		Id:           data.Id,
+		Url:          data.Url,
+		EventTypes:   eventTypes,
+		Secret:       data.Secret,
+		Active:       data.Active,
+		FailureCount: data.FailureCount,
+		DisabledAt:   data.DisabledAt,
+		CreatedBy:    data.CreatedBy,
+		CreatedAt:    data.CreatedAt,
+		UpdatedAt:    data.UpdatedAt,
+	}
+}
+
+func (w Webhook) ToModel() *model.Webhook {
+	var eventTypes []model.EventType
+	if w.EventTypes != nil {
+		_ = json.Unmarshal([]byte(*w.EventTypes), &eventTypes)
+	}
+
+	return &model.Webhook{
+		Id:           w.Id,
+		Url:          w.Url,
+		Secret:       w.Secret,
+		EventTypes:   eventTypes,
+		Active:       w.Active,
+		FailureCount: w.FailureCount,
+		DisabledAt:   w.DisabledAt,
+		CreatedBy:    w.CreatedBy,
+		CreatedAt:    w.CreatedAt,
+		UpdatedAt:    w.UpdatedAt,
+	}
+}
+
+func (w Webhook) TableName() string {
+	return "webhooks"
+}
+
+func (w *Webhook) BeforeCreate(db *gorm.DB) error {
+	if w.Id == nil {
+		db.Statement.SetColumn("id", ksuid.New().String())
+	}
+
+	return nil
+}
+
+type WebhookDelivery struct {
+	Id            *string
+	WebhookId     *string
+	EventId       *string
+	EventType     *model.EventType
+	Payload       *string
+	Status        *model.WebhookDeliveryStatus `gorm:"default:pending"`
+	Attempts      *int                         `gorm:"default:0"`
+	NextAttemptAt *time.Time
+	StatusCode    *int
+	ResponseBody  *string
+	Error         *string
+	DurationMs    *int64
+	CreatedAt     *time.Time
+	UpdatedAt     *time.Time
+}
+
+func (w WebhookDelivery) FromModel(data model.WebhookDelivery) *WebhookDelivery {
+	return &WebhookDelivery{
+		Id:            data.Id,
+		WebhookId:     data.WebhookId,
+		EventId:       data.EventId,
+		EventType:     data.EventType,
+		Payload:       data.Payload,
+		Status:        data.Status,
+		Attempts:      data.Attempts,
+		NextAttemptAt: data.NextAttemptAt,
+		StatusCode:    data.StatusCode,
+		ResponseBody:  data.ResponseBody,
+		Error:         data.Error,
+		DurationMs:    data.DurationMs,
+		CreatedAt:     data.CreatedAt,
+		UpdatedAt:     data.UpdatedAt,
+	}
+}
+
+func (w WebhookDelivery) ToModel() *model.WebhookDelivery {
+	return &model.WebhookDelivery{
+		Id:            w.Id,
+		WebhookId:     w.WebhookId,
+		EventId:       w.EventId,
+		EventType:     w.EventType,
+		Payload:       w.Payload,
+		Status:        w.Status,
+		Attempts:      w.Attempts,
+		NextAttemptAt: w.NextAttemptAt,
+		StatusCode:    w.StatusCode,
+		ResponseBody:  w.ResponseBody,
+		Error:         w.Error,
+		DurationMs:    w.DurationMs,
+		CreatedAt:     w.CreatedAt,
+		UpdatedAt:     w.UpdatedAt,
+	}
+}
+
+func (w WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+func (w *WebhookDelivery) BeforeCreate(db *gorm.DB) error {
+	if w.Id == nil {
+		db.Statement.SetColumn("id", ksuid.New().String())
+	}
+
+	return nil
+}
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"time"
+
 	"tempo/model"
 )
 
@@ -9,4 +11,42 @@ type News interface {
 	Add(ctx context.Context, news *model.News) (*model.News, error)
 	Get(ctx context.Context, id *string) (*model.News, error)
 	Update(ctx context.Context, id *string, user *model.News) (*model.News, error)
+	List(ctx context.Context, filter NewsListFilter) ([]model.News, error)
+	// PublishedRange return the publication time of the oldest and of the newest published news, nil when none is
+	PublishedRange(ctx context.Context) (*time.Time, *time.Time, error)
+	// CountPublished return the number of published news in each period between two consecutive bounds, the bounds
+	// sorted from the oldest
+	CountPublished(ctx context.Context, bounds []time.Time) ([]int64, error)
+	// ListSimilar return the news whose fingerprint is within the distance, the closest first
+	ListSimilar(ctx context.Context, filter NewsSimilarFilter) ([]model.NewsDuplicate, error)
+	// ListBackfill return the news by id, the unpublished and deleted ones included
+	ListBackfill(ctx context.Context, filter NewsBackfillFilter) ([]model.News, error)
+	// SetReading store the reading metadata of the news, leaving its updated_at as it is
+	SetReading(ctx context.Context, id string, news *model.News) error
+}
+
+// NewsListFilter only ever match published news, ordered by published_at from the newest
+type NewsListFilter struct {
+	UserIds []string
+	// PublishedSince and PublishedUntil return only the news published in [PublishedSince, PublishedUntil)
+	PublishedSince *time.Time
+	PublishedUntil *time.Time
+	// BeforePublishedAt and BeforeId return only the news older than this position
+	BeforePublishedAt *time.Time
+	BeforeId          *string
+	Limit             int
+}
+
+type NewsSimilarFilter struct {
+	Fingerprint uint64
+	MaxDistance int
+	ExcludeId   *string
+	Limit       int
+}
+
+type NewsBackfillFilter struct {
+	AfterId *string
+	// MissingReading only return the news whose reading metadata were never computed
+	MissingReading bool
+	Limit          int
 }
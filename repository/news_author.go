@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"tempo/model"
+)
+
+// NewsAuthor is the association of the news and their collaborators, the owner being added with the news
+type NewsAuthor interface {
+	// Add a collaborator to the news, it return a duplicate error when the user already collaborate on it
+	Add(ctx context.Context, author *model.NewsAuthor) (*model.NewsAuthor, error)
+	Get(ctx context.Context, newsId string, userId string) (*model.NewsAuthor, error)
+	// List return the collaborators with their full name, by role then from the oldest
+	List(ctx context.Context, filter NewsAuthorListFilter) ([]model.NewsAuthor, error)
+	Remove(ctx context.Context, newsId string, userId string) error
+	// TransferOwnership make the user the owner of the news, the previous owner becoming a co-author
+	TransferOwnership(ctx context.Context, newsId string, userId string) error
+}
+
+type NewsAuthorListFilter struct {
+	NewsIds []string
+	Roles   []model.NewsAuthorRole
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"tempo/model"
+)
+
+type NewsReport interface {
+	// Add the report and hide the news once it has hideThreshold open reports, 0 never hiding it.
+	// It return a duplicate error when the user has an open report on the news
+	Add(ctx context.Context, report *model.NewsReport, hideThreshold int) (*model.NewsReport, error)
+	// ListGroups return the news with open reports, the most reported first
+	ListGroups(ctx context.Context, filter NewsReportGroupFilter) ([]model.NewsReportGroup, error)
+	// Resolve close the open reports of the news and apply the action in the same transaction.
+	// It return a not found error when the news has no open report
+	Resolve(ctx context.Context, newsId string, action model.NewsReportAction, resolvedBy string, note *string) (*model.NewsReportResolution, error)
+}
+
+type NewsReportGroupFilter struct {
+	Limit int
+}
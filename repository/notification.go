@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"tempo/model"
+)
+
+type Notification interface {
+	AddBatch(ctx context.Context, notifications []model.Notification) error
+	List(ctx context.Context, filter NotificationListFilter) ([]model.Notification, error)
+	CountUnread(ctx context.Context, userId string) (int64, error)
+	// MarkRead mark the given notifications of the user as read, all of them when ids is empty
+	MarkRead(ctx context.Context, userId string, ids []string) (int64, error)
+	GetPreferences(ctx context.Context, userId string) ([]model.NotificationPreference, error)
+	UpsertPreferences(ctx context.Context, preferences []model.NotificationPreference) error
+	// GetOptedOutUserIds return the subset of userIds that disabled the notification type
+	GetOptedOutUserIds(ctx context.Context, notificationType model.NotificationType, userIds []string) ([]string, error)
+}
+
+type NotificationListFilter struct {
+	UserId     *string
+	UnreadOnly bool
+	// AfterCreatedAt and AfterId return only the notifications older than this position
+	AfterCreatedAt *time.Time
+	AfterId        *string
+	Limit          int
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"tempo/model"
+)
+
+type Outbox interface {
+	List(ctx context.Context, filter OutboxListFilter) ([]model.OutboxEvent, error)
+	MarkDispatched(ctx context.Context, offsets []int64) error
+	// TryLock take the relay lock so only one relay publish at a time, it return false when another relay hold it
+	TryLock(ctx context.Context) (bool, error)
+	Unlock(ctx context.Context) error
+}
+
+type OutboxListFilter struct {
+	// FromOffset return only the events at or after this offset
+	FromOffset  *int64
+	PendingOnly bool
+	// OccurredBefore return only the events recorded before this time, the offsets are allocated at insert so a
+	// younger transaction can still commit a lower offset
+	OccurredBefore *time.Time
+	Limit          int
+}
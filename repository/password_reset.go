@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"tempo/model"
+)
+
+type PasswordReset interface {
+	Add(ctx context.Context, reset *model.PasswordReset) (*model.PasswordReset, error)
+	GetByHash(ctx context.Context, tokenHash string) (*model.PasswordReset, error)
+	// Use mark the reset as used, with the other resets of its user not used yet. It return a duplicate error when the
+	// reset was already used, so a token only ever set one password
+	Use(ctx context.Context, id string) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+type RateLimit interface {
+	// Hit count a hit in the current window of the bucket and return the hits of the window so far, this one
+	// included. A window start at the first hit after the previous window ended
+	Hit(ctx context.Context, bucket string, window time.Duration) (int, error)
+	// DeleteExpired delete the windows ended at before, their bucket start a new window at the next hit anyway
+	DeleteExpired(ctx context.Context, before time.Time) error
+}
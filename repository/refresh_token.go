@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"tempo/model"
+)
+
+type RefreshToken interface {
+	Add(ctx context.Context, token *model.RefreshToken) (*model.RefreshToken, error)
+	GetByHash(ctx context.Context, tokenHash string) (*model.RefreshToken, error)
+	// Rotate mark the token as used and add the next token of its family. It return a duplicate error when the token
+	// was already used or revoked, so a token is only ever rotated once
+	Rotate(ctx context.Context, id string, next *model.RefreshToken) (*model.RefreshToken, error)
+	// RevokeFamily revoke every token of the family that is not revoked yet
+	RevokeFamily(ctx context.Context, familyId string) error
+	// RevokeUser revoke every token of the user that is not revoked yet
+	RevokeUser(ctx context.Context, userId string) error
+}
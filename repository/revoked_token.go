@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"tempo/model"
+)
+
+type RevokedToken interface {
+	// Add deny the token, adding a token already denied is ignored
+	Add(ctx context.Context, token *model.RevokedToken) error
+	// List return the tokens not expired yet at expiresAfter, only the ones revoked since createdSince when it is set
+	List(ctx context.Context, expiresAfter time.Time, createdSince *time.Time) ([]model.RevokedToken, error)
+	// DeleteExpired delete the tokens expired at before, they are rejected without the denylist
+	DeleteExpired(ctx context.Context, before time.Time) error
+}
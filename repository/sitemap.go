@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"tempo/model"
+)
+
+type Sitemap interface {
+	// ListEntries return every listed news when since is nil, else the news updated since then, the unpublished and
+	// deleted ones included so they can be dropped from the sitemaps
+	ListEntries(ctx context.Context, since *time.Time) ([]model.SitemapEntry, error)
+}
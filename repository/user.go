@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"time"
+
 	"tempo/model"
 )
 
@@ -9,9 +11,45 @@ type User interface {
 	Add(ctx context.Context, user *model.User) (*model.User, error)
 	Get(ctx context.Context, filter UserGetFilter) (*model.User, error)
 	Update(ctx context.Context, id string, user *model.User) (*model.User, error)
+	List(ctx context.Context, filter UserListFilter) ([]model.User, error)
+	// Suspend the user on behalf of the admin, it return a duplicate error when the user is already suspended
+	Suspend(ctx context.Context, id string, adminId string, reason string) (*model.User, error)
+	// Unsuspend the user on behalf of the admin, it return a duplicate error when the user is not suspended
+	Unsuspend(ctx context.Context, id string, adminId string, reason string) (*model.User, error)
+	// SetPassword replace the password and reject the tokens issued before. When resetBy is set the admin forced the reset,
+	// the user having to change the password again, else the user changed it and the reset is no more required
+	SetPassword(ctx context.Context, id string, password string, resetBy *string) (*model.User, error)
+	// UpgradePassword replace the hash of the password by a stronger one of the same password, the tokens staying
+	// valid. Nothing is changed when the hash is no more currentHash
+	UpgradePassword(ctx context.Context, id string, currentHash string, hash string) error
+	// RevokeTokens reject the tokens issued before now, the password staying the same
+	RevokeTokens(ctx context.Context, id string) error
+	// VerifyEmail mark the email of the user as verified, it return a not found error when the user has another email
+	// now. Verifying an email already verified keep its first verification
+	VerifyEmail(ctx context.Context, id string, email string) (*model.User, error)
+	// ListRestricted return the users whose access tokens can be rejected: the suspended users, and the users who
+	// revoked their tokens since revokedSince, with the users having another role than user. Only the id, the role and
+	// the token state of the users are read
+	ListRestricted(ctx context.Context, revokedSince time.Time) ([]model.User, error)
+	// ListUpdated return the users updated since, with only their id, role and token state like ListRestricted
+	ListUpdated(ctx context.Context, since time.Time) ([]model.User, error)
+	// ListAdminActions return the latest actions of the admins on the user, the newest first
+	ListAdminActions(ctx context.Context, userId string, limit int) ([]model.UserAdminAction, error)
 }
 
 type UserGetFilter struct {
 	Id    *string
 	Email *string
 }
+
+// UserListFilter order the users from the newest
+type UserListFilter struct {
+	Ids []string
+	// Query match the users whose email or full name contain it
+	Query     *string
+	Suspended *bool
+	// BeforeCreatedAt and BeforeId return only the users older than this position
+	BeforeCreatedAt *time.Time
+	BeforeId        *string
+	Limit           int
+}
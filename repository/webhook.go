@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"tempo/model"
+)
+
+type Webhook interface {
+	Add(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error)
+	Get(ctx context.Context, id string) (*model.Webhook, error)
+	List(ctx context.Context, filter WebhookListFilter) ([]model.Webhook, error)
+	Update(ctx context.Context, id string, webhook *model.Webhook) (*model.Webhook, error)
+	Delete(ctx context.Context, id string) error
+	// RecordSuccess reset the consecutive failure count of the webhook
+	RecordSuccess(ctx context.Context, id string) error
+	// RecordFailure increment the consecutive failure count of the webhook and disable it once it reach disableAfter
+	RecordFailure(ctx context.Context, id string, disableAfter int) error
+
+	AddDelivery(ctx context.Context, delivery *model.WebhookDelivery) (*model.WebhookDelivery, error)
+	GetDelivery(ctx context.Context, webhookId string, id string) (*model.WebhookDelivery, error)
+	ListDeliveries(ctx context.Context, filter WebhookDeliveryListFilter) ([]model.WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, id string, delivery *model.WebhookDelivery) error
+	// ListDueDeliveries return the pending deliveries whose next attempt is due, oldest first
+	ListDueDeliveries(ctx context.Context, now time.Time, limit int) ([]model.WebhookDelivery, error)
+	// ClaimDelivery push back the next attempt of a due delivery to leaseUntil, it return false when another worker claimed it first
+	ClaimDelivery(ctx context.Context, id string, now time.Time, leaseUntil time.Time) (bool, error)
+}
+
+type WebhookListFilter struct {
+	ActiveOnly bool
+	EventType  *model.EventType
+}
+
+type WebhookDeliveryListFilter struct {
+	WebhookId *string
+	Status    *model.WebhookDeliveryStatus
+	// AfterCreatedAt and AfterId return only the deliveries older than this position
+	AfterCreatedAt *time.Time
+	AfterId        *string
+	Limit          int
+}
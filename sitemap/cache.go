@@ -0,0 +1,197 @@
+package sitemap
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"tempo/config"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+)
+
+// changeLookback re-read the changes from a bit before the last refresh, for the transactions committed late and the
+// clock skew between the instances, applying a change twice being harmless
+const changeLookback = 5 * time.Minute
+
+type Options struct {
+	Site       config.SiteConfig
+	ChunkSize  int
+	Refresh    time.Duration
+	NewsWindow time.Duration
+}
+
+// Cache keep the listed news in memory for the sitemaps. It load them all on the first use, then only read the news
+// updated since the previous refresh, at most once per refresh interval, so a crawl never scan the news table.
+// The rendered documents are kept until the next refresh.
+type Cache struct {
+	repo    repository.Sitemap
+	options Options
+	now     func() time.Time
+
+	mu          sync.Mutex
+	loaded      bool
+	refreshedAt time.Time
+	// entries are ordered by published_at then id, so the new news land in the last chunk and the others stay put
+	entries []model.SitemapEntry
+	byId    map[string]model.SitemapEntry
+	docs    map[string][]byte
+}
+
+func NewCache(repo repository.Sitemap, options Options) *Cache {
+	if options.ChunkSize <= 0 {
+		options.ChunkSize = maxChunkUrls
+	}
+
+	return &Cache{
+		repo:    repo,
+		options: options,
+		now:     time.Now,
+		byId:    map[string]model.SitemapEntry{},
+		docs:    map[string][]byte{},
+	}
+}
+
+func NewFromConfig(cfg config.Config, repo repository.Sitemap) *Cache {
+	return NewCache(repo, Options{
+		Site:       cfg.Site,
+		ChunkSize:  cfg.Sitemap.ChunkSize,
+		Refresh:    time.Duration(cfg.Sitemap.RefreshSeconds) * time.Second,
+		NewsWindow: time.Duration(cfg.Sitemap.NewsHours) * time.Hour,
+	})
+}
+
+// Index return the sitemap index of the chunks and of the Google News sitemap
+func (c *Cache) Index(ctx context.Context) ([]byte, error) {
+	return c.document(ctx, "index", func() ([]byte, error) {
+		return c.renderIndex()
+	})
+}
+
+// Chunk return the sitemap of the chunk, numbered from 1, there is always a first chunk even empty
+func (c *Cache) Chunk(ctx context.Context, number int) ([]byte, error) {
+	return c.document(ctx, "chunk/"+strconv.Itoa(number), func() ([]byte, error) {
+		if number < 1 || number > c.chunkCount() {
+			return nil, model.NewNotFoundError()
+		}
+		return c.renderChunk(number)
+	})
+}
+
+// News return the Google News sitemap of the news published in the window
+func (c *Cache) News(ctx context.Context) ([]byte, error) {
+	return c.document(ctx, "news", func() ([]byte, error) {
+		return c.renderNews()
+	})
+}
+
+func (c *Cache) document(ctx context.Context, key string, render func() ([]byte, error)) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	if doc, ok := c.docs[key]; ok {
+		return doc, nil
+	}
+
+	doc, err := render()
+	if err != nil {
+		return nil, err
+	}
+	c.docs[key] = doc
+
+	return doc, nil
+}
+
+// refresh apply the changes since the previous refresh, a failure after the first load only leave the sitemaps stale
+func (c *Cache) refresh(ctx context.Context) error {
+	now := c.now()
+	if c.loaded && now.Sub(c.refreshedAt) < c.options.Refresh {
+		return nil
+	}
+
+	var since *time.Time
+	if c.loaded {
+		since = helper.Pointer(c.refreshedAt.Add(-changeLookback))
+	}
+	entries, err := c.repo.ListEntries(ctx, since)
+	if err != nil {
+		if !c.loaded {
+			return err
+		}
+		helper.GetLogger(ctx).WithError(err).Warning("Failed refresh the sitemaps, serving the stale ones")
+		return nil
+	}
+
+	if c.loaded {
+		for _, v := range entries {
+			c.apply(v)
+		}
+	} else {
+		c.load(entries)
+	}
+	c.loaded = true
+	c.refreshedAt = now
+	c.docs = map[string][]byte{}
+
+	return nil
+}
+
+func (c *Cache) load(entries []model.SitemapEntry) {
+	c.entries = make([]model.SitemapEntry, 0, len(entries))
+	for _, v := range entries {
+		if !v.Listed {
+			continue
+		}
+		c.entries = append(c.entries, v)
+		c.byId[v.NewsId] = v
+	}
+	sort.Slice(c.entries, func(i, j int) bool {
+		return entryBefore(c.entries[i], c.entries[j])
+	})
+}
+
+func (c *Cache) apply(entry model.SitemapEntry) {
+	if old, ok := c.byId[entry.NewsId]; ok {
+		i := c.position(old)
+		c.entries = append(c.entries[:i], c.entries[i+1:]...)
+		delete(c.byId, entry.NewsId)
+	}
+	if !entry.Listed {
+		return
+	}
+
+	i := c.position(entry)
+	c.entries = append(c.entries, model.SitemapEntry{})
+	copy(c.entries[i+1:], c.entries[i:])
+	c.entries[i] = entry
+	c.byId[entry.NewsId] = entry
+}
+
+// position return the index of the entry, or where it would be inserted
+func (c *Cache) position(entry model.SitemapEntry) int {
+	return sort.Search(len(c.entries), func(i int) bool {
+		return !entryBefore(c.entries[i], entry)
+	})
+}
+
+func (c *Cache) chunkCount() int {
+	count := (len(c.entries) + c.options.ChunkSize - 1) / c.options.ChunkSize
+	if count == 0 {
+		return 1
+	}
+	return count
+}
+
+func entryBefore(a model.SitemapEntry, b model.SitemapEntry) bool {
+	if !a.PublishedAt.Equal(b.PublishedAt) {
+		return a.PublishedAt.Before(b.PublishedAt)
+	}
+	return a.NewsId < b.NewsId
+}
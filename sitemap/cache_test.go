@@ -0,0 +1,158 @@
+package sitemap_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"tempo/config"
+	"tempo/model"
+	"tempo/repository/mocks"
+	"tempo/sitemap"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func fakeEntry(id string, publishedAt time.Time) model.SitemapEntry {
+	return model.SitemapEntry{
+		NewsId:      id,
+		Title:       "title of " + id,
+		PublishedAt: publishedAt,
+		UpdatedAt:   publishedAt,
+		Listed:      true,
+	}
+}
+
+func TestCache_Chunk(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldSplitTheNewsIntoChunks_InPublicationOrder", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		now := time.Now()
+		repoMock := &mocks.Sitemap{}
+		repoMock.On("ListEntries", mock.Anything, (*time.Time)(nil)).Return([]model.SitemapEntry{
+			fakeEntry("c", now),
+			fakeEntry("a", now.Add(-2*time.Hour)),
+			fakeEntry("b", now.Add(-time.Hour)),
+		}, nil).Once()
+		cache := sitemap.NewCache(repoMock, sitemap.Options{
+			Site:      config.SiteConfig{BaseUrl: "https://tempo.test/", NewsPath: "/news/%s", Name: "Tempo", Language: "en"},
+			ChunkSize: 2,
+			Refresh:   time.Hour,
+		})
+
+		// CODE UNDER TEST
+		index, err := cache.Index(context.TODO())
+		require.NoError(t, err)
+		first, err := cache.Chunk(context.TODO(), 1)
+		require.NoError(t, err)
+		second, err := cache.Chunk(context.TODO(), 2)
+		require.NoError(t, err)
+		_, errThird := cache.Chunk(context.TODO(), 3)
+
+		// EXPECTATION
+		require.Contains(t, string(index), "<loc>https://tempo.test/sitemaps/1.xml</loc>")
+		require.Contains(t, string(index), "<loc>https://tempo.test/sitemaps/2.xml</loc>")
+		require.Contains(t, string(index), "<loc>https://tempo.test/sitemap-news.xml</loc>")
+		require.Contains(t, string(first), "<loc>https://tempo.test/news/a</loc>")
+		require.Contains(t, string(first), "<loc>https://tempo.test/news/b</loc>")
+		require.Contains(t, string(second), "<loc>https://tempo.test/news/c</loc>")
+		require.Contains(t, string(second), "<lastmod>"+now.UTC().Format(time.RFC3339)+"</lastmod>")
+		require.True(t, model.IsNotFoundError(errThird))
+
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldApplyTheChanges_WhenRefreshing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		now := time.Now()
+		removed := fakeEntry("a", now.Add(-2*time.Hour))
+		removed.Listed = false
+		repoMock := &mocks.Sitemap{}
+		repoMock.On("ListEntries", mock.Anything, (*time.Time)(nil)).Return([]model.SitemapEntry{
+			fakeEntry("a", now.Add(-2*time.Hour)),
+			fakeEntry("b", now.Add(-time.Hour)),
+		}, nil).Once()
+		repoMock.On("ListEntries", mock.Anything, mock.AnythingOfType("*time.Time")).Return([]model.SitemapEntry{
+			removed,
+			fakeEntry("c", now),
+		}, nil).Once()
+		cache := sitemap.NewCache(repoMock, sitemap.Options{
+			Site: config.SiteConfig{BaseUrl: "https://tempo.test", NewsPath: "/news/%s", Name: "Tempo", Language: "en"},
+		})
+
+		// CODE UNDER TEST
+		before, err := cache.Chunk(context.TODO(), 1)
+		require.NoError(t, err)
+		after, err := cache.Chunk(context.TODO(), 1)
+		require.NoError(t, err)
+
+		// EXPECTATION
+		require.Contains(t, string(before), "/news/a<")
+		require.NotContains(t, string(before), "/news/c<")
+		require.NotContains(t, string(after), "/news/a<")
+		require.Contains(t, string(after), "/news/b<")
+		require.Contains(t, string(after), "/news/c<")
+
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldServeTheStaleSitemap_WhenRefreshFailed", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		repoMock := &mocks.Sitemap{}
+		repoMock.On("ListEntries", mock.Anything, (*time.Time)(nil)).Return([]model.SitemapEntry{
+			fakeEntry("a", time.Now()),
+		}, nil).Once()
+		repoMock.On("ListEntries", mock.Anything, mock.AnythingOfType("*time.Time")).Return(nil, errors.New("error list")).Once()
+		cache := sitemap.NewCache(repoMock, sitemap.Options{
+			Site: config.SiteConfig{BaseUrl: "https://tempo.test", NewsPath: "/news/%s", Name: "Tempo", Language: "en"},
+		})
+
+		// CODE UNDER TEST
+		_, err := cache.Chunk(context.TODO(), 1)
+		require.NoError(t, err)
+		res, err := cache.Chunk(context.TODO(), 1)
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Contains(t, string(res), "/news/a<")
+
+		repoMock.AssertExpectations(t)
+	})
+}
+
+func TestCache_News(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldOnlyListTheNewsOfTheWindow", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		now := time.Now()
+		repoMock := &mocks.Sitemap{}
+		repoMock.On("ListEntries", mock.Anything, (*time.Time)(nil)).Return([]model.SitemapEntry{
+			fakeEntry("old", now.Add(-72*time.Hour)),
+			fakeEntry("recent", now.Add(-time.Hour)),
+		}, nil).Once()
+		cache := sitemap.NewCache(repoMock, sitemap.Options{
+			Site:       config.SiteConfig{BaseUrl: "https://tempo.test", NewsPath: "/news/%s", Name: "Tempo", Language: "en"},
+			Refresh:    time.Hour,
+			NewsWindow: 48 * time.Hour,
+		})
+
+		// CODE UNDER TEST
+		res, err := cache.News(context.TODO())
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Contains(t, string(res), `xmlns:news="http://www.google.com/schemas/sitemap-news/0.9"`)
+		require.Contains(t, string(res), "<loc>https://tempo.test/news/recent</loc>")
+		require.Contains(t, string(res), "<news:name>Tempo</news:name>")
+		require.Contains(t, string(res), "<news:title>title of recent</news:title>")
+		require.NotContains(t, string(res), "/news/old<")
+
+		repoMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,144 @@
+package sitemap
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"tempo/model"
+)
+
+const (
+	// maxChunkUrls is the most urls a sitemap can list
+	maxChunkUrls = 50000
+	// maxNewsUrls is the most urls a Google News sitemap can list
+	maxNewsUrls = 1000
+
+	sitemapNamespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	newsNamespace    = "http://www.google.com/schemas/sitemap-news/0.9"
+)
+
+type sitemapIndex struct {
+	XMLName  xml.Name     `xml:"sitemapindex"`
+	Xmlns    string       `xml:"xmlns,attr"`
+	Sitemaps []sitemapRef `xml:"sitemap"`
+}
+
+type sitemapRef struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+type urlSet struct {
+	XMLName   xml.Name `xml:"urlset"`
+	Xmlns     string   `xml:"xmlns,attr"`
+	XmlnsNews string   `xml:"xmlns:news,attr,omitempty"`
+	Urls      []urlRef `xml:"url"`
+}
+
+type urlRef struct {
+	Loc     string    `xml:"loc"`
+	LastMod string    `xml:"lastmod,omitempty"`
+	News    *newsInfo `xml:"news:news,omitempty"`
+}
+
+type newsInfo struct {
+	Publication     newsPublication `xml:"news:publication"`
+	PublicationDate string          `xml:"news:publication_date"`
+	Title           string          `xml:"news:title"`
+}
+
+type newsPublication struct {
+	Name     string `xml:"news:name"`
+	Language string `xml:"news:language"`
+}
+
+func (c *Cache) renderIndex() ([]byte, error) {
+	index := sitemapIndex{Xmlns: sitemapNamespace}
+	for i := 1; i <= c.chunkCount(); i++ {
+		var lastMod time.Time
+		for _, v := range c.chunk(i) {
+			if v.UpdatedAt.After(lastMod) {
+				lastMod = v.UpdatedAt
+			}
+		}
+		index.Sitemaps = append(index.Sitemaps, sitemapRef{
+			Loc:     c.options.Site.Url(fmt.Sprintf("/sitemaps/%d.xml", i)),
+			LastMod: formatTime(lastMod),
+		})
+	}
+	index.Sitemaps = append(index.Sitemaps, sitemapRef{Loc: c.options.Site.Url("/sitemap-news.xml")})
+
+	return encode(index)
+}
+
+func (c *Cache) renderChunk(number int) ([]byte, error) {
+	set := urlSet{Xmlns: sitemapNamespace, Urls: []urlRef{}}
+	for _, v := range c.chunk(number) {
+		set.Urls = append(set.Urls, urlRef{
+			Loc:     c.options.Site.NewsUrl(v.NewsId),
+			LastMod: formatTime(v.UpdatedAt),
+		})
+	}
+
+	return encode(set)
+}
+
+// renderNews list the news of the window from the newest
+func (c *Cache) renderNews() ([]byte, error) {
+	now := c.now()
+	from := now.Add(-c.options.NewsWindow)
+
+	set := urlSet{Xmlns: sitemapNamespace, XmlnsNews: newsNamespace, Urls: []urlRef{}}
+	for i := len(c.entries) - 1; i >= 0 && len(set.Urls) < maxNewsUrls; i-- {
+		v := c.entries[i]
+		if v.PublishedAt.Before(from) {
+			break
+		}
+		if v.PublishedAt.After(now) {
+			continue
+		}
+		set.Urls = append(set.Urls, urlRef{
+			Loc: c.options.Site.NewsUrl(v.NewsId),
+			News: &newsInfo{
+				Publication: newsPublication{
+					Name:     c.options.Site.Name,
+					Language: c.options.Site.Language,
+				},
+				PublicationDate: formatTime(v.PublishedAt),
+				Title:           v.Title,
+			},
+		})
+	}
+
+	return encode(set)
+}
+
+func (c *Cache) chunk(number int) []model.SitemapEntry {
+	from := (number - 1) * c.options.ChunkSize
+	if from >= len(c.entries) {
+		return nil
+	}
+	to := from + c.options.ChunkSize
+	if to > len(c.entries) {
+		to = len(c.entries)
+	}
+	return c.entries[from:to]
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func encode(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	if err := xml.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
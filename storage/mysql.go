@@ -139,6 +139,27 @@ func CloseDB(db *gorm.DB) {
 func TruncateNonRefTables(db *gorm.DB) error {
 	models := []interface{}{
 		mysqlrepo.User{},
+		mysqlrepo.Bookmark{},
+		mysqlrepo.Follow{},
+		mysqlrepo.Notification{},
+		mysqlrepo.NotificationPreference{},
+		mysqlrepo.Webhook{},
+		mysqlrepo.WebhookDelivery{},
+		mysqlrepo.Outbox{},
+		mysqlrepo.ModerationItem{},
+		mysqlrepo.FeaturedItem{},
+		mysqlrepo.FeaturedAudit{},
+		mysqlrepo.Collection{},
+		mysqlrepo.CollectionItem{},
+		mysqlrepo.NewsAuthor{},
+		mysqlrepo.NewsReport{},
+		mysqlrepo.NewsReportResolution{},
+		mysqlrepo.NewsReportHold{},
+		mysqlrepo.UserAdminAction{},
+		mysqlrepo.RefreshToken{},
+		mysqlrepo.RevokedToken{},
+		mysqlrepo.RateLimit{},
+		mysqlrepo.PasswordReset{},
 	}
 	for _, v := range models {
 		err := db.Statement.Parse(v)
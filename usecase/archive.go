@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+)
+
+type Archive struct {
+	newsRepo repository.News
+	timeZone string
+}
+
+func NewArchive(a *container.Container) *Archive {
+	return &Archive{
+		newsRepo: a.NewsRepo(),
+		timeZone: a.Config().Site.TimeZone,
+	}
+}
+
+// Months return the number of news published in each month of the site time zone, the newest month first and
+// without the months nothing was published in
+func (a *Archive) Months(ctx context.Context) ([]model.NewsArchiveMonth, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Archive.Months")
+
+	loc, err := a.location()
+	if err != nil {
+		logger.WithError(err).Error("Failed load site time zone")
+		return nil, err
+	}
+
+	first, last, err := a.newsRepo.PublishedRange(ctx)
+	if err != nil {
+		logger.WithError(err).Warning("Failed get published range")
+		return nil, err
+	}
+
+	res := []model.NewsArchiveMonth{}
+	if first == nil || last == nil {
+		return res, nil
+	}
+
+	bounds := monthBounds(first.In(loc), last.In(loc))
+	counts, err := a.newsRepo.CountPublished(ctx, bounds)
+	if err != nil {
+		logger.WithError(err).Warning("Failed count published News")
+		return nil, err
+	}
+
+	for i := len(counts) - 1; i >= 0; i-- {
+		if counts[i] == 0 {
+			continue
+		}
+		res = append(res, model.NewsArchiveMonth{
+			Year:  bounds[i].Year(),
+			Month: int(bounds[i].Month()),
+			Count: counts[i],
+		})
+	}
+
+	return res, nil
+}
+
+// List return a page of the news published in the month of the site time zone, newest first, and the cursor of the
+// next page if there is one
+func (a *Archive) List(ctx context.Context, year int, month int, cursor *string, limit int) ([]model.News, *string, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Archive.List")
+
+	if year < 1 || year > 9999 || month < 1 || month > 12 {
+		return nil, nil, model.NewNotFoundError()
+	}
+
+	loc, err := a.location()
+	if err != nil {
+		logger.WithError(err).Error("Failed load site time zone")
+		return nil, nil, err
+	}
+
+	beforePublishedAt, beforeId, err := decodeCursor(cursor)
+	if err != nil {
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, nil, err
+	}
+	limit = pageLimit(limit)
+
+	since := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, loc)
+	until := time.Date(year, time.Month(month)+1, 1, 0, 0, 0, 0, loc)
+	res, err := a.newsRepo.List(ctx, repository.NewsListFilter{
+		PublishedSince:    &since,
+		PublishedUntil:    &until,
+		BeforePublishedAt: beforePublishedAt,
+		BeforeId:          beforeId,
+		Limit:             limit + 1,
+	})
+	if err != nil {
+		logger.WithError(err).Warning("Failed list News")
+		return nil, nil, err
+	}
+
+	var next *string
+	if len(res) > limit {
+		res = res[:limit]
+		last := res[limit-1]
+		next = helper.Pointer(helper.EncodeCursor(*last.PublishedAt, *last.Id))
+	}
+
+	return res, next, nil
+}
+
+func (a *Archive) location() (*time.Location, error) {
+	if a.timeZone == "" {
+		return time.UTC, nil
+	}
+
+	return time.LoadLocation(a.timeZone)
+}
+
+// monthBounds return the start of every month from the one of first to the one after last, in their location
+func monthBounds(first time.Time, last time.Time) []time.Time {
+	end := time.Date(last.Year(), last.Month()+1, 1, 0, 0, 0, 0, last.Location())
+
+	var res []time.Time
+	for year, month := first.Year(), first.Month(); ; month++ {
+		t := time.Date(year, month, 1, 0, 0, 0, 0, first.Location())
+		res = append(res, t)
+		if !t.Before(end) {
+			break
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,143 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tempo/config"
+	"tempo/container"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+	"tempo/usecase"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func archiveContainer(t *testing.T, timeZone string, newsMock *mocks.News) *container.Container {
+	cfg := config.Config{}
+	cfg.Site.TimeZone = timeZone
+
+	appContainer := container.Container{}
+	appContainer.SetConfig(cfg)
+	appContainer.SetNewsRepo(newsMock)
+
+	return &appContainer
+}
+
+func TestArchive_Months(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldCountByMonthOfTheSiteTimeZone_NewestFirst", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		loc, err := time.LoadLocation("Asia/Jakarta")
+		require.NoError(t, err)
+		// the first news is published on January 31st in UTC but on February 1st in Jakarta
+		first := time.Date(2023, time.January, 31, 20, 0, 0, 0, time.UTC)
+		last := time.Date(2023, time.April, 10, 0, 0, 0, 0, time.UTC)
+		bounds := []time.Time{
+			time.Date(2023, time.February, 1, 0, 0, 0, 0, loc),
+			time.Date(2023, time.March, 1, 0, 0, 0, 0, loc),
+			time.Date(2023, time.April, 1, 0, 0, 0, 0, loc),
+			time.Date(2023, time.May, 1, 0, 0, 0, 0, loc),
+		}
+
+		newsMock := &mocks.News{}
+		newsMock.On("PublishedRange", mock.Anything).Return(&first, &last, nil).Once()
+		newsMock.On("CountPublished", mock.Anything, mock.MatchedBy(func(v []time.Time) bool {
+			if len(v) != len(bounds) {
+				return false
+			}
+			for i := range v {
+				if !v[i].Equal(bounds[i]) {
+					return false
+				}
+			}
+			return true
+		})).Return([]int64{3, 0, 2}, nil).Once()
+
+		// CODE UNDER TEST
+		uc := usecase.NewArchive(archiveContainer(t, "Asia/Jakarta", newsMock))
+		res, err := uc.Months(context.Background())
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, []model.NewsArchiveMonth{
+			{Year: 2023, Month: 4, Count: 2},
+			{Year: 2023, Month: 2, Count: 3},
+		}, res)
+
+		newsMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnEmpty_WhenNothingIsPublished", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		newsMock := &mocks.News{}
+		newsMock.On("PublishedRange", mock.Anything).Return(nil, nil, nil).Once()
+
+		// CODE UNDER TEST
+		uc := usecase.NewArchive(archiveContainer(t, "", newsMock))
+		res, err := uc.Months(context.Background())
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Empty(t, res)
+
+		newsMock.AssertExpectations(t)
+	})
+}
+
+func TestArchive_List(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldListTheMonthOfTheSiteTimeZone", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		loc, err := time.LoadLocation("America/New_York")
+		require.NoError(t, err)
+		since := time.Date(2023, time.December, 1, 0, 0, 0, 0, loc)
+		until := time.Date(2024, time.January, 1, 0, 0, 0, 0, loc)
+		news := []model.News{
+			{Id: helper.Pointer("b"), PublishedAt: helper.Pointer(since.Add(48 * time.Hour))},
+			{Id: helper.Pointer("a"), PublishedAt: helper.Pointer(since.Add(24 * time.Hour))},
+		}
+
+		newsMock := &mocks.News{}
+		newsMock.On("List", mock.Anything, mock.MatchedBy(func(filter repository.NewsListFilter) bool {
+			return filter.PublishedSince.Equal(since) && filter.PublishedUntil.Equal(until) && filter.Limit == 2
+		})).Return(news, nil).Once()
+
+		// CODE UNDER TEST
+		uc := usecase.NewArchive(archiveContainer(t, "America/New_York", newsMock))
+		res, next, err := uc.List(context.Background(), 2023, 12, nil, 1)
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.Equal(t, "b", *res[0].Id)
+		require.NotNil(t, next)
+
+		newsMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnNotFound_WhenMonthIsNotValid", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		newsMock := &mocks.News{}
+
+		// CODE UNDER TEST
+		uc := usecase.NewArchive(archiveContainer(t, "", newsMock))
+		res, next, err := uc.List(context.Background(), 2023, 13, nil, 0)
+
+		// EXPECTATION
+		require.Error(t, err)
+		require.True(t, model.IsNotFoundError(err))
+		require.Nil(t, res)
+		require.Nil(t, next)
+
+		newsMock.AssertExpectations(t)
+	})
+}
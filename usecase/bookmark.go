@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+)
+
+type Bookmark struct {
+	repository.Bookmark
+	newsRepo repository.News
+}
+
+func NewBookmark(b *container.Container) *Bookmark {
+	return &Bookmark{
+		Bookmark: b.BookmarkRepo(),
+		newsRepo: b.NewsRepo(),
+	}
+}
+
+func (b *Bookmark) Put(ctx context.Context, req *model.Bookmark) (*model.Bookmark, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Bookmark.Put")
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, model.NewParameterError(helper.Pointer(err.Error()))
+	}
+
+	news, err := b.newsRepo.Get(ctx, req.NewsId)
+	if err != nil {
+		logger.WithError(err).Warning("Failed get News")
+		return nil, err
+	}
+	if news.PublishedAt == nil {
+		err = model.NewNotFoundError()
+		logger.WithError(err).Warning("News is not published")
+		return nil, err
+	}
+
+	res, err := b.Bookmark.Upsert(ctx, req)
+	if err != nil {
+		logger.WithError(err).Warning("Failed upsert Bookmark")
+		return nil, err
+	}
+	res.News = news
+
+	return res, nil
+}
+
+func (b *Bookmark) Delete(ctx context.Context, userId *string, newsId *string) error {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Bookmark.Delete")
+
+	if userId == nil || newsId == nil {
+		logger.Error("missing user id or news id")
+		return model.NewParameterError(helper.Pointer("missing user id or news id"))
+	}
+
+	if err := b.Bookmark.Delete(ctx, *userId, *newsId); err != nil {
+		logger.WithError(err).Warning("Failed delete Bookmark")
+		return err
+	}
+
+	return nil
+}
+
+// List return a page of the user bookmarks, newest first, and the cursor of the next page if there is one
+func (b *Bookmark) List(ctx context.Context, filter repository.BookmarkListFilter, cursor *string) ([]model.Bookmark, *string, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Bookmark.List")
+
+	if filter.UserId == nil {
+		logger.Error("missing user id")
+		return nil, nil, model.NewParameterError(helper.Pointer("missing user id"))
+	}
+
+	afterCreatedAt, afterId, err := decodeCursor(cursor)
+	if err != nil {
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, nil, err
+	}
+	limit := pageLimit(filter.Limit)
+	filter.AfterCreatedAt = afterCreatedAt
+	filter.AfterId = afterId
+	filter.Limit = limit + 1
+
+	res, err := b.Bookmark.List(ctx, filter)
+	if err != nil {
+		logger.WithError(err).Warning("Failed list Bookmark")
+		return nil, nil, err
+	}
+
+	var next *string
+	if len(res) > limit {
+		res = res[:limit]
+		last := res[limit-1]
+		next = helper.Pointer(helper.EncodeCursor(*last.CreatedAt, *last.Id))
+	}
+
+	return res, next, nil
+}
@@ -0,0 +1,223 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+	"tempo/usecase"
+
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookmark_Put(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnError_WhenNewsIdIsMissing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		appContainer := container.Container{}
+
+		fakeBookmark := test.FakeBookmark(t, func(bookmark model.Bookmark) model.Bookmark {
+			bookmark.NewsId = nil
+			return bookmark
+		})
+
+		// CODE UNDER TEST
+		uc := usecase.NewBookmark(&appContainer)
+		res, err := uc.Put(context.Background(), &fakeBookmark)
+		require.Error(t, err)
+		require.True(t, model.IsParameterError(err))
+		require.Nil(t, res)
+	})
+
+	t.Run("ShouldReturnNotFound_WhenNewsIsNotPublished", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeBookmark := test.FakeBookmark(t, nil)
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.Id = fakeBookmark.NewsId
+			news.PublishedAt = nil
+			return news
+		})
+
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeBookmark.NewsId).Return(&fakeNews, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsRepo(newsMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewBookmark(&appContainer)
+		res, err := uc.Put(context.Background(), &fakeBookmark)
+		require.Error(t, err)
+		require.True(t, model.IsNotFoundError(err))
+		require.Nil(t, res)
+
+		newsMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnError_WhenFailedUpsertBookmark", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeBookmark := test.FakeBookmark(t, nil)
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.Id = fakeBookmark.NewsId
+			news.PublishedAt = helper.Pointer(time.Now())
+			return news
+		})
+
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeBookmark.NewsId).Return(&fakeNews, nil).Once()
+		bookmarkMock := &mocks.Bookmark{}
+		bookmarkMock.On("Upsert", mock.Anything, &fakeBookmark).Return(nil, errors.New("error upsert")).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsRepo(newsMock)
+		appContainer.SetBookmarkRepo(bookmarkMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewBookmark(&appContainer)
+		res, err := uc.Put(context.Background(), &fakeBookmark)
+		require.Error(t, err)
+		require.EqualError(t, err, "error upsert")
+		require.Nil(t, res)
+
+		newsMock.AssertExpectations(t)
+		bookmarkMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnBookmarkWithNews_WhenSuccess", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeBookmark := test.FakeBookmark(t, nil)
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.Id = fakeBookmark.NewsId
+			news.PublishedAt = helper.Pointer(time.Now())
+			return news
+		})
+
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeBookmark.NewsId).Return(&fakeNews, nil).Once()
+		bookmarkMock := &mocks.Bookmark{}
+		bookmarkMock.On("Upsert", mock.Anything, &fakeBookmark).Return(&model.Bookmark{
+			Id:        helper.Pointer(fake.CharactersN(6)),
+			UserId:    fakeBookmark.UserId,
+			NewsId:    fakeBookmark.NewsId,
+			Folder:    fakeBookmark.Folder,
+			CreatedAt: helper.Pointer(time.Now()),
+		}, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsRepo(newsMock)
+		appContainer.SetBookmarkRepo(bookmarkMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewBookmark(&appContainer)
+		res, err := uc.Put(context.Background(), &fakeBookmark)
+		require.NoError(t, err)
+		require.NotNil(t, res.Id)
+		require.Equal(t, *fakeBookmark.Folder, *res.Folder)
+		require.Equal(t, *fakeNews.Id, *res.News.Id)
+
+		newsMock.AssertExpectations(t)
+		bookmarkMock.AssertExpectations(t)
+	})
+}
+
+func TestBookmark_List(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnError_WhenCursorIsInvalid", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		appContainer := container.Container{}
+
+		// CODE UNDER TEST
+		uc := usecase.NewBookmark(&appContainer)
+		res, next, err := uc.List(context.Background(), repository.BookmarkListFilter{
+			UserId: helper.Pointer(fake.CharactersN(7)),
+		}, helper.Pointer("invalid cursor"))
+		require.Error(t, err)
+		require.True(t, model.IsParameterError(err))
+		require.Nil(t, res)
+		require.Nil(t, next)
+	})
+
+	t.Run("ShouldReturnNextCursor_WhenThereIsMorePage", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		userId := helper.Pointer(fake.CharactersN(7))
+		now := time.Now().Truncate(time.Second)
+		bookmarks := []model.Bookmark{
+			{Id: helper.Pointer("3"), UserId: userId, CreatedAt: helper.Pointer(now)},
+			{Id: helper.Pointer("2"), UserId: userId, CreatedAt: helper.Pointer(now.Add(-time.Second))},
+			{Id: helper.Pointer("1"), UserId: userId, CreatedAt: helper.Pointer(now.Add(-2 * time.Second))},
+		}
+
+		bookmarkMock := &mocks.Bookmark{}
+		bookmarkMock.On("List", mock.Anything, repository.BookmarkListFilter{
+			UserId: userId,
+			Limit:  3,
+		}).Return(bookmarks, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetBookmarkRepo(bookmarkMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewBookmark(&appContainer)
+		res, next, err := uc.List(context.Background(), repository.BookmarkListFilter{
+			UserId: userId,
+			Limit:  2,
+		}, nil)
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		require.NotNil(t, next)
+
+		afterCreatedAt, afterId, err := helper.DecodeCursor(*next)
+		require.NoError(t, err)
+		require.Equal(t, "2", afterId)
+		require.True(t, bookmarks[1].CreatedAt.Equal(afterCreatedAt))
+
+		bookmarkMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldNotReturnNextCursor_WhenLastPage", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		userId := helper.Pointer(fake.CharactersN(7))
+		cursorTime := time.Now().Truncate(time.Second)
+		cursor := helper.EncodeCursor(cursorTime, "10")
+
+		bookmarkMock := &mocks.Bookmark{}
+		bookmarkMock.On("List", mock.Anything, mock.MatchedBy(func(filter repository.BookmarkListFilter) bool {
+			return *filter.UserId == *userId &&
+				filter.Limit == 21 &&
+				*filter.AfterId == "10" &&
+				filter.AfterCreatedAt.Equal(cursorTime)
+		})).Return([]model.Bookmark{
+			{Id: helper.Pointer("9"), UserId: userId, CreatedAt: helper.Pointer(cursorTime)},
+		}, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetBookmarkRepo(bookmarkMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewBookmark(&appContainer)
+		res, next, err := uc.List(context.Background(), repository.BookmarkListFilter{
+			UserId: userId,
+		}, &cursor)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.Nil(t, next)
+
+		bookmarkMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,217 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+)
+
+type Collection struct {
+	repository.Collection
+	newsRepo       repository.News
+	newsAuthorRepo repository.NewsAuthor
+}
+
+func NewCollection(c *container.Container) *Collection {
+	return &Collection{
+		Collection:     c.CollectionRepo(),
+		newsRepo:       c.NewsRepo(),
+		newsAuthorRepo: c.NewsAuthorRepo(),
+	}
+}
+
+func (c *Collection) Add(ctx context.Context, req *model.Collection) (*model.Collection, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Collection.Add")
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, model.NewParameterError(helper.Pointer(err.Error()))
+	}
+
+	res, err := c.Collection.Add(ctx, req)
+	if err != nil {
+		logger.WithError(err).Warning("Failed insert Collection")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Get the collection as the viewer see it, the unpublished news being left out unless the viewer is one of their
+// authors or an admin
+func (c *Collection) Get(ctx context.Context, id *string, viewer *model.User) (*model.Collection, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Collection.Get")
+
+	if id == nil {
+		logger.Error("missing id")
+		return nil, model.NewParameterError(helper.Pointer("missing id"))
+	}
+
+	res, err := c.Collection.Get(ctx, *id)
+	if err != nil {
+		logger.WithError(err).Warning("Failed get Collection")
+		return nil, err
+	}
+
+	res.Items, err = c.visibleItems(ctx, res.Items, viewer)
+	if err != nil {
+		logger.WithError(err).Warning("Failed list NewsAuthor")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// visibleItems drop the items of the unpublished news the viewer can't read, the authors of the news being read at once
+func (c *Collection) visibleItems(ctx context.Context, items []model.CollectionItem, viewer *model.User) ([]model.CollectionItem, error) {
+	if viewer != nil && viewer.HasRole(model.UserRoleAdmin) {
+		return items, nil
+	}
+
+	var unpublishedIds []string
+	for _, v := range items {
+		if v.News.PublishedAt == nil {
+			unpublishedIds = append(unpublishedIds, *v.News.Id)
+		}
+	}
+	if len(unpublishedIds) == 0 {
+		return items, nil
+	}
+
+	authored := map[string]bool{}
+	if viewer != nil && viewer.Id != nil {
+		authors, err := c.newsAuthorRepo.List(ctx, repository.NewsAuthorListFilter{NewsIds: unpublishedIds})
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range authors {
+			if *v.UserId == *viewer.Id {
+				authored[*v.NewsId] = true
+			}
+		}
+	}
+
+	res := make([]model.CollectionItem, 0, len(items))
+	for _, v := range items {
+		owner := viewer != nil && viewer.Id != nil && helper.Val(v.News.UserId) == *viewer.Id
+		if v.News.PublishedAt != nil || owner || authored[*v.News.Id] {
+			res = append(res, v)
+		}
+	}
+
+	return res, nil
+}
+
+// AddItem add a news of the author to the collection, at the position or at the end when it is nil
+func (c *Collection) AddItem(ctx context.Context, id *string, userId *string, newsId *string, position *int) (*model.Collection, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Collection.AddItem")
+
+	if id == nil || userId == nil || newsId == nil {
+		logger.Error("missing id")
+		return nil, model.NewParameterError(helper.Pointer("missing id"))
+	}
+	if position != nil && *position < 0 {
+		err := model.NewParameterError(helper.Pointer("position must be no less than 0"))
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, err
+	}
+
+	if err := c.checkAuthor(ctx, *id, *userId); err != nil {
+		logger.WithError(err).Warning("Not allowed")
+		return nil, err
+	}
+
+	news, err := c.newsRepo.Get(ctx, newsId)
+	if err != nil {
+		if model.IsNotFoundError(err) {
+			err = model.NewParameterError(helper.Pointer(fmt.Sprintf("news %s is not found", *newsId)))
+		}
+		logger.WithError(err).Warning("Failed get News")
+		return nil, err
+	}
+	if helper.Val(news.UserId) != *userId {
+		err = model.NewError("only the news of the author can be added", model.ErrorUnauthorized)
+		logger.WithError(err).Warning("Not allowed")
+		return nil, err
+	}
+
+	res, err := c.Collection.AddItem(ctx, *id, *newsId, position)
+	if err != nil {
+		logger.WithError(err).Warning("Failed add CollectionItem")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Collection) RemoveItem(ctx context.Context, id *string, userId *string, newsId *string) (*model.Collection, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Collection.RemoveItem")
+
+	if id == nil || userId == nil || newsId == nil {
+		logger.Error("missing id")
+		return nil, model.NewParameterError(helper.Pointer("missing id"))
+	}
+
+	if err := c.checkAuthor(ctx, *id, *userId); err != nil {
+		logger.WithError(err).Warning("Not allowed")
+		return nil, err
+	}
+
+	res, err := c.Collection.RemoveItem(ctx, *id, *newsId)
+	if err != nil {
+		logger.WithError(err).Warning("Failed remove CollectionItem")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Reorder set the order of the items, version is the one of the collection the order was made on
+func (c *Collection) Reorder(ctx context.Context, id *string, userId *string, version int, newsIds []string) (*model.Collection, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Collection.Reorder")
+
+	if id == nil || userId == nil {
+		logger.Error("missing id")
+		return nil, model.NewParameterError(helper.Pointer("missing id"))
+	}
+
+	seen := make(map[string]struct{}, len(newsIds))
+	for _, v := range newsIds {
+		if _, ok := seen[v]; ok {
+			err := model.NewParameterError(helper.Pointer(fmt.Sprintf("news %s is listed twice", v)))
+			logger.WithError(err).Warning("Not Valid Request")
+			return nil, err
+		}
+		seen[v] = struct{}{}
+	}
+
+	if err := c.checkAuthor(ctx, *id, *userId); err != nil {
+		logger.WithError(err).Warning("Not allowed")
+		return nil, err
+	}
+
+	res, err := c.Collection.Reorder(ctx, *id, version, newsIds)
+	if err != nil {
+		logger.WithError(err).Warning("Failed reorder Collection")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// checkAuthor return an error when the collection is not the user's one
+func (c *Collection) checkAuthor(ctx context.Context, id string, userId string) error {
+	collection, err := c.Collection.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if helper.Val(collection.UserId) != userId {
+		return model.NewError("only the author can change the collection", model.ErrorUnauthorized)
+	}
+
+	return nil
+}
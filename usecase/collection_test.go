@@ -0,0 +1,267 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+	"tempo/usecase"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollection_Add(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnError_WhenTitleIsMissing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		appContainer := container.Container{}
+
+		// CODE UNDER TEST
+		uc := usecase.NewCollection(&appContainer)
+		res, err := uc.Add(context.Background(), &model.Collection{UserId: helper.Pointer("user")})
+
+		// EXPECTATION
+		require.True(t, model.IsParameterError(err))
+		require.Nil(t, res)
+	})
+}
+
+// collectionWithDraft return a collection of a published news of the author and a draft co-written by the co-author
+func collectionWithDraft() *model.Collection {
+	return &model.Collection{
+		Id:     helper.Pointer("collection"),
+		UserId: helper.Pointer("author"),
+		Items: []model.CollectionItem{
+			{NewsId: helper.Pointer("published"), News: &model.News{
+				Id: helper.Pointer("published"), UserId: helper.Pointer("author"), PublishedAt: helper.Pointer(time.Now()),
+			}},
+			{NewsId: helper.Pointer("draft"), News: &model.News{
+				Id: helper.Pointer("draft"), UserId: helper.Pointer("author"),
+			}},
+		},
+	}
+}
+
+func TestCollection_Get(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldLeaveOutTheUnpublishedNews_WhenViewerIsNotAnAuthor", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		collectionMock := &mocks.Collection{}
+		collectionMock.On("Get", mock.Anything, "collection").Return(collectionWithDraft(), nil).Once()
+		newsAuthorMock := &mocks.NewsAuthor{}
+		newsAuthorMock.On("List", mock.Anything, repository.NewsAuthorListFilter{NewsIds: []string{"draft"}}).
+			Return([]model.NewsAuthor{{NewsId: helper.Pointer("draft"), UserId: helper.Pointer("author")}}, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetCollectionRepo(collectionMock)
+		appContainer.SetNewsAuthorRepo(newsAuthorMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewCollection(&appContainer)
+		res, err := uc.Get(context.Background(), helper.Pointer("collection"), &model.User{Id: helper.Pointer("reader")})
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		require.Equal(t, "published", *res.Items[0].NewsId)
+
+		collectionMock.AssertExpectations(t)
+		newsAuthorMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldListTheUnpublishedNews_WhenViewerIsACoAuthor", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		collectionMock := &mocks.Collection{}
+		collectionMock.On("Get", mock.Anything, "collection").Return(collectionWithDraft(), nil).Once()
+		newsAuthorMock := &mocks.NewsAuthor{}
+		newsAuthorMock.On("List", mock.Anything, mock.Anything).Return([]model.NewsAuthor{
+			{NewsId: helper.Pointer("draft"), UserId: helper.Pointer("author")},
+			{NewsId: helper.Pointer("draft"), UserId: helper.Pointer("co-author")},
+		}, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetCollectionRepo(collectionMock)
+		appContainer.SetNewsAuthorRepo(newsAuthorMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewCollection(&appContainer)
+		res, err := uc.Get(context.Background(), helper.Pointer("collection"), &model.User{Id: helper.Pointer("co-author")})
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Len(t, res.Items, 2)
+
+		collectionMock.AssertExpectations(t)
+		newsAuthorMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldListTheUnpublishedNews_WhenViewerIsAnAdmin", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		collectionMock := &mocks.Collection{}
+		collectionMock.On("Get", mock.Anything, "collection").Return(collectionWithDraft(), nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetCollectionRepo(collectionMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewCollection(&appContainer)
+		res, err := uc.Get(context.Background(), helper.Pointer("collection"), &model.User{
+			Id:   helper.Pointer("admin"),
+			Role: helper.Pointer(model.UserRoleAdmin),
+		})
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Len(t, res.Items, 2)
+
+		collectionMock.AssertExpectations(t)
+	})
+}
+
+func TestCollection_AddItem(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnErrorUnauthorized_WhenCollectionIsNotTheUserOne", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		collectionMock := &mocks.Collection{}
+		collectionMock.On("Get", mock.Anything, "collection").Return(&model.Collection{
+			Id:     helper.Pointer("collection"),
+			UserId: helper.Pointer("author"),
+		}, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetCollectionRepo(collectionMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewCollection(&appContainer)
+		res, err := uc.AddItem(context.Background(), helper.Pointer("collection"), helper.Pointer("other"), helper.Pointer("news"), nil)
+
+		// EXPECTATION
+		var e model.Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, model.ErrorUnauthorized, e.Code)
+		require.Nil(t, res)
+
+		collectionMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnErrorUnauthorized_WhenNewsIsNotTheAuthorOne", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, nil)
+		collectionMock := &mocks.Collection{}
+		collectionMock.On("Get", mock.Anything, "collection").Return(&model.Collection{
+			Id:     helper.Pointer("collection"),
+			UserId: helper.Pointer("author"),
+		}, nil).Once()
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeNews.Id).Return(&fakeNews, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetCollectionRepo(collectionMock)
+		appContainer.SetNewsRepo(newsMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewCollection(&appContainer)
+		res, err := uc.AddItem(context.Background(), helper.Pointer("collection"), helper.Pointer("author"), fakeNews.Id, nil)
+
+		// EXPECTATION
+		var e model.Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, model.ErrorUnauthorized, e.Code)
+		require.Nil(t, res)
+
+		collectionMock.AssertExpectations(t)
+		newsMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldAddTheNewsAtThePosition", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.UserId = helper.Pointer("author")
+			return news
+		})
+		updated := &model.Collection{
+			Id:      helper.Pointer("collection"),
+			UserId:  helper.Pointer("author"),
+			Version: helper.Pointer(1),
+		}
+		collectionMock := &mocks.Collection{}
+		collectionMock.On("Get", mock.Anything, "collection").Return(&model.Collection{
+			Id:     helper.Pointer("collection"),
+			UserId: helper.Pointer("author"),
+		}, nil).Once()
+		collectionMock.On("AddItem", mock.Anything, "collection", *fakeNews.Id, helper.Pointer(0)).Return(updated, nil).Once()
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeNews.Id).Return(&fakeNews, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetCollectionRepo(collectionMock)
+		appContainer.SetNewsRepo(newsMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewCollection(&appContainer)
+		res, err := uc.AddItem(context.Background(), helper.Pointer("collection"), helper.Pointer("author"), fakeNews.Id, helper.Pointer(0))
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, updated, res)
+
+		collectionMock.AssertExpectations(t)
+		newsMock.AssertExpectations(t)
+	})
+}
+
+func TestCollection_Reorder(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnError_WhenNewsIsListedTwice", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		appContainer := container.Container{}
+
+		// CODE UNDER TEST
+		uc := usecase.NewCollection(&appContainer)
+		res, err := uc.Reorder(context.Background(), helper.Pointer("collection"), helper.Pointer("author"), 1, []string{"a", "b", "a"})
+
+		// EXPECTATION
+		require.True(t, model.IsParameterError(err))
+		require.Nil(t, res)
+	})
+
+	t.Run("ShouldReturnDuplicateError_WhenVersionIsNotTheCurrentOne", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		collectionMock := &mocks.Collection{}
+		collectionMock.On("Get", mock.Anything, "collection").Return(&model.Collection{
+			Id:     helper.Pointer("collection"),
+			UserId: helper.Pointer("author"),
+		}, nil).Once()
+		collectionMock.On("Reorder", mock.Anything, "collection", 1, []string{"b", "a"}).
+			Return(nil, model.NewError("the collection was changed, reload it and retry", model.ErrorDuplicate)).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetCollectionRepo(collectionMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewCollection(&appContainer)
+		res, err := uc.Reorder(context.Background(), helper.Pointer("collection"), helper.Pointer("author"), 1, []string{"b", "a"})
+
+		// EXPECTATION
+		require.True(t, model.IsDuplicateError(err))
+		require.Nil(t, res)
+
+		collectionMock.AssertExpectations(t)
+	})
+}
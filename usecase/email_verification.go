@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"tempo/config"
+	"tempo/container"
+	"tempo/event"
+	"tempo/helper"
+	"tempo/mailer"
+	"tempo/model"
+	"tempo/ratelimit"
+	"tempo/repository"
+)
+
+type EmailVerification struct {
+	userRepo      repository.User
+	eventBus      event.Bus
+	mailer        mailer.Mailer
+	site          config.SiteConfig
+	secret        string
+	lifetime      time.Duration
+	path          string
+	resendLimiter *ratelimit.Limiter
+}
+
+// verificationClaims is the payload of a verification token, binding it to the email it was sent to
+type verificationClaims struct {
+	Subject   string `json:"sub"`
+	Email     string `json:"email"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+func NewEmailVerification(c *container.Container) *EmailVerification {
+	cfg := c.Config()
+	return &EmailVerification{
+		userRepo: c.UserRepo(),
+		eventBus: c.EventBus(),
+		mailer:   c.Mailer(),
+		site:     cfg.Site,
+		secret:   cfg.EmailVerification.Secret,
+		lifetime: time.Duration(cfg.EmailVerification.TokenHours) * time.Hour,
+		path:     cfg.EmailVerification.Path,
+		resendLimiter: ratelimit.New(c.RateLimitRepo(), "email-verification-resend", cfg.EmailVerification.ResendLimit,
+			time.Duration(cfg.EmailVerification.ResendWindowSeconds)*time.Second),
+	}
+}
+
+// Subscribe register the sending of the resent verification emails on the event bus
+func (e *EmailVerification) Subscribe(bus event.Bus) {
+	bus.Subscribe(model.EventEmailVerificationRequested, func(ctx context.Context, ev model.Event) error {
+		email, ok := ev.Data.(string)
+		if !ok {
+			return fmt.Errorf("unexpected %s payload %T", ev.Type, ev.Data)
+		}
+		return e.SendToEmail(ctx, email)
+	})
+}
+
+// Send email the user a link to verify the email, unless it is verified already
+func (e *EmailVerification) Send(ctx context.Context, user *model.User) error {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.EmailVerification.Send")
+
+	if user.IsEmailVerified() {
+		return nil
+	}
+	if e.mailer == nil {
+		err := errors.New("no mailer")
+		logger.WithError(err).Error("Failed send verification email")
+		return err
+	}
+
+	token, err := e.sign(verificationClaims{
+		Subject:   *user.Id,
+		Email:     *user.Email,
+		ExpiresAt: time.Now().Add(e.lifetime).Unix(),
+	})
+	if err != nil {
+		logger.WithError(err).Error("Failed sign verification token")
+		return err
+	}
+
+	err = e.mailer.Send(ctx, mailer.Message{
+		To:      *user.Email,
+		Subject: "Verify your email",
+		Body: fmt.Sprintf("Hi %s,\n\nOpen the link below to verify your email, it expires in %d hours:\n\n%s\n\n"+
+			"If you did not create an account, ignore this email.\n", helper.Val(user.FullName),
+			int(e.lifetime.Hours()), e.site.Url(fmt.Sprintf(e.path, url.QueryEscape(token)))),
+	})
+	if err != nil {
+		logger.WithError(err).Warning("Failed send verification email")
+		return err
+	}
+
+	return nil
+}
+
+// Resend the verification email of the address. Only the rate limit, which every address counts against, is checked
+// here: the user is looked up and mailed in the background, so an unknown or verified address get the same response
+// in the same time and the resend can't list the registered emails
+func (e *EmailVerification) Resend(ctx context.Context, email string) error {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.EmailVerification.Resend")
+
+	if err := e.resendLimiter.Allow(ctx, strings.ToLower(email)); err != nil {
+		logger.WithError(err).Warning("Resend not allowed")
+		return err
+	}
+
+	if e.eventBus == nil {
+		go func(ctx context.Context) {
+			_ = e.SendToEmail(ctx, email)
+		}(helper.DetachContext(ctx))
+		return nil
+	}
+	e.eventBus.Publish(ctx, event.New(model.EventEmailVerificationRequested, email))
+
+	return nil
+}
+
+// SendToEmail email a verification link to the user of the email, when there is one who is not verified yet
+func (e *EmailVerification) SendToEmail(ctx context.Context, email string) error {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.EmailVerification.SendToEmail")
+
+	user, err := e.userRepo.Get(ctx, repository.UserGetFilter{Email: &email})
+	if err != nil {
+		if model.IsNotFoundError(err) {
+			return nil
+		}
+		logger.WithError(err).Warning("Failed get User")
+		return err
+	}
+
+	return e.Send(ctx, user)
+}
+
+// Verify the email of the token, which is rejected once expired or when the user has another email now
+func (e *EmailVerification) Verify(ctx context.Context, token string) (*model.User, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.EmailVerification.Verify")
+
+	invalid := model.NewBadRequestError(helper.Pointer("invalid or expired token"))
+	claims, err := e.parse(token)
+	if err != nil {
+		logger.WithError(err).Warning("Invalid verification token")
+		return nil, invalid
+	}
+	if time.Now().Unix() > claims.ExpiresAt {
+		logger.Warning("Expired verification token")
+		return nil, invalid
+	}
+
+	res, err := e.userRepo.VerifyEmail(ctx, claims.Subject, claims.Email)
+	if err != nil {
+		if model.IsNotFoundError(err) {
+			logger.Warning("Verification token of a previous email")
+			return nil, invalid
+		}
+		logger.WithError(err).Warning("Failed verify User email")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// sign return the token as the base64url payload and its HMAC-SHA256, the purpose being signed too so the token can't
+// be used where the same secret sign something else
+func (e *EmailVerification) sign(claims verificationClaims) (string, error) {
+	if e.secret == "" {
+		return "", errors.New("no email verification secret")
+	}
+
+	b, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(b)
+
+	return payload + "." + helper.HmacSha256(e.secret, []byte("email-verification."+payload)), nil
+}
+
+func (e *EmailVerification) parse(token string) (*verificationClaims, error) {
+	if e.secret == "" {
+		return nil, errors.New("no email verification secret")
+	}
+
+	payload, signature, found := strings.Cut(token, ".")
+	if !found {
+		return nil, errors.New("malformed token")
+	}
+	expected := helper.HmacSha256(e.secret, []byte("email-verification."+payload))
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return nil, errors.New("invalid signature")
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, err
+	}
+	var claims verificationClaims
+	if err = json.Unmarshal(b, &claims); err != nil {
+		return nil, err
+	}
+
+	return &claims, nil
+}
@@ -0,0 +1,266 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"tempo/config"
+	"tempo/container"
+	"tempo/event"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+	"tempo/usecase"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func emailVerificationContainer(userMock *mocks.User, rateLimitMock *mocks.RateLimit, mailer *test.Mailer, tokenHours int) *container.Container {
+	cfg := config.Config{JwtSecret: "secret"}
+	cfg.EmailVerification.Secret = "email-verification-secret"
+	cfg.Site.BaseUrl = "https://tempo.dev"
+	cfg.EmailVerification.TokenHours = tokenHours
+	cfg.EmailVerification.Path = "/verify-email?token=%s"
+	cfg.EmailVerification.ResendLimit = 3
+	cfg.EmailVerification.ResendWindowSeconds = 3600
+
+	appContainer := container.Container{}
+	appContainer.SetConfig(cfg)
+	appContainer.SetUserRepo(userMock)
+	appContainer.SetRateLimitRepo(rateLimitMock)
+	appContainer.SetMailer(mailer)
+
+	return &appContainer
+}
+
+// mailedToken return the verification token of the link in the last message sent
+func mailedToken(t *testing.T, mailer *test.Mailer) string {
+	t.Helper()
+
+	messages := mailer.Messages()
+	require.NotEmpty(t, messages)
+	_, link, found := strings.Cut(messages[len(messages)-1].Body, "https://tempo.dev/verify-email?token=")
+	require.True(t, found)
+	token, err := url.QueryUnescape(strings.Fields(link)[0])
+	require.NoError(t, err)
+
+	return token
+}
+
+// resend run Resend with the verification emails sent by an event bus, which is drained before returning
+func resend(appContainer *container.Container, email string) error {
+	bus := event.NewAsyncBus(1, 10)
+	appContainer.SetEventBus(bus)
+	uc := usecase.NewEmailVerification(appContainer)
+	uc.Subscribe(bus)
+	bus.Start()
+	defer bus.Close()
+
+	return uc.Resend(context.Background(), email)
+}
+
+func TestEmailVerification_Send(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldMailTheVerificationLink", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		mailer := &test.Mailer{}
+		fakeUser := test.FakeUser(t, nil)
+
+		// CODE UNDER TEST
+		uc := usecase.NewEmailVerification(emailVerificationContainer(&mocks.User{}, &mocks.RateLimit{}, mailer, 48))
+		err := uc.Send(context.Background(), &fakeUser)
+
+		// EXPECTATION
+		require.NoError(t, err)
+		messages := mailer.Messages()
+		require.Len(t, messages, 1)
+		require.Equal(t, *fakeUser.Email, messages[0].To)
+		require.Equal(t, "Verify your email", messages[0].Subject)
+		require.Contains(t, messages[0].Body, "it expires in 48 hours")
+		require.NotEmpty(t, mailedToken(t, mailer))
+	})
+
+	t.Run("ShouldNotMail_WhenEmailIsVerified", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		mailer := &test.Mailer{}
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.EmailVerifiedAt = helper.Pointer(time.Now())
+			return user
+		})
+
+		// CODE UNDER TEST
+		uc := usecase.NewEmailVerification(emailVerificationContainer(&mocks.User{}, &mocks.RateLimit{}, mailer, 48))
+		err := uc.Send(context.Background(), &fakeUser)
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Empty(t, mailer.Messages())
+	})
+}
+
+func TestEmailVerification_Verify(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldVerifyTheEmailOfTheToken", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		mailer := &test.Mailer{}
+		fakeUser := test.FakeUser(t, nil)
+		verified := fakeUser
+		verified.EmailVerifiedAt = helper.Pointer(time.Now())
+
+		userMock := &mocks.User{}
+		userMock.On("VerifyEmail", mock.Anything, *fakeUser.Id, *fakeUser.Email).Return(&verified, nil).Once()
+
+		uc := usecase.NewEmailVerification(emailVerificationContainer(userMock, &mocks.RateLimit{}, mailer, 48))
+		require.NoError(t, uc.Send(context.Background(), &fakeUser))
+
+		// CODE UNDER TEST
+		res, err := uc.Verify(context.Background(), mailedToken(t, mailer))
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.True(t, res.IsEmailVerified())
+
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnErrorBadRequest_WhenTokenIsTampered", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		mailer := &test.Mailer{}
+		fakeUser := test.FakeUser(t, nil)
+		userMock := &mocks.User{}
+
+		uc := usecase.NewEmailVerification(emailVerificationContainer(userMock, &mocks.RateLimit{}, mailer, 48))
+		require.NoError(t, uc.Send(context.Background(), &fakeUser))
+		_, signature, _ := strings.Cut(mailedToken(t, mailer), ".")
+		other := `{"sub":"other","email":"other@gmail.com","exp":4102444800}`
+
+		// CODE UNDER TEST
+		res, err := uc.Verify(context.Background(), base64.RawURLEncoding.EncodeToString([]byte(other))+"."+signature)
+
+		// EXPECTATION
+		require.True(t, model.IsBadRequestError(err))
+		require.Nil(t, res)
+
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnErrorBadRequest_WhenTokenIsExpired", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		mailer := &test.Mailer{}
+		fakeUser := test.FakeUser(t, nil)
+		userMock := &mocks.User{}
+
+		uc := usecase.NewEmailVerification(emailVerificationContainer(userMock, &mocks.RateLimit{}, mailer, -1))
+		require.NoError(t, uc.Send(context.Background(), &fakeUser))
+
+		// CODE UNDER TEST
+		res, err := uc.Verify(context.Background(), mailedToken(t, mailer))
+
+		// EXPECTATION
+		require.True(t, model.IsBadRequestError(err))
+		require.Nil(t, res)
+
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnErrorBadRequest_WhenTheEmailChanged", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		mailer := &test.Mailer{}
+		fakeUser := test.FakeUser(t, nil)
+		userMock := &mocks.User{}
+		userMock.On("VerifyEmail", mock.Anything, *fakeUser.Id, *fakeUser.Email).Return(nil, model.NewNotFoundError()).Once()
+
+		uc := usecase.NewEmailVerification(emailVerificationContainer(userMock, &mocks.RateLimit{}, mailer, 48))
+		require.NoError(t, uc.Send(context.Background(), &fakeUser))
+
+		// CODE UNDER TEST
+		res, err := uc.Verify(context.Background(), mailedToken(t, mailer))
+
+		// EXPECTATION
+		require.True(t, model.IsBadRequestError(err))
+		require.Nil(t, res)
+
+		userMock.AssertExpectations(t)
+	})
+}
+
+func TestEmailVerification_Resend(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnErrorTooManyRequests_WhenTheLimitIsExceeded", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		mailer := &test.Mailer{}
+		userMock := &mocks.User{}
+		rateLimitMock := &mocks.RateLimit{}
+		rateLimitMock.On("Hit", mock.Anything, "email-verification-resend:"+helper.Sha256("email@gmail.com"), time.Hour).
+			Return(4, nil).Once()
+
+		// CODE UNDER TEST
+		err := resend(emailVerificationContainer(userMock, rateLimitMock, mailer, 48), "Email@gmail.com")
+
+		// EXPECTATION
+		var e model.Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, model.ErrorTooManyRequests, e.Code)
+		require.Empty(t, mailer.Messages())
+
+		userMock.AssertExpectations(t)
+		rateLimitMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldSucceedWithoutMail_WhenEmailIsUnknown", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		mailer := &test.Mailer{}
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Email: helper.Pointer("unknown@gmail.com")}).
+			Return(nil, model.NewNotFoundError()).Once()
+		rateLimitMock := &mocks.RateLimit{}
+		rateLimitMock.On("Hit", mock.Anything, mock.Anything, time.Hour).Return(1, nil).Once()
+
+		// CODE UNDER TEST
+		err := resend(emailVerificationContainer(userMock, rateLimitMock, mailer, 48), "unknown@gmail.com")
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Empty(t, mailer.Messages())
+
+		userMock.AssertExpectations(t)
+		rateLimitMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldMailTheLink_WhenEmailIsNotVerified", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		mailer := &test.Mailer{}
+		fakeUser := test.FakeUser(t, nil)
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Email: fakeUser.Email}).Return(&fakeUser, nil).Once()
+		rateLimitMock := &mocks.RateLimit{}
+		rateLimitMock.On("Hit", mock.Anything, mock.Anything, time.Hour).Return(1, nil).Once()
+
+		// CODE UNDER TEST
+		err := resend(emailVerificationContainer(userMock, rateLimitMock, mailer, 48), *fakeUser.Email)
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Len(t, mailer.Messages(), 1)
+		require.Equal(t, *fakeUser.Email, mailer.Messages()[0].To)
+
+		userMock.AssertExpectations(t)
+		rateLimitMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	"tempo/event"
+	"tempo/model"
+)
+
+// publish emit the event when the container has an event bus, which is only the case for long running commands
+func publish(ctx context.Context, bus event.Bus, eventType model.EventType, data interface{}) {
+	if bus == nil {
+		return
+	}
+
+	bus.Publish(ctx, event.New(eventType, data))
+}
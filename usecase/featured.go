@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+)
+
+// maxFeaturedItems bound the number of news pinned to a slot
+const maxFeaturedItems = 20
+
+type Featured struct {
+	repository.Featured
+	newsRepo repository.News
+}
+
+func NewFeatured(f *container.Container) *Featured {
+	return &Featured{
+		Featured: f.FeaturedRepo(),
+		newsRepo: f.NewsRepo(),
+	}
+}
+
+// Set replace the news pinned to the slot by the items, in their order
+func (f *Featured) Set(ctx context.Context, slot string, items []model.FeaturedItem, userId *string) ([]model.FeaturedItem, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Featured.Set")
+
+	if userId == nil {
+		logger.Error("missing user id")
+		return nil, model.NewParameterError(helper.Pointer("missing user id"))
+	}
+	if err := model.ValidateFeaturedSlot(slot); err != nil {
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, model.NewParameterError(helper.Pointer("slot: " + err.Error()))
+	}
+	if len(items) > maxFeaturedItems {
+		err := model.NewParameterError(helper.Pointer(fmt.Sprintf("a slot hold at most %d news", maxFeaturedItems)))
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, err
+	}
+
+	seen := make(map[string]struct{}, len(items))
+	for _, v := range items {
+		if err := v.Validate(); err != nil {
+			logger.WithError(err).Warning("Not Valid Request")
+			return nil, model.NewParameterError(helper.Pointer(err.Error()))
+		}
+		if _, ok := seen[*v.NewsId]; ok {
+			err := model.NewParameterError(helper.Pointer(fmt.Sprintf("news %s is pinned twice", *v.NewsId)))
+			logger.WithError(err).Warning("Not Valid Request")
+			return nil, err
+		}
+		seen[*v.NewsId] = struct{}{}
+
+		if _, err := f.newsRepo.Get(ctx, v.NewsId); err != nil {
+			if model.IsNotFoundError(err) {
+				err = model.NewParameterError(helper.Pointer(fmt.Sprintf("news %s is not found", *v.NewsId)))
+			}
+			logger.WithError(err).Warning("Failed get News")
+			return nil, err
+		}
+	}
+
+	res, err := f.Featured.Replace(ctx, slot, items, *userId)
+	if err != nil {
+		logger.WithError(err).Warning("Failed replace FeaturedItem")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Get return the news currently shown in the slot
+func (f *Featured) Get(ctx context.Context, slot string) ([]model.FeaturedNews, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Featured.Get")
+
+	if err := model.ValidateFeaturedSlot(slot); err != nil {
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, model.NewParameterError(helper.Pointer("slot: " + err.Error()))
+	}
+
+	res, err := f.Featured.ListNews(ctx, repository.FeaturedNewsFilter{
+		Slot: slot,
+		At:   time.Now(),
+	})
+	if err != nil {
+		logger.WithError(err).Warning("Failed list FeaturedNews")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ListAudits return a page of the changes made to the slot, the latest first
+func (f *Featured) ListAudits(ctx context.Context, slot string, cursor *string, limit int) ([]model.FeaturedAudit, *string, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Featured.ListAudits")
+
+	if err := model.ValidateFeaturedSlot(slot); err != nil {
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, nil, model.NewParameterError(helper.Pointer("slot: " + err.Error()))
+	}
+
+	beforeCreatedAt, beforeId, err := decodeCursor(cursor)
+	if err != nil {
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, nil, err
+	}
+	limit = pageLimit(limit)
+
+	res, err := f.Featured.ListAudits(ctx, repository.FeaturedAuditFilter{
+		Slot:            slot,
+		BeforeCreatedAt: beforeCreatedAt,
+		BeforeId:        beforeId,
+		Limit:           limit + 1,
+	})
+	if err != nil {
+		logger.WithError(err).Warning("Failed list FeaturedAudit")
+		return nil, nil, err
+	}
+
+	var next *string
+	if len(res) > limit {
+		res = res[:limit]
+		last := res[limit-1]
+		next = helper.Pointer(helper.EncodeCursor(*last.CreatedAt, *last.Id))
+	}
+
+	return res, next, nil
+}
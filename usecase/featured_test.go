@@ -0,0 +1,154 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository/mocks"
+	"tempo/usecase"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeatured_Set(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnError_WhenSlotIsInvalid", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		appContainer := container.Container{}
+
+		// CODE UNDER TEST
+		uc := usecase.NewFeatured(&appContainer)
+		res, err := uc.Set(context.Background(), "Home Page", nil, helper.Pointer("admin"))
+
+		// EXPECTATION
+		require.True(t, model.IsParameterError(err))
+		require.Nil(t, res)
+	})
+
+	t.Run("ShouldReturnError_WhenNewsIsPinnedTwice", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, helper.Pointer("news")).Return(&model.News{}, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsRepo(newsMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewFeatured(&appContainer)
+		res, err := uc.Set(context.Background(), "sidebar", []model.FeaturedItem{
+			{NewsId: helper.Pointer("news")},
+			{NewsId: helper.Pointer("news")},
+		}, helper.Pointer("admin"))
+
+		// EXPECTATION
+		require.True(t, model.IsParameterError(err))
+		require.EqualError(t, err, "news news is pinned twice")
+		require.Nil(t, res)
+
+		newsMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnError_WhenExpiryIsInThePast", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		appContainer := container.Container{}
+
+		// CODE UNDER TEST
+		uc := usecase.NewFeatured(&appContainer)
+		res, err := uc.Set(context.Background(), "sidebar", []model.FeaturedItem{
+			{NewsId: helper.Pointer("news"), ExpiresAt: helper.Pointer(time.Now().Add(-time.Hour))},
+		}, helper.Pointer("admin"))
+
+		// EXPECTATION
+		require.True(t, model.IsParameterError(err))
+		require.Nil(t, res)
+	})
+
+	t.Run("ShouldReturnParameterError_WhenNewsIsNotFound", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, helper.Pointer("missing")).Return(nil, model.NewNotFoundError()).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsRepo(newsMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewFeatured(&appContainer)
+		res, err := uc.Set(context.Background(), "sidebar", []model.FeaturedItem{
+			{NewsId: helper.Pointer("missing")},
+		}, helper.Pointer("admin"))
+
+		// EXPECTATION
+		require.True(t, model.IsParameterError(err))
+		require.EqualError(t, err, "news missing is not found")
+		require.Nil(t, res)
+
+		newsMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReplaceTheItemsOfTheSlot", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, nil)
+		items := []model.FeaturedItem{{NewsId: fakeNews.Id}}
+		replaced := []model.FeaturedItem{{
+			Slot:     helper.Pointer("homepage_hero"),
+			NewsId:   fakeNews.Id,
+			Position: helper.Pointer(0),
+			PinnedBy: helper.Pointer("admin"),
+		}}
+
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeNews.Id).Return(&fakeNews, nil).Once()
+		featuredMock := &mocks.Featured{}
+		featuredMock.On("Replace", mock.Anything, "homepage_hero", items, "admin").Return(replaced, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsRepo(newsMock)
+		appContainer.SetFeaturedRepo(featuredMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewFeatured(&appContainer)
+		res, err := uc.Set(context.Background(), "homepage_hero", items, helper.Pointer("admin"))
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, replaced, res)
+
+		newsMock.AssertExpectations(t)
+		featuredMock.AssertExpectations(t)
+	})
+}
+
+func TestFeatured_Get(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnError_WhenErrorListNews", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		featuredMock := &mocks.Featured{}
+		featuredMock.On("ListNews", mock.Anything, mock.Anything).Return(nil, errors.New("error list")).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetFeaturedRepo(featuredMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewFeatured(&appContainer)
+		res, err := uc.Get(context.Background(), "sidebar")
+
+		// EXPECTATION
+		require.EqualError(t, err, "error list")
+		require.Nil(t, res)
+
+		featuredMock.AssertExpectations(t)
+	})
+}
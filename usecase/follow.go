@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+)
+
+// feedBatchSize is how many followed authors are queried at once when building the feed
+const feedBatchSize = 500
+
+type Follow struct {
+	repository.Follow
+	userRepo repository.User
+	newsRepo repository.News
+}
+
+func NewFollow(f *container.Container) *Follow {
+	return &Follow{
+		Follow:   f.FollowRepo(),
+		userRepo: f.UserRepo(),
+		newsRepo: f.NewsRepo(),
+	}
+}
+
+func (f *Follow) Add(ctx context.Context, req *model.Follow) (*model.Follow, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Follow.Add")
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, model.NewParameterError(helper.Pointer(err.Error()))
+	}
+	if *req.FollowerId == *req.FolloweeId {
+		err := model.NewParameterError(helper.Pointer("cannot follow yourself"))
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, err
+	}
+
+	if _, err := f.userRepo.Get(ctx, repository.UserGetFilter{Id: req.FolloweeId}); err != nil {
+		logger.WithError(err).Warning("Failed get User")
+		return nil, err
+	}
+
+	res, err := f.Follow.Add(ctx, req)
+	if err != nil {
+		logger.WithError(err).Warning("Failed insert Follow")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (f *Follow) Delete(ctx context.Context, followerId *string, followeeId *string) error {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Follow.Delete")
+
+	if followerId == nil || followeeId == nil {
+		logger.Error("missing follower id or followee id")
+		return model.NewParameterError(helper.Pointer("missing follower id or followee id"))
+	}
+
+	if err := f.Follow.Delete(ctx, *followerId, *followeeId); err != nil {
+		logger.WithError(err).Warning("Failed delete Follow")
+		return err
+	}
+
+	return nil
+}
+
+// List return a page of follows, newest first, and the cursor of the next page if there is one
+func (f *Follow) List(ctx context.Context, filter repository.FollowListFilter, cursor *string) ([]model.Follow, *string, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Follow.List")
+
+	if filter.FollowerId == nil && filter.FolloweeId == nil {
+		logger.Error("missing follower id or followee id")
+		return nil, nil, model.NewParameterError(helper.Pointer("missing follower id or followee id"))
+	}
+
+	afterCreatedAt, afterId, err := decodeCursor(cursor)
+	if err != nil {
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, nil, err
+	}
+	limit := pageLimit(filter.Limit)
+	filter.AfterCreatedAt = afterCreatedAt
+	filter.AfterId = afterId
+	filter.Limit = limit + 1
+
+	res, err := f.Follow.List(ctx, filter)
+	if err != nil {
+		logger.WithError(err).Warning("Failed list Follow")
+		return nil, nil, err
+	}
+
+	var next *string
+	if len(res) > limit {
+		res = res[:limit]
+		last := res[limit-1]
+		next = helper.Pointer(helper.EncodeCursor(*last.CreatedAt, *last.Id))
+	}
+
+	return res, next, nil
+}
+
+// Feed return the most recent news of the authors followed by the user.
+// The feed is built on read: the followed authors are queried in batches, each batch returning at most one page,
+// and every batch is merged into the running result so no more than two pages are held in memory at once.
+func (f *Follow) Feed(ctx context.Context, userId *string, cursor *string, limit int) ([]model.News, *string, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Follow.Feed")
+
+	if userId == nil {
+		logger.Error("missing user id")
+		return nil, nil, model.NewParameterError(helper.Pointer("missing user id"))
+	}
+
+	beforePublishedAt, beforeId, err := decodeCursor(cursor)
+	if err != nil {
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, nil, err
+	}
+	limit = pageLimit(limit)
+
+	followeeIds, err := f.Follow.GetFolloweeIds(ctx, *userId)
+	if err != nil {
+		logger.WithError(err).Warning("Failed get followee ids")
+		return nil, nil, err
+	}
+
+	res := []model.News{}
+	for start := 0; start < len(followeeIds); start += feedBatchSize {
+		end := start + feedBatchSize
+		if end > len(followeeIds) {
+			end = len(followeeIds)
+		}
+
+		batch, err := f.newsRepo.List(ctx, repository.NewsListFilter{
+			UserIds:           followeeIds[start:end],
+			BeforePublishedAt: beforePublishedAt,
+			BeforeId:          beforeId,
+			Limit:             limit + 1,
+		})
+		if err != nil {
+			logger.WithError(err).Warning("Failed list News")
+			return nil, nil, err
+		}
+
+		res = mergeNewsByPublishedAt(res, batch, limit+1)
+	}
+
+	var next *string
+	if len(res) > limit {
+		res = res[:limit]
+		last := res[limit-1]
+		next = helper.Pointer(helper.EncodeCursor(*last.PublishedAt, *last.Id))
+	}
+
+	return res, next, nil
+}
+
+// mergeNewsByPublishedAt merge two lists sorted from the newest and keep at most limit items
+func mergeNewsByPublishedAt(a []model.News, b []model.News, limit int) []model.News {
+	res := append(a, b...)
+	sort.SliceStable(res, func(i, j int) bool {
+		if !res[i].PublishedAt.Equal(*res[j].PublishedAt) {
+			return res[i].PublishedAt.After(*res[j].PublishedAt)
+		}
+		return *res[i].Id > *res[j].Id
+	})
+	if len(res) > limit {
+		res = res[:limit]
+	}
+
+	return res
+}
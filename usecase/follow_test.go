@@ -0,0 +1,196 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+	"tempo/usecase"
+
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFollow_Add(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnError_WhenFollowingYourself", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		appContainer := container.Container{}
+		userId := helper.Pointer(fake.CharactersN(7))
+
+		// CODE UNDER TEST
+		uc := usecase.NewFollow(&appContainer)
+		res, err := uc.Add(context.Background(), &model.Follow{
+			FollowerId: userId,
+			FolloweeId: userId,
+		})
+		require.Error(t, err)
+		require.True(t, model.IsParameterError(err))
+		require.Nil(t, res)
+	})
+
+	t.Run("ShouldReturnNotFound_WhenFolloweeIsNotExist", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		req := model.Follow{
+			FollowerId: helper.Pointer(fake.CharactersN(7)),
+			FolloweeId: helper.Pointer(fake.CharactersN(7)),
+		}
+
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{
+			Id: req.FolloweeId,
+		}).Return(nil, model.NewNotFoundError()).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetUserRepo(userMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewFollow(&appContainer)
+		res, err := uc.Add(context.Background(), &req)
+		require.Error(t, err)
+		require.True(t, model.IsNotFoundError(err))
+		require.Nil(t, res)
+
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldAddFollow", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		req := model.Follow{
+			FollowerId: helper.Pointer(fake.CharactersN(7)),
+			FolloweeId: helper.Pointer(fake.CharactersN(7)),
+		}
+
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{
+			Id: req.FolloweeId,
+		}).Return(&model.User{Id: req.FolloweeId}, nil).Once()
+		followMock := &mocks.Follow{}
+		followMock.On("Add", mock.Anything, &req).Return(&model.Follow{
+			Id:         helper.Pointer(fake.CharactersN(7)),
+			FollowerId: req.FollowerId,
+			FolloweeId: req.FolloweeId,
+			CreatedAt:  helper.Pointer(time.Now()),
+		}, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetUserRepo(userMock)
+		appContainer.SetFollowRepo(followMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewFollow(&appContainer)
+		res, err := uc.Add(context.Background(), &req)
+		require.NoError(t, err)
+		require.NotNil(t, res.Id)
+		require.Equal(t, *req.FolloweeId, *res.FolloweeId)
+
+		userMock.AssertExpectations(t)
+		followMock.AssertExpectations(t)
+	})
+}
+
+func TestFollow_Feed(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnEmpty_WhenNotFollowingAnyone", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		userId := helper.Pointer(fake.CharactersN(7))
+
+		followMock := &mocks.Follow{}
+		followMock.On("GetFolloweeIds", mock.Anything, *userId).Return([]string{}, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetFollowRepo(followMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewFollow(&appContainer)
+		res, next, err := uc.Feed(context.Background(), userId, nil, 0)
+		require.NoError(t, err)
+		require.Empty(t, res)
+		require.Nil(t, next)
+
+		followMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnError_WhenFailedListNews", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		userId := helper.Pointer(fake.CharactersN(7))
+
+		followMock := &mocks.Follow{}
+		followMock.On("GetFolloweeIds", mock.Anything, *userId).Return([]string{"author"}, nil).Once()
+		newsMock := &mocks.News{}
+		newsMock.On("List", mock.Anything, mock.Anything).Return(nil, errors.New("error list")).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetFollowRepo(followMock)
+		appContainer.SetNewsRepo(newsMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewFollow(&appContainer)
+		res, next, err := uc.Feed(context.Background(), userId, nil, 0)
+		require.EqualError(t, err, "error list")
+		require.Nil(t, res)
+		require.Nil(t, next)
+	})
+
+	t.Run("ShouldMergeBatchesChronologically", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		userId := helper.Pointer(fake.CharactersN(7))
+		var followeeIds []string
+		for i := 0; i < 501; i++ {
+			followeeIds = append(followeeIds, fmt.Sprintf("author-%d", i))
+		}
+		now := time.Now().Truncate(time.Second)
+		newsAt := func(id string, minutesAgo int) model.News {
+			return model.News{
+				Id:          helper.Pointer(id),
+				PublishedAt: helper.Pointer(now.Add(-time.Duration(minutesAgo) * time.Minute)),
+			}
+		}
+
+		followMock := &mocks.Follow{}
+		followMock.On("GetFolloweeIds", mock.Anything, *userId).Return(followeeIds, nil).Once()
+		newsMock := &mocks.News{}
+		newsMock.On("List", mock.Anything, repository.NewsListFilter{
+			UserIds: followeeIds[:500],
+			Limit:   3,
+		}).Return([]model.News{newsAt("a", 1), newsAt("b", 3), newsAt("c", 5)}, nil).Once()
+		newsMock.On("List", mock.Anything, repository.NewsListFilter{
+			UserIds: followeeIds[500:],
+			Limit:   3,
+		}).Return([]model.News{newsAt("d", 2), newsAt("e", 4)}, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetFollowRepo(followMock)
+		appContainer.SetNewsRepo(newsMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewFollow(&appContainer)
+		res, next, err := uc.Feed(context.Background(), userId, nil, 2)
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		require.Equal(t, "a", *res[0].Id)
+		require.Equal(t, "d", *res[1].Id)
+		require.NotNil(t, next)
+
+		_, afterId, err := helper.DecodeCursor(*next)
+		require.NoError(t, err)
+		require.Equal(t, "d", afterId)
+
+		followMock.AssertExpectations(t)
+		newsMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+)
+
+type Moderation struct {
+	repository.Moderation
+}
+
+func NewModeration(m *container.Container) *Moderation {
+	return &Moderation{
+		Moderation: m.ModerationRepo(),
+	}
+}
+
+// List return a page of the moderation queue, oldest first
+func (m *Moderation) List(ctx context.Context, filter repository.ModerationListFilter, cursor *string) ([]model.ModerationItem, *string, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Moderation.List")
+
+	if filter.Status != nil && !filter.Status.IsValid() {
+		err := model.NewParameterError(helper.Pointer("invalid status"))
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, nil, err
+	}
+
+	afterCreatedAt, afterId, err := decodeCursor(cursor)
+	if err != nil {
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, nil, err
+	}
+	limit := pageLimit(filter.Limit)
+	filter.AfterCreatedAt = afterCreatedAt
+	filter.AfterId = afterId
+	filter.Limit = limit + 1
+
+	res, err := m.Moderation.List(ctx, filter)
+	if err != nil {
+		logger.WithError(err).Warning("Failed list ModerationItem")
+		return nil, nil, err
+	}
+
+	var next *string
+	if len(res) > limit {
+		res = res[:limit]
+		last := res[limit-1]
+		next = helper.Pointer(helper.EncodeCursor(*last.CreatedAt, *last.Id))
+	}
+
+	return res, next, nil
+}
+
+// Resolve approve the flagged news or remove it
+func (m *Moderation) Resolve(ctx context.Context, id *string, adminId *string, status model.ModerationStatus, note *string) (*model.ModerationItem, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Moderation.Resolve")
+
+	if id == nil || adminId == nil {
+		logger.Error("missing id")
+		return nil, model.NewParameterError(helper.Pointer("missing id"))
+	}
+	if status != model.ModerationApproved && status != model.ModerationRemoved {
+		err := model.NewParameterError(helper.Pointer("status must be approved or removed"))
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, err
+	}
+
+	res, err := m.Moderation.Resolve(ctx, *id, status, *adminId, note)
+	if err != nil {
+		logger.WithError(err).Warning("Failed resolve ModerationItem")
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,137 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+	"tempo/usecase"
+
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModeration_List(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnError_WhenStatusIsInvalid", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		appContainer := container.Container{}
+
+		// CODE UNDER TEST
+		uc := usecase.NewModeration(&appContainer)
+		res, next, err := uc.List(context.Background(), repository.ModerationListFilter{
+			Status: helper.Pointer(model.ModerationStatus("unknown")),
+		}, nil)
+
+		// EXPECTATION
+		require.Error(t, err)
+		require.True(t, model.IsParameterError(err))
+		require.Nil(t, res)
+		require.Nil(t, next)
+	})
+
+	t.Run("ShouldReturnNextCursor_WhenThereIsAnotherPage", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		items := []model.ModerationItem{
+			{Id: helper.Pointer(fake.CharactersN(6)), CreatedAt: helper.Pointer(time.Now())},
+			{Id: helper.Pointer(fake.CharactersN(6)), CreatedAt: helper.Pointer(time.Now())},
+		}
+		moderationMock := &mocks.Moderation{}
+		moderationMock.On("List", mock.Anything, repository.ModerationListFilter{
+			Status: helper.Pointer(model.ModerationPending),
+			Limit:  2,
+		}).Return(items, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetModerationRepo(moderationMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewModeration(&appContainer)
+		res, next, err := uc.List(context.Background(), repository.ModerationListFilter{
+			Status: helper.Pointer(model.ModerationPending),
+			Limit:  1,
+		}, nil)
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.NotNil(t, next)
+		require.Equal(t, helper.EncodeCursor(*items[0].CreatedAt, *items[0].Id), *next)
+
+		moderationMock.AssertExpectations(t)
+	})
+}
+
+func TestModeration_Resolve(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnError_WhenStatusIsPending", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		appContainer := container.Container{}
+
+		// CODE UNDER TEST
+		uc := usecase.NewModeration(&appContainer)
+		res, err := uc.Resolve(context.Background(), helper.Pointer("id"), helper.Pointer("admin"), model.ModerationPending, nil)
+
+		// EXPECTATION
+		require.Error(t, err)
+		require.True(t, model.IsParameterError(err))
+		require.Nil(t, res)
+	})
+
+	t.Run("ShouldReturnError_WhenErrorResolve", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		moderationMock := &mocks.Moderation{}
+		moderationMock.On("Resolve", mock.Anything, "id", model.ModerationRemoved, "admin", (*string)(nil)).
+			Return(nil, errors.New("error resolve")).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetModerationRepo(moderationMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewModeration(&appContainer)
+		res, err := uc.Resolve(context.Background(), helper.Pointer("id"), helper.Pointer("admin"), model.ModerationRemoved, nil)
+
+		// EXPECTATION
+		require.EqualError(t, err, "error resolve")
+		require.Nil(t, res)
+
+		moderationMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnResolvedItem", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		resolved := &model.ModerationItem{
+			Id:         helper.Pointer("id"),
+			Status:     helper.Pointer(model.ModerationApproved),
+			ResolvedBy: helper.Pointer("admin"),
+		}
+		moderationMock := &mocks.Moderation{}
+		moderationMock.On("Resolve", mock.Anything, "id", model.ModerationApproved, "admin", helper.Pointer("fine")).
+			Return(resolved, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetModerationRepo(moderationMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewModeration(&appContainer)
+		res, err := uc.Resolve(context.Background(), helper.Pointer("id"), helper.Pointer("admin"), model.ModerationApproved, helper.Pointer("fine"))
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, resolved, res)
+
+		moderationMock.AssertExpectations(t)
+	})
+}
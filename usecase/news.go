@@ -3,41 +3,126 @@ package usecase
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"tempo/container"
+	"tempo/event"
 	"tempo/helper"
 	"tempo/model"
+	"tempo/moderation"
 	"tempo/repository"
 )
 
 type News struct {
 	repository.News
+	eventBus       event.Bus
+	newsStream     *event.Stream
+	moderation     *moderation.Pipeline
+	moderationRepo repository.Moderation
+	collectionRepo repository.Collection
+	newsAuthorRepo repository.NewsAuthor
+	userRepo       repository.User
+	duplicate      duplicateConfig
+	reading        readingConfig
+	// verifiedEmail refuse the news of the users who did not verify their email
+	verifiedEmail bool
+}
+
+// maxDuplicates bound the near duplicates returned for a news
+const maxDuplicates = 20
+
+type duplicateConfig struct {
+	enabled     bool
+	maxDistance int
+	reject      bool
+}
+
+type readingConfig struct {
+	excerptLength       int
+	wordsPerMinute      int
+	charactersPerMinute int
 }
 
 func NewNews(n *container.Container) *News {
 	return &News{
-		News: n.NewsRepo(),
+		News:           n.NewsRepo(),
+		eventBus:       n.EventBus(),
+		newsStream:     n.NewsStream(),
+		moderation:     n.Moderation(),
+		moderationRepo: n.ModerationRepo(),
+		collectionRepo: n.CollectionRepo(),
+		newsAuthorRepo: n.NewsAuthorRepo(),
+		userRepo:       n.UserRepo(),
+		duplicate: duplicateConfig{
+			enabled:     n.Config().NewsDuplicate.Enabled,
+			maxDistance: n.Config().NewsDuplicate.MaxDistance,
+			reject:      n.Config().NewsDuplicate.Reject,
+		},
+		reading: readingConfig{
+			excerptLength:       n.Config().NewsReading.ExcerptLength,
+			wordsPerMinute:      n.Config().NewsReading.WordsPerMinute,
+			charactersPerMinute: n.Config().NewsReading.CharactersPerMinute,
+		},
+		verifiedEmail: n.Config().EmailVerification.RequiredForNews,
 	}
 }
 
 func (n *News) Add(ctx context.Context, req *model.News) (*model.News, error) {
 	logger := helper.GetLogger(ctx).WithField("method", "usecase.News.Add")
 
+	req.Tags = model.NormalizeTags(req.Tags)
 	if err := req.Validate(); err != nil {
 		logger.WithError(err).Warning("Not Valid Request")
 		return nil, model.NewParameterError(helper.Pointer(err.Error()))
 	}
 
+	if n.verifiedEmail {
+		user, err := n.userRepo.Get(ctx, repository.UserGetFilter{Id: req.UserId})
+		if err != nil {
+			logger.WithError(err).Warning("Failed get User")
+			return nil, err
+		}
+		if !user.IsEmailVerified() {
+			err = model.NewError("email is not verified", model.ErrorUnauthorized)
+			logger.WithError(err).Warning("Unverified email")
+			return nil, err
+		}
+	}
+
+	flags, err := n.moderate(ctx, moderation.Content{
+		AuthorId:    *req.UserId,
+		Title:       *req.Title,
+		Description: *req.Description,
+	})
+	if err != nil {
+		logger.WithError(err).Warning("News not allowed")
+		return nil, err
+	}
+
+	req.Fingerprint = helper.Pointer(req.ComputeFingerprint())
+	req.ComputeReading(n.reading.excerptLength, n.reading.wordsPerMinute, n.reading.charactersPerMinute)
+	duplicateOf, err := n.findDuplicates(ctx, *req.Fingerprint)
+	if err != nil {
+		logger.WithError(err).Warning("Failed find duplicate News")
+		return nil, err
+	}
+
 	res, err := n.News.Add(ctx, req)
 	if err != nil {
 		logger.WithError(err).Warning("Failed insert News")
 		return nil, err
 	}
+	res.DuplicateOf = duplicateOf
+	n.addBylines(ctx, res)
+	n.queueForReview(ctx, res, flags)
+	publish(ctx, n.eventBus, model.EventNewsCreated, res)
 
 	return res, nil
 }
 
-func (n *News) Get(ctx context.Context, id *string) (*model.News, error) {
+// Get return the news for the viewer, nil when anonymous. A news which is not published, as hidden after reports, is
+// only returned to its authors and to the admins, the others get a not found error
+func (n *News) Get(ctx context.Context, id *string, viewer *model.User) (*model.News, error) {
 	logger := helper.GetLogger(ctx).WithField("method", "usecase.News.Get")
 
 	if id == nil {
@@ -51,23 +136,298 @@ func (n *News) Get(ctx context.Context, id *string) (*model.News, error) {
 		logger.WithError(err).Warning("Failed get News")
 		return nil, err
 	}
+	if user.PublishedAt == nil {
+		canRead, err := n.canReadUnpublished(ctx, user, viewer)
+		if err != nil {
+			logger.WithError(err).Warning("Failed get NewsAuthor")
+			return nil, err
+		}
+		if !canRead {
+			err = model.NewNotFoundError()
+			logger.WithError(err).Warning("News is not published")
+			return nil, err
+		}
+	}
+
+	n.addBylines(ctx, user)
+	if n.collectionRepo != nil {
+		user.Series, err = n.collectionRepo.ListSeries(ctx, *id)
+		if err != nil {
+			logger.WithError(err).Warning("Failed list NewsSeries")
+			return nil, err
+		}
+	}
 
 	return user, nil
 }
 
-func (n *News) Update(ctx context.Context, id *string, req *model.News) (*model.News, error) {
+func (n *News) canReadUnpublished(ctx context.Context, news *model.News, viewer *model.User) (bool, error) {
+	if viewer == nil || viewer.Id == nil {
+		return false, nil
+	}
+	if viewer.HasRole(model.UserRoleAdmin) || helper.Val(news.UserId) == *viewer.Id {
+		return true, nil
+	}
+	if n.newsAuthorRepo == nil {
+		return false, nil
+	}
+
+	author, err := n.newsAuthorRepo.Get(ctx, *news.Id, *viewer.Id)
+	if err != nil {
+		if model.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return author != nil, nil
+}
+
+// Update the news on behalf of the user, who must be one of its authors or editors
+func (n *News) Update(ctx context.Context, id *string, userId *string, req *model.News) (*model.News, error) {
 	logger := helper.GetLogger(ctx).WithField("method", "usecase.News.Update")
 
-	if id == nil {
+	if id == nil || userId == nil {
 		logger.Error("missing id")
 		return nil, model.NewParameterError(helper.Pointer("missing id"))
 	}
 
+	req.Tags = model.NormalizeTags(req.Tags)
+	if err := req.ValidateTags(); err != nil {
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, model.NewParameterError(helper.Pointer(err.Error()))
+	}
+
+	author, err := n.newsAuthorRepo.Get(ctx, *id, *userId)
+	if err != nil && !model.IsNotFoundError(err) {
+		logger.WithError(err).Warning("Failed get NewsAuthor")
+		return nil, err
+	}
+	if author == nil || !helper.Val(author.Role).CanEdit() {
+		err = model.NewError("only the authors and editors can update the news", model.ErrorUnauthorized)
+		logger.WithError(err).Warning("Not allowed")
+		return nil, err
+	}
+
+	var flags []model.ModerationResult
+	if n.moderation != nil {
+		existing, err := n.News.Get(ctx, id)
+		if err != nil {
+			logger.WithError(err).Warning("Failed get News")
+			return nil, err
+		}
+
+		content := moderation.Content{
+			AuthorId:    helper.Val(existing.UserId),
+			Title:       helper.Val(existing.Title),
+			Description: helper.Val(existing.Description),
+		}
+		if req.Title != nil {
+			content.Title = *req.Title
+		}
+		if req.Description != nil {
+			content.Description = *req.Description
+		}
+
+		flags, err = n.moderate(ctx, content)
+		if err != nil {
+			logger.WithError(err).Warning("News not allowed")
+			return nil, err
+		}
+	}
+
+	if req.Description != nil {
+		req.ComputeReading(n.reading.excerptLength, n.reading.wordsPerMinute, n.reading.charactersPerMinute)
+	}
+
 	res, err := n.News.Update(ctx, id, req)
 	if err != nil {
 		logger.WithError(err).Warning("Failed update News")
 		return nil, err
 	}
+	n.addBylines(ctx, res)
+	n.queueForReview(ctx, res, flags)
+	publish(ctx, n.eventBus, model.EventNewsUpdated, res)
 
 	return res, nil
 }
+
+// BackfillReading compute the reading metadata of the news missing them, or of every news when all is set, and return
+// how many were updated
+func (n *News) BackfillReading(ctx context.Context, all bool, batchSize int) (int, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.News.BackfillReading")
+
+	total := 0
+	var afterId *string
+	for {
+		news, err := n.News.ListBackfill(ctx, repository.NewsBackfillFilter{
+			AfterId:        afterId,
+			MissingReading: !all,
+			Limit:          batchSize,
+		})
+		if err != nil {
+			logger.WithError(err).Warning("Failed list News")
+			return total, err
+		}
+		if len(news) == 0 {
+			return total, nil
+		}
+
+		for i := range news {
+			news[i].ComputeReading(n.reading.excerptLength, n.reading.wordsPerMinute, n.reading.charactersPerMinute)
+			if err = n.News.SetReading(ctx, *news[i].Id, &news[i]); err != nil {
+				logger.WithError(err).Warning("Failed update News reading")
+				return total, err
+			}
+		}
+		total += len(news)
+		afterId = news[len(news)-1].Id
+
+		if len(news) < batchSize {
+			return total, nil
+		}
+	}
+}
+
+// Stream subscribe to the news events after lastEventId, only those of the author and with the tag when they are set
+func (n *News) Stream(ctx context.Context, lastEventId int64, authorId *string, tag *string) ([]event.StreamEntry, <-chan event.StreamEntry, func(), error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.News.Stream")
+
+	if n.newsStream == nil {
+		logger.Warning("News stream is not enabled")
+		return nil, nil, nil, model.NewError("news stream is not available", model.ErrorServiceUnavailable)
+	}
+
+	// the drafts and the news hidden after reports are changed too, only the published news reach the readers
+	filter := func(e model.Event) bool {
+		news, ok := e.Data.(*model.News)
+		if !ok || news.PublishedAt == nil {
+			return false
+		}
+		if authorId != nil && helper.Val(news.UserId) != *authorId {
+			return false
+		}
+		return tag == nil || news.HasTag(*tag)
+	}
+
+	replay, ch, unsubscribe := n.newsStream.Subscribe(lastEventId, filter)
+	return replay, ch, unsubscribe, nil
+}
+
+// Duplicates return the news that are near duplicates of the news, the closest first
+func (n *News) Duplicates(ctx context.Context, id *string) ([]model.NewsDuplicate, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.News.Duplicates")
+
+	if id == nil {
+		logger.Error("missing id")
+		return nil, model.NewParameterError(helper.Pointer("missing id"))
+	}
+
+	news, err := n.News.Get(ctx, id)
+	if err != nil {
+		logger.WithError(err).Warning("Failed get News")
+		return nil, err
+	}
+	if news.Fingerprint == nil {
+		return []model.NewsDuplicate{}, nil
+	}
+
+	res, err := n.News.ListSimilar(ctx, repository.NewsSimilarFilter{
+		Fingerprint: *news.Fingerprint,
+		MaxDistance: n.duplicate.maxDistance,
+		ExcludeId:   id,
+		Limit:       maxDuplicates,
+	})
+	if err != nil {
+		logger.WithError(err).Warning("Failed list similar News")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// findDuplicates return the ids of the news close to the fingerprint, or an error when the near duplicates are rejected
+func (n *News) findDuplicates(ctx context.Context, fingerprint uint64) ([]string, error) {
+	if !n.duplicate.enabled {
+		return nil, nil
+	}
+
+	similar, err := n.News.ListSimilar(ctx, repository.NewsSimilarFilter{
+		Fingerprint: fingerprint,
+		MaxDistance: n.duplicate.maxDistance,
+		Limit:       maxDuplicates,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(similar) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(similar))
+	for _, v := range similar {
+		ids = append(ids, *v.Id)
+	}
+	if n.duplicate.reject {
+		return nil, model.NewError("news is a near duplicate of: "+strings.Join(ids, ", "), model.ErrorDuplicate)
+	}
+
+	return ids, nil
+}
+
+// moderate run the moderation checks on the content, returning an error when they reject it and the flags raised otherwise
+func (n *News) moderate(ctx context.Context, content moderation.Content) ([]model.ModerationResult, error) {
+	if n.moderation == nil {
+		return nil, nil
+	}
+
+	verdict, results, err := n.moderation.Run(ctx, content)
+	if err != nil {
+		return nil, err
+	}
+
+	if verdict == model.ModerationReject {
+		reasons := make([]string, 0, len(results))
+		for _, v := range results {
+			if v.Verdict == model.ModerationReject {
+				reasons = append(reasons, v.Reason)
+			}
+		}
+		return nil, model.NewParameterError(helper.Pointer("rejected by moderation: " + strings.Join(reasons, "; ")))
+	}
+
+	return results, nil
+}
+
+// addBylines set the authors credited on the news, they are only missing from the response when they fail to load
+func (n *News) addBylines(ctx context.Context, news *model.News) {
+	if n.newsAuthorRepo == nil {
+		return
+	}
+
+	authors, err := n.newsAuthorRepo.List(ctx, repository.NewsAuthorListFilter{
+		NewsIds: []string{*news.Id},
+		Roles:   []model.NewsAuthorRole{model.NewsAuthorOwner, model.NewsAuthorCoAuthor},
+	})
+	if err != nil {
+		helper.GetLogger(ctx).WithField("method", "usecase.News.addBylines").WithError(err).Error("Failed list NewsAuthor")
+		return
+	}
+	news.Authors = authors
+}
+
+// queueForReview put the flagged news in the moderation queue, the news is already saved so a failure is only logged
+func (n *News) queueForReview(ctx context.Context, news *model.News, flags []model.ModerationResult) {
+	if len(flags) == 0 || n.moderationRepo == nil {
+		return
+	}
+
+	_, err := n.moderationRepo.Add(ctx, &model.ModerationItem{
+		NewsId: news.Id,
+		UserId: news.UserId,
+		Flags:  flags,
+	})
+	if err != nil {
+		helper.GetLogger(ctx).WithField("method", "usecase.News.queueForReview").WithError(err).Error("Failed queue News for review")
+	}
+}
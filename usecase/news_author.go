@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+)
+
+type NewsAuthor struct {
+	repository.NewsAuthor
+	userRepo repository.User
+}
+
+func NewNewsAuthor(n *container.Container) *NewsAuthor {
+	return &NewsAuthor{
+		NewsAuthor: n.NewsAuthorRepo(),
+		userRepo:   n.UserRepo(),
+	}
+}
+
+// List return the collaborators of the news, the owner first
+func (n *NewsAuthor) List(ctx context.Context, newsId *string) ([]model.NewsAuthor, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.NewsAuthor.List")
+
+	if newsId == nil {
+		logger.Error("missing id")
+		return nil, model.NewParameterError(helper.Pointer("missing id"))
+	}
+
+	res, err := n.NewsAuthor.List(ctx, repository.NewsAuthorListFilter{NewsIds: []string{*newsId}})
+	if err != nil {
+		logger.WithError(err).Warning("Failed list NewsAuthor")
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, model.NewNotFoundError()
+	}
+
+	return res, nil
+}
+
+// Invite add the user as a co-author or an editor of the news, on behalf of its owner
+func (n *NewsAuthor) Invite(ctx context.Context, newsId *string, ownerId *string, userId *string, role model.NewsAuthorRole) (*model.NewsAuthor, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.NewsAuthor.Invite")
+
+	if newsId == nil || ownerId == nil || userId == nil {
+		logger.Error("missing id")
+		return nil, model.NewParameterError(helper.Pointer("missing id"))
+	}
+	if role != model.NewsAuthorCoAuthor && role != model.NewsAuthorEditor {
+		err := model.NewParameterError(helper.Pointer("role must be co_author or editor, the ownership is transferred instead"))
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, err
+	}
+
+	if err := n.checkOwner(ctx, *newsId, *ownerId); err != nil {
+		logger.WithError(err).Warning("Not allowed")
+		return nil, err
+	}
+	if err := n.checkUser(ctx, userId); err != nil {
+		logger.WithError(err).Warning("Failed get User")
+		return nil, err
+	}
+
+	res, err := n.NewsAuthor.Add(ctx, &model.NewsAuthor{
+		NewsId:    newsId,
+		UserId:    userId,
+		Role:      &role,
+		InvitedBy: ownerId,
+	})
+	if err != nil {
+		logger.WithError(err).Warning("Failed insert NewsAuthor")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Remove a collaborator from the news, the owner can remove anyone else and a collaborator can leave the news
+func (n *NewsAuthor) Remove(ctx context.Context, newsId *string, requesterId *string, userId *string) error {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.NewsAuthor.Remove")
+
+	if newsId == nil || requesterId == nil || userId == nil {
+		logger.Error("missing id")
+		return model.NewParameterError(helper.Pointer("missing id"))
+	}
+
+	if *requesterId != *userId {
+		if err := n.checkOwner(ctx, *newsId, *requesterId); err != nil {
+			logger.WithError(err).Warning("Not allowed")
+			return err
+		}
+	}
+
+	author, err := n.NewsAuthor.Get(ctx, *newsId, *userId)
+	if err != nil {
+		logger.WithError(err).Warning("Failed get NewsAuthor")
+		return err
+	}
+	if helper.Val(author.Role) == model.NewsAuthorOwner {
+		err = model.NewParameterError(helper.Pointer("the owner can not be removed, transfer the ownership first"))
+		logger.WithError(err).Warning("Not Valid Request")
+		return err
+	}
+
+	if err = n.NewsAuthor.Remove(ctx, *newsId, *userId); err != nil {
+		logger.WithError(err).Warning("Failed remove NewsAuthor")
+		return err
+	}
+
+	return nil
+}
+
+// TransferOwnership make the user the owner of the news, on behalf of the current owner who stay a co-author
+func (n *NewsAuthor) TransferOwnership(ctx context.Context, newsId *string, ownerId *string, userId *string) ([]model.NewsAuthor, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.NewsAuthor.TransferOwnership")
+
+	if newsId == nil || ownerId == nil || userId == nil {
+		logger.Error("missing id")
+		return nil, model.NewParameterError(helper.Pointer("missing id"))
+	}
+
+	if err := n.checkOwner(ctx, *newsId, *ownerId); err != nil {
+		logger.WithError(err).Warning("Not allowed")
+		return nil, err
+	}
+	if err := n.checkUser(ctx, userId); err != nil {
+		logger.WithError(err).Warning("Failed get User")
+		return nil, err
+	}
+
+	if err := n.NewsAuthor.TransferOwnership(ctx, *newsId, *userId); err != nil {
+		logger.WithError(err).Warning("Failed transfer News ownership")
+		return nil, err
+	}
+
+	return n.List(ctx, newsId)
+}
+
+// checkOwner return an error when the user is not the owner of the news
+func (n *NewsAuthor) checkOwner(ctx context.Context, newsId string, userId string) error {
+	author, err := n.NewsAuthor.Get(ctx, newsId, userId)
+	if err != nil && !model.IsNotFoundError(err) {
+		return err
+	}
+	if author == nil || helper.Val(author.Role) != model.NewsAuthorOwner {
+		return model.NewError("only the owner can manage the authors of the news", model.ErrorUnauthorized)
+	}
+
+	return nil
+}
+
+// checkUser return a parameter error when the user does not exist
+func (n *NewsAuthor) checkUser(ctx context.Context, userId *string) error {
+	_, err := n.userRepo.Get(ctx, repository.UserGetFilter{Id: userId})
+	if err != nil {
+		if model.IsNotFoundError(err) {
+			return model.NewParameterError(helper.Pointer("user is not found"))
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+	"tempo/usecase"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewsAuthor_Invite(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnParameterError_WhenRoleIsOwner", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		appContainer := container.Container{}
+
+		// CODE UNDER TEST
+		uc := usecase.NewNewsAuthor(&appContainer)
+		res, err := uc.Invite(context.Background(), helper.Pointer("news"), helper.Pointer("owner"), helper.Pointer("user"), model.NewsAuthorOwner)
+
+		// EXPECTATION
+		require.True(t, model.IsParameterError(err))
+		require.Nil(t, res)
+	})
+
+	t.Run("ShouldReturnErrorUnauthorized_WhenUserIsNotTheOwner", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		newsAuthorMock := &mocks.NewsAuthor{}
+		newsAuthorMock.On("Get", mock.Anything, "news", "coauthor").Return(&model.NewsAuthor{
+			NewsId: helper.Pointer("news"),
+			UserId: helper.Pointer("coauthor"),
+			Role:   helper.Pointer(model.NewsAuthorCoAuthor),
+		}, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsAuthorRepo(newsAuthorMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNewsAuthor(&appContainer)
+		res, err := uc.Invite(context.Background(), helper.Pointer("news"), helper.Pointer("coauthor"), helper.Pointer("user"), model.NewsAuthorEditor)
+
+		// EXPECTATION
+		var e model.Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, model.ErrorUnauthorized, e.Code)
+		require.Nil(t, res)
+
+		newsAuthorMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnParameterError_WhenUserIsNotFound", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		newsAuthorMock := &mocks.NewsAuthor{}
+		newsAuthorMock.On("Get", mock.Anything, "news", "owner").Return(&model.NewsAuthor{
+			Role: helper.Pointer(model.NewsAuthorOwner),
+		}, nil).Once()
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Id: helper.Pointer("user")}).Return(nil, model.NewNotFoundError()).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsAuthorRepo(newsAuthorMock)
+		appContainer.SetUserRepo(userMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNewsAuthor(&appContainer)
+		res, err := uc.Invite(context.Background(), helper.Pointer("news"), helper.Pointer("owner"), helper.Pointer("user"), model.NewsAuthorEditor)
+
+		// EXPECTATION
+		require.True(t, model.IsParameterError(err))
+		require.Nil(t, res)
+
+		newsAuthorMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldAddTheCollaborator", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		author := &model.NewsAuthor{
+			NewsId:    helper.Pointer("news"),
+			UserId:    helper.Pointer("user"),
+			Role:      helper.Pointer(model.NewsAuthorCoAuthor),
+			InvitedBy: helper.Pointer("owner"),
+		}
+		newsAuthorMock := &mocks.NewsAuthor{}
+		newsAuthorMock.On("Get", mock.Anything, "news", "owner").Return(&model.NewsAuthor{
+			Role: helper.Pointer(model.NewsAuthorOwner),
+		}, nil).Once()
+		newsAuthorMock.On("Add", mock.Anything, author).Return(author, nil).Once()
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Id: helper.Pointer("user")}).Return(&model.User{Id: helper.Pointer("user")}, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsAuthorRepo(newsAuthorMock)
+		appContainer.SetUserRepo(userMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNewsAuthor(&appContainer)
+		res, err := uc.Invite(context.Background(), helper.Pointer("news"), helper.Pointer("owner"), helper.Pointer("user"), model.NewsAuthorCoAuthor)
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, author, res)
+
+		newsAuthorMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+}
+
+func TestNewsAuthor_Remove(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnParameterError_WhenUserIsTheOwner", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		newsAuthorMock := &mocks.NewsAuthor{}
+		newsAuthorMock.On("Get", mock.Anything, "news", "owner").Return(&model.NewsAuthor{
+			Role: helper.Pointer(model.NewsAuthorOwner),
+		}, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsAuthorRepo(newsAuthorMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNewsAuthor(&appContainer)
+		err := uc.Remove(context.Background(), helper.Pointer("news"), helper.Pointer("owner"), helper.Pointer("owner"))
+
+		// EXPECTATION
+		require.True(t, model.IsParameterError(err))
+
+		newsAuthorMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldRemoveTheCollaborator_WhenTheyLeaveTheNews", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		newsAuthorMock := &mocks.NewsAuthor{}
+		newsAuthorMock.On("Get", mock.Anything, "news", "editor").Return(&model.NewsAuthor{
+			Role: helper.Pointer(model.NewsAuthorEditor),
+		}, nil).Once()
+		newsAuthorMock.On("Remove", mock.Anything, "news", "editor").Return(nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsAuthorRepo(newsAuthorMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNewsAuthor(&appContainer)
+		err := uc.Remove(context.Background(), helper.Pointer("news"), helper.Pointer("editor"), helper.Pointer("editor"))
+
+		// EXPECTATION
+		require.NoError(t, err)
+
+		newsAuthorMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnErrorUnauthorized_WhenACollaboratorRemoveAnother", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		newsAuthorMock := &mocks.NewsAuthor{}
+		newsAuthorMock.On("Get", mock.Anything, "news", "editor").Return(&model.NewsAuthor{
+			Role: helper.Pointer(model.NewsAuthorEditor),
+		}, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsAuthorRepo(newsAuthorMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNewsAuthor(&appContainer)
+		err := uc.Remove(context.Background(), helper.Pointer("news"), helper.Pointer("editor"), helper.Pointer("coauthor"))
+
+		// EXPECTATION
+		var e model.Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, model.ErrorUnauthorized, e.Code)
+
+		newsAuthorMock.AssertExpectations(t)
+	})
+}
+
+func TestNewsAuthor_TransferOwnership(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldTransferTheOwnership", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		authors := []model.NewsAuthor{
+			{NewsId: helper.Pointer("news"), UserId: helper.Pointer("user"), Role: helper.Pointer(model.NewsAuthorOwner)},
+			{NewsId: helper.Pointer("news"), UserId: helper.Pointer("owner"), Role: helper.Pointer(model.NewsAuthorCoAuthor)},
+		}
+		newsAuthorMock := &mocks.NewsAuthor{}
+		newsAuthorMock.On("Get", mock.Anything, "news", "owner").Return(&model.NewsAuthor{
+			Role: helper.Pointer(model.NewsAuthorOwner),
+		}, nil).Once()
+		newsAuthorMock.On("TransferOwnership", mock.Anything, "news", "user").Return(nil).Once()
+		newsAuthorMock.On("List", mock.Anything, repository.NewsAuthorListFilter{NewsIds: []string{"news"}}).Return(authors, nil).Once()
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Id: helper.Pointer("user")}).Return(&model.User{Id: helper.Pointer("user")}, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsAuthorRepo(newsAuthorMock)
+		appContainer.SetUserRepo(userMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNewsAuthor(&appContainer)
+		res, err := uc.TransferOwnership(context.Background(), helper.Pointer("news"), helper.Pointer("owner"), helper.Pointer("user"))
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, authors, res)
+
+		newsAuthorMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+)
+
+type NewsReport struct {
+	repository.NewsReport
+	newsRepo      repository.News
+	hideThreshold int
+}
+
+func NewNewsReport(n *container.Container) *NewsReport {
+	return &NewsReport{
+		NewsReport:    n.NewsReportRepo(),
+		newsRepo:      n.NewsRepo(),
+		hideThreshold: n.Config().NewsReport.HideThreshold,
+	}
+}
+
+// Report the news on behalf of the user, once until the reports are resolved
+func (n *NewsReport) Report(ctx context.Context, req *model.NewsReport) (*model.NewsReport, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.NewsReport.Report")
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, model.NewParameterError(helper.Pointer(err.Error()))
+	}
+
+	if _, err := n.newsRepo.Get(ctx, req.NewsId); err != nil {
+		logger.WithError(err).Warning("Failed get News")
+		return nil, err
+	}
+
+	res, err := n.NewsReport.Add(ctx, req, n.hideThreshold)
+	if err != nil {
+		logger.WithError(err).Warning("Failed insert NewsReport")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Queue return the news with open reports, the most reported first
+func (n *NewsReport) Queue(ctx context.Context, limit int) ([]model.NewsReportGroup, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.NewsReport.Queue")
+
+	res, err := n.NewsReport.ListGroups(ctx, repository.NewsReportGroupFilter{Limit: pageLimit(limit)})
+	if err != nil {
+		logger.WithError(err).Warning("Failed list NewsReportGroup")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Resolve the open reports of the news, dismissing them or unpublishing or deleting the news
+func (n *NewsReport) Resolve(ctx context.Context, newsId *string, moderatorId *string, action model.NewsReportAction, note *string) (*model.NewsReportResolution, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.NewsReport.Resolve")
+
+	if newsId == nil || moderatorId == nil {
+		logger.Error("missing id")
+		return nil, model.NewParameterError(helper.Pointer("missing id"))
+	}
+	if !action.IsValid() {
+		err := model.NewParameterError(helper.Pointer("action must be dismiss, unpublish or delete"))
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, err
+	}
+
+	res, err := n.NewsReport.Resolve(ctx, *newsId, action, *moderatorId, note)
+	if err != nil {
+		logger.WithError(err).Warning("Failed resolve NewsReport")
+		return nil, err
+	}
+
+	return res, nil
+}
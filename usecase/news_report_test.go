@@ -0,0 +1,132 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+	"tempo/usecase"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewsReport_Report(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnParameterError_WhenReasonIsMissing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		appContainer := container.Container{}
+
+		// CODE UNDER TEST
+		uc := usecase.NewNewsReport(&appContainer)
+		res, err := uc.Report(context.Background(), &model.NewsReport{
+			NewsId: helper.Pointer("news"),
+			UserId: helper.Pointer("user"),
+		})
+
+		// EXPECTATION
+		require.True(t, model.IsParameterError(err))
+		require.Nil(t, res)
+	})
+
+	t.Run("ShouldReturnNotFound_WhenNewsIsNotFound", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, helper.Pointer("news")).Return(nil, model.NewNotFoundError()).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsRepo(newsMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNewsReport(&appContainer)
+		res, err := uc.Report(context.Background(), &model.NewsReport{
+			NewsId: helper.Pointer("news"),
+			UserId: helper.Pointer("user"),
+			Reason: helper.Pointer(model.NewsReportSpam),
+		})
+
+		// EXPECTATION
+		require.True(t, model.IsNotFoundError(err))
+		require.Nil(t, res)
+
+		newsMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldAddTheReport", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, nil)
+		report := &model.NewsReport{
+			NewsId: fakeNews.Id,
+			UserId: helper.Pointer("user"),
+			Reason: helper.Pointer(model.NewsReportOther),
+			Note:   helper.Pointer("copied from another site"),
+		}
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeNews.Id).Return(&fakeNews, nil).Once()
+		newsReportMock := &mocks.NewsReport{}
+		newsReportMock.On("Add", mock.Anything, report, 0).Return(report, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsRepo(newsMock)
+		appContainer.SetNewsReportRepo(newsReportMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNewsReport(&appContainer)
+		res, err := uc.Report(context.Background(), report)
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, report, res)
+
+		newsMock.AssertExpectations(t)
+		newsReportMock.AssertExpectations(t)
+	})
+}
+
+func TestNewsReport_Queue(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldUseTheDefaultLimit_WhenLimitIsMissing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		newsReportMock := &mocks.NewsReport{}
+		newsReportMock.On("ListGroups", mock.Anything, repository.NewsReportGroupFilter{Limit: 20}).Return([]model.NewsReportGroup{}, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsReportRepo(newsReportMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNewsReport(&appContainer)
+		res, err := uc.Queue(context.Background(), 0)
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Empty(t, res)
+
+		newsReportMock.AssertExpectations(t)
+	})
+}
+
+func TestNewsReport_Resolve(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnParameterError_WhenActionIsUnknown", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		appContainer := container.Container{}
+
+		// CODE UNDER TEST
+		uc := usecase.NewNewsReport(&appContainer)
+		res, err := uc.Resolve(context.Background(), helper.Pointer("news"), helper.Pointer("admin"), model.NewsReportAction("ban"), nil)
+
+		// EXPECTATION
+		require.True(t, model.IsParameterError(err))
+		require.Nil(t, res)
+	})
+}
@@ -3,13 +3,18 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
+	"tempo/config"
 	"tempo/container"
+	"tempo/event"
 	"tempo/helper"
 	"tempo/helper/test"
 	"tempo/model"
+	"tempo/moderation"
+	"tempo/repository"
 	"tempo/repository/mocks"
 	"tempo/usecase"
 
@@ -59,6 +64,35 @@ func TestNews_Add(t *testing.T) {
 		newsMock.AssertExpectations(t)
 	})
 
+	t.Run("ShouldReturnErrorUnauthorized_WhenEmailIsNotVerifiedAndItIsRequired", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, nil)
+
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Id: fakeNews.UserId}).
+			Return(&model.User{Id: fakeNews.UserId}, nil).Once()
+
+		cfg := config.Config{}
+		cfg.EmailVerification.RequiredForNews = true
+		appContainer := container.Container{}
+		appContainer.SetConfig(cfg)
+		appContainer.SetNewsRepo(&mocks.News{})
+		appContainer.SetUserRepo(userMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNews(&appContainer)
+		res, err := uc.Add(context.Background(), &fakeNews)
+
+		// EXPECTATION
+		var e model.Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, model.ErrorUnauthorized, e.Code)
+		require.Nil(t, res)
+
+		userMock.AssertExpectations(t)
+	})
+
 	t.Run("ShouldNotReturnError_WhenSuccessInsertNews", func(t *testing.T) {
 		t.Parallel()
 		// INIT
@@ -88,6 +122,367 @@ func TestNews_Add(t *testing.T) {
 
 		newsMock.AssertExpectations(t)
 	})
+
+	t.Run("ShouldStoreTheNormalizedTags", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.Tags = []string{" Live ", "politics", "live", ""}
+			return news
+		})
+
+		newsMock := &mocks.News{}
+		newsMock.On("Add", mock.Anything, mock.MatchedBy(func(news *model.News) bool {
+			return strings.Join(news.Tags, ",") == "live,politics"
+		})).Return(&fakeNews, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsRepo(newsMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNews(&appContainer)
+		_, err := uc.Add(context.Background(), &fakeNews)
+
+		// EXPECTATION
+		require.NoError(t, err)
+
+		newsMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnParameterError_WhenTagIsInvalid", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.Tags = []string{"live blog"}
+			return news
+		})
+
+		// CODE UNDER TEST
+		uc := usecase.NewNews(&container.Container{})
+		_, addErr := uc.Add(context.Background(), &fakeNews)
+		_, updateErr := uc.Update(context.Background(), fakeNews.Id, fakeNews.UserId, &model.News{Tags: fakeNews.Tags})
+
+		// EXPECTATION
+		require.True(t, model.IsParameterError(addErr))
+		require.True(t, model.IsParameterError(updateErr))
+	})
+
+	t.Run("ShouldPublishNewsCreated_WhenEventBusIsSet", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, nil)
+		created := &model.News{
+			Id:    helper.Pointer(fake.CharactersN(6)),
+			Title: fakeNews.Title,
+		}
+
+		newsMock := &mocks.News{}
+		newsMock.On("Add", mock.Anything, &fakeNews).Return(created, nil).Once()
+
+		var published []model.Event
+		bus := event.NewAsyncBus(1, 1)
+		bus.Subscribe(model.EventNewsCreated, func(ctx context.Context, e model.Event) error {
+			published = append(published, e)
+			return nil
+		})
+		bus.Start()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsRepo(newsMock)
+		appContainer.SetEventBus(bus)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNews(&appContainer)
+		_, err := uc.Add(context.Background(), &fakeNews)
+		require.NoError(t, err)
+		bus.Close()
+
+		require.Len(t, published, 1)
+		require.Equal(t, created, published[0].Data)
+
+		newsMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnParameterError_WhenModerationRejects", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.Description = helper.Pointer("come to the casino")
+			return news
+		})
+		bannedWords, err := moderation.ParseBannedWords(strings.NewReader("casino"))
+		require.NoError(t, err)
+
+		newsMock := &mocks.News{}
+
+		appContainer := container.Container{}
+		appContainer.SetNewsRepo(newsMock)
+		appContainer.SetModeration(moderation.NewPipeline(bannedWords))
+
+		// CODE UNDER TEST
+		uc := usecase.NewNews(&appContainer)
+		res, err := uc.Add(context.Background(), &fakeNews)
+
+		// EXPECTATION
+		require.Error(t, err)
+		require.True(t, model.IsParameterError(err))
+		require.Contains(t, err.Error(), "rejected by moderation")
+		require.Nil(t, res)
+
+		newsMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldQueueForReview_WhenModerationFlags", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.Description = helper.Pointer("all about crypto")
+			return news
+		})
+		bannedWords, err := moderation.ParseBannedWords(strings.NewReader("flag:crypto"))
+		require.NoError(t, err)
+		created := &model.News{
+			Id:     helper.Pointer(fake.CharactersN(6)),
+			UserId: fakeNews.UserId,
+		}
+
+		newsMock := &mocks.News{}
+		newsMock.On("Add", mock.Anything, &fakeNews).Return(created, nil).Once()
+		moderationMock := &mocks.Moderation{}
+		moderationMock.On("Add", mock.Anything, mock.MatchedBy(func(item *model.ModerationItem) bool {
+			return *item.NewsId == *created.Id && *item.UserId == *created.UserId && len(item.Flags) == 1
+		})).Return(&model.ModerationItem{}, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsRepo(newsMock)
+		appContainer.SetModeration(moderation.NewPipeline(bannedWords))
+		appContainer.SetModerationRepo(moderationMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNews(&appContainer)
+		res, err := uc.Add(context.Background(), &fakeNews)
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, created, res)
+
+		newsMock.AssertExpectations(t)
+		moderationMock.AssertExpectations(t)
+	})
+}
+
+func duplicateConfig(reject bool) config.Config {
+	cfg := config.Config{}
+	cfg.NewsDuplicate.Enabled = true
+	cfg.NewsDuplicate.MaxDistance = 8
+	cfg.NewsDuplicate.Reject = reject
+	return cfg
+}
+
+func TestNews_AddReading(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldPersistTheReadingMetadata", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.Description = helper.Pointer("<p>" + strings.Repeat("word ", 460) + "</p>" + strings.Repeat("字", 500))
+			return news
+		})
+		newsMock := &mocks.News{}
+		newsMock.On("Add", mock.Anything, mock.MatchedBy(func(news *model.News) bool {
+			return helper.Val(news.Excerpt) == "word word word…" &&
+				helper.Val(news.WordCount) == 960 &&
+				helper.Val(news.ReadingMinutes) == 3
+		})).Return(&fakeNews, nil).Once()
+
+		cfg := config.Config{}
+		cfg.NewsReading.ExcerptLength = 16
+		cfg.NewsReading.WordsPerMinute = 230
+		cfg.NewsReading.CharactersPerMinute = 500
+		appContainer := container.Container{}
+		appContainer.SetConfig(cfg)
+		appContainer.SetNewsRepo(newsMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNews(&appContainer)
+		_, err := uc.Add(context.Background(), &fakeNews)
+
+		// EXPECTATION
+		require.NoError(t, err)
+
+		newsMock.AssertExpectations(t)
+	})
+}
+
+func TestNews_BackfillReading(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldUpdateTheNewsByBatches_UntilNoneIsMissing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		first := test.FakeNews(t, func(news model.News) model.News {
+			news.Id = helper.Pointer("a")
+			return news
+		})
+		second := test.FakeNews(t, func(news model.News) model.News {
+			news.Id = helper.Pointer("b")
+			return news
+		})
+		third := test.FakeNews(t, func(news model.News) model.News {
+			news.Id = helper.Pointer("c")
+			return news
+		})
+
+		newsMock := &mocks.News{}
+		newsMock.On("ListBackfill", mock.Anything, repository.NewsBackfillFilter{
+			MissingReading: true,
+			Limit:          2,
+		}).Return([]model.News{first, second}, nil).Once()
+		newsMock.On("ListBackfill", mock.Anything, repository.NewsBackfillFilter{
+			AfterId:        helper.Pointer("b"),
+			MissingReading: true,
+			Limit:          2,
+		}).Return([]model.News{third}, nil).Once()
+		for _, id := range []string{"a", "b", "c"} {
+			newsMock.On("SetReading", mock.Anything, id, mock.MatchedBy(func(news *model.News) bool {
+				return news.WordCount != nil && news.ReadingMinutes != nil && news.Excerpt != nil
+			})).Return(nil).Once()
+		}
+
+		appContainer := container.Container{}
+		appContainer.SetNewsRepo(newsMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNews(&appContainer)
+		count, err := uc.BackfillReading(context.Background(), false, 2)
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, 3, count)
+
+		newsMock.AssertExpectations(t)
+	})
+}
+
+func TestNews_AddDuplicate(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnTheDuplicateIds_WhenNearDuplicatesExist", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, nil)
+		fingerprint := fakeNews.ComputeFingerprint()
+		created := &model.News{
+			Id:    helper.Pointer(fake.CharactersN(6)),
+			Title: fakeNews.Title,
+		}
+
+		newsMock := &mocks.News{}
+		newsMock.On("ListSimilar", mock.Anything, repository.NewsSimilarFilter{
+			Fingerprint: fingerprint,
+			MaxDistance: 8,
+			Limit:       20,
+		}).Return([]model.NewsDuplicate{{News: model.News{Id: helper.Pointer("existing")}, Distance: 2}}, nil).Once()
+		newsMock.On("Add", mock.Anything, mock.MatchedBy(func(news *model.News) bool {
+			return news.Fingerprint != nil && *news.Fingerprint == fingerprint
+		})).Return(created, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetConfig(duplicateConfig(false))
+		appContainer.SetNewsRepo(newsMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNews(&appContainer)
+		res, err := uc.Add(context.Background(), &fakeNews)
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, []string{"existing"}, res.DuplicateOf)
+
+		newsMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnDuplicateError_WhenNearDuplicatesAreRejected", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, nil)
+
+		newsMock := &mocks.News{}
+		newsMock.On("ListSimilar", mock.Anything, mock.Anything).
+			Return([]model.NewsDuplicate{{News: model.News{Id: helper.Pointer("first")}}, {News: model.News{Id: helper.Pointer("second")}}}, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetConfig(duplicateConfig(true))
+		appContainer.SetNewsRepo(newsMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNews(&appContainer)
+		res, err := uc.Add(context.Background(), &fakeNews)
+
+		// EXPECTATION
+		require.True(t, model.IsDuplicateError(err))
+		require.EqualError(t, err, "news is a near duplicate of: first, second")
+		require.Nil(t, res)
+
+		newsMock.AssertExpectations(t)
+	})
+}
+
+func TestNews_Duplicates(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnEmpty_WhenNewsHasNoFingerprint", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, nil)
+
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeNews.Id).Return(&fakeNews, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetConfig(duplicateConfig(false))
+		appContainer.SetNewsRepo(newsMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNews(&appContainer)
+		res, err := uc.Duplicates(context.Background(), fakeNews.Id)
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Empty(t, res)
+
+		newsMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldListTheSimilarNewsExceptItself", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.Fingerprint = helper.Pointer(uint64(42))
+			return news
+		})
+		similar := []model.NewsDuplicate{{News: model.News{Id: helper.Pointer("other")}, Distance: 1}}
+
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeNews.Id).Return(&fakeNews, nil).Once()
+		newsMock.On("ListSimilar", mock.Anything, repository.NewsSimilarFilter{
+			Fingerprint: 42,
+			MaxDistance: 8,
+			ExcludeId:   fakeNews.Id,
+			Limit:       20,
+		}).Return(similar, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetConfig(duplicateConfig(false))
+		appContainer.SetNewsRepo(newsMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNews(&appContainer)
+		res, err := uc.Duplicates(context.Background(), fakeNews.Id)
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, similar, res)
+
+		newsMock.AssertExpectations(t)
+	})
 }
 
 func TestNews_Login(t *testing.T) {
@@ -99,7 +494,7 @@ func TestNews_Login(t *testing.T) {
 
 		// CODE UNDER TEST
 		uc := usecase.NewNews(&appContainer)
-		res, err := uc.Get(context.Background(), nil)
+		res, err := uc.Get(context.Background(), nil, nil)
 		require.Error(t, err)
 		require.True(t, model.IsParameterError(err))
 		require.Nil(t, res)
@@ -119,7 +514,7 @@ func TestNews_Login(t *testing.T) {
 
 		// CODE UNDER TEST
 		uc := usecase.NewNews(&appContainer)
-		res, err := uc.Get(context.Background(), fakeNews.Id)
+		res, err := uc.Get(context.Background(), fakeNews.Id, nil)
 		require.Error(t, err)
 		require.EqualError(t, err, "error get")
 		require.Nil(t, res)
@@ -130,7 +525,10 @@ func TestNews_Login(t *testing.T) {
 	t.Run("ShouldReturnExistingNews", func(t *testing.T) {
 		t.Parallel()
 		// INIT
-		fakeNews := test.FakeNews(t, nil)
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.PublishedAt = helper.Pointer(time.Now())
+			return news
+		})
 
 		newsMock := &mocks.News{}
 		newsMock.On("Get", mock.Anything, fakeNews.Id).Return(&fakeNews, nil).Once()
@@ -140,7 +538,7 @@ func TestNews_Login(t *testing.T) {
 
 		// CODE UNDER TEST
 		uc := usecase.NewNews(&appContainer)
-		res, err := uc.Get(context.Background(), fakeNews.Id)
+		res, err := uc.Get(context.Background(), fakeNews.Id, nil)
 		require.NoError(t, err)
 		require.NotNil(t, res)
 		require.Equal(t, *fakeNews.UserId, *res.UserId)
@@ -149,6 +547,112 @@ func TestNews_Login(t *testing.T) {
 
 		newsMock.AssertExpectations(t)
 	})
+
+	t.Run("ShouldReturnErrorNotFound_WhenNewsIsHidden", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, nil)
+		viewer := test.FakeUser(t, nil)
+
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeNews.Id).Return(&fakeNews, nil).Twice()
+		newsAuthorMock := &mocks.NewsAuthor{}
+		newsAuthorMock.On("Get", mock.Anything, *fakeNews.Id, *viewer.Id).Return(nil, model.NewNotFoundError()).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsRepo(newsMock)
+		appContainer.SetNewsAuthorRepo(newsAuthorMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNews(&appContainer)
+		res, err := uc.Get(context.Background(), fakeNews.Id, &viewer)
+		anonymousRes, anonymousErr := uc.Get(context.Background(), fakeNews.Id, nil)
+
+		// EXPECTATION
+		require.True(t, model.IsNotFoundError(err))
+		require.Nil(t, res)
+		require.True(t, model.IsNotFoundError(anonymousErr))
+		require.Nil(t, anonymousRes)
+
+		newsMock.AssertExpectations(t)
+		newsAuthorMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnHiddenNews_WhenViewerIsAnAuthorOrAdmin", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, nil)
+		coAuthor := test.FakeUser(t, nil)
+		admin := test.FakeUser(t, func(user model.User) model.User {
+			user.Role = helper.Pointer(model.UserRoleAdmin)
+			return user
+		})
+
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeNews.Id).Return(&fakeNews, nil).Times(3)
+		newsAuthorMock := &mocks.NewsAuthor{}
+		newsAuthorMock.On("Get", mock.Anything, *fakeNews.Id, *coAuthor.Id).Return(&model.NewsAuthor{
+			NewsId: fakeNews.Id,
+			UserId: coAuthor.Id,
+			Role:   helper.Pointer(model.NewsAuthorEditor),
+		}, nil).Once()
+		newsAuthorMock.On("List", mock.Anything, mock.Anything).Return([]model.NewsAuthor{}, nil).Times(3)
+
+		appContainer := container.Container{}
+		appContainer.SetNewsRepo(newsMock)
+		appContainer.SetNewsAuthorRepo(newsAuthorMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNews(&appContainer)
+		_, ownerErr := uc.Get(context.Background(), fakeNews.Id, &model.User{Id: fakeNews.UserId})
+		_, coAuthorErr := uc.Get(context.Background(), fakeNews.Id, &coAuthor)
+		_, adminErr := uc.Get(context.Background(), fakeNews.Id, &admin)
+
+		// EXPECTATION
+		require.NoError(t, ownerErr)
+		require.NoError(t, coAuthorErr)
+		require.NoError(t, adminErr)
+
+		newsMock.AssertExpectations(t)
+		newsAuthorMock.AssertExpectations(t)
+	})
+}
+
+func TestNews_GetSeries(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnTheSeriesOfTheNews_WhenCollectionsAreSet", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.PublishedAt = helper.Pointer(time.Now())
+			return news
+		})
+		series := []model.NewsSeries{{
+			CollectionId: helper.Pointer("collection"),
+			Position:     helper.Pointer(1),
+			Previous:     &model.NewsLink{Id: helper.Pointer("first")},
+		}}
+
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeNews.Id).Return(&fakeNews, nil).Once()
+		collectionMock := &mocks.Collection{}
+		collectionMock.On("ListSeries", mock.Anything, *fakeNews.Id).Return(series, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsRepo(newsMock)
+		appContainer.SetCollectionRepo(collectionMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNews(&appContainer)
+		res, err := uc.Get(context.Background(), fakeNews.Id, nil)
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, series, res.Series)
+
+		newsMock.AssertExpectations(t)
+		collectionMock.AssertExpectations(t)
+	})
 }
 
 func TestNews_Update(t *testing.T) {
@@ -160,7 +664,7 @@ func TestNews_Update(t *testing.T) {
 
 		// CODE UNDER TEST
 		uc := usecase.NewNews(&appContainer)
-		res, err := uc.Update(context.Background(), nil, &model.News{})
+		res, err := uc.Update(context.Background(), nil, helper.Pointer(fake.CharactersN(7)), &model.News{})
 		require.Error(t, err)
 		require.True(t, model.IsParameterError(err))
 		require.Nil(t, res)
@@ -178,17 +682,53 @@ func TestNews_Update(t *testing.T) {
 		newsMock := &mocks.News{}
 		newsMock.On("Update", mock.Anything, fakeNews.Id, updateNews).Return(nil, errors.New("error update")).Once()
 
+		newsAuthorMock := &mocks.NewsAuthor{}
+		newsAuthorMock.On("Get", mock.Anything, *fakeNews.Id, *fakeNews.UserId).Return(&model.NewsAuthor{
+			NewsId: fakeNews.Id,
+			UserId: fakeNews.UserId,
+			Role:   helper.Pointer(model.NewsAuthorOwner),
+		}, nil).Once()
+
 		appContainer := container.Container{}
 		appContainer.SetNewsRepo(newsMock)
+		appContainer.SetNewsAuthorRepo(newsAuthorMock)
 
 		// CODE UNDER TEST
 		uc := usecase.NewNews(&appContainer)
-		res, err := uc.Update(context.Background(), fakeNews.Id, updateNews)
+		res, err := uc.Update(context.Background(), fakeNews.Id, fakeNews.UserId, updateNews)
 		require.Error(t, err)
 		require.EqualError(t, err, "error update")
 		require.Nil(t, res)
 
 		newsMock.AssertExpectations(t)
+		newsAuthorMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnErrorUnauthorized_WhenUserIsNotACollaborator", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, nil)
+		userId := fake.CharactersN(7)
+
+		newsMock := &mocks.News{}
+		newsAuthorMock := &mocks.NewsAuthor{}
+		newsAuthorMock.On("Get", mock.Anything, *fakeNews.Id, userId).Return(nil, model.NewNotFoundError()).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNewsRepo(newsMock)
+		appContainer.SetNewsAuthorRepo(newsAuthorMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNews(&appContainer)
+		res, err := uc.Update(context.Background(), fakeNews.Id, &userId, &model.News{Title: helper.Pointer(fake.Words())})
+		require.Error(t, err)
+		var e model.Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, model.ErrorUnauthorized, e.Code)
+		require.Nil(t, res)
+
+		newsMock.AssertExpectations(t)
+		newsAuthorMock.AssertExpectations(t)
 	})
 
 	t.Run("ShouldUpdateNews", func(t *testing.T) {
@@ -206,18 +746,160 @@ func TestNews_Update(t *testing.T) {
 			Title:       updateNews.Title,
 		}, nil).Once()
 
+		editorId := fake.CharactersN(7)
+		owner := model.NewsAuthor{
+			NewsId: fakeNews.Id,
+			UserId: fakeNews.UserId,
+			Role:   helper.Pointer(model.NewsAuthorOwner),
+		}
+		newsAuthorMock := &mocks.NewsAuthor{}
+		newsAuthorMock.On("Get", mock.Anything, *fakeNews.Id, editorId).Return(&model.NewsAuthor{
+			NewsId: fakeNews.Id,
+			UserId: &editorId,
+			Role:   helper.Pointer(model.NewsAuthorEditor),
+		}, nil).Once()
+		newsAuthorMock.On("List", mock.Anything, repository.NewsAuthorListFilter{
+			NewsIds: []string{*fakeNews.Id},
+			Roles:   []model.NewsAuthorRole{model.NewsAuthorOwner, model.NewsAuthorCoAuthor},
+		}).Return([]model.NewsAuthor{owner}, nil).Once()
+
 		appContainer := container.Container{}
 		appContainer.SetNewsRepo(newsMock)
+		appContainer.SetNewsAuthorRepo(newsAuthorMock)
 
 		// CODE UNDER TEST
 		uc := usecase.NewNews(&appContainer)
-		res, err := uc.Update(context.Background(), fakeNews.Id, updateNews)
+		res, err := uc.Update(context.Background(), fakeNews.Id, &editorId, updateNews)
 		require.NoError(t, err)
 		require.NotNil(t, res)
 		require.Equal(t, *updateNews.Title, *res.Title)
 		require.Equal(t, *fakeNews.UserId, *res.UserId)
 		require.Equal(t, *fakeNews.Description, *res.Description)
+		require.Equal(t, []model.NewsAuthor{owner}, res.Authors)
 
 		newsMock.AssertExpectations(t)
+		newsAuthorMock.AssertExpectations(t)
+	})
+}
+
+func TestNews_Stream(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnError_WhenStreamIsNotEnabled", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		appContainer := container.Container{}
+
+		// CODE UNDER TEST
+		uc := usecase.NewNews(&appContainer)
+		_, _, _, err := uc.Stream(context.TODO(), 0, nil, nil)
+
+		// EXPECTATION
+		require.Error(t, err)
+		require.Equal(t, model.ErrorServiceUnavailable, err.(model.Error).Code)
+	})
+
+	t.Run("ShouldOnlyReturnTheNewsOfTheAuthor_WhenAuthorIsSet", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		authorId := fake.CharactersN(7)
+		news := test.FakeNews(t, func(news model.News) model.News {
+			news.PublishedAt = helper.Pointer(time.Now())
+			news.UserId = &authorId
+			return news
+		})
+		otherNews := test.FakeNews(t, func(news model.News) model.News {
+			news.PublishedAt = helper.Pointer(time.Now())
+			return news
+		})
+
+		stream := event.NewStream(10, 10)
+		stream.Push(event.New(model.EventNewsCreated, &otherNews))
+		stream.Push(event.New(model.EventNewsCreated, &news))
+
+		appContainer := container.Container{}
+		appContainer.SetNewsStream(stream)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNews(&appContainer)
+		replay, ch, unsubscribe, err := uc.Stream(context.TODO(), 0, &authorId, nil)
+		require.NoError(t, err)
+		defer unsubscribe()
+		stream.Push(event.New(model.EventNewsUpdated, &otherNews))
+		stream.Push(event.New(model.EventNewsUpdated, &news))
+
+		// EXPECTATION
+		require.Len(t, replay, 1)
+		require.Equal(t, int64(2), replay[0].Seq)
+		entry := <-ch
+		require.Equal(t, int64(4), entry.Seq)
+		require.Equal(t, model.EventNewsUpdated, entry.Event.Type)
+	})
+
+	t.Run("ShouldOnlyReturnTheNewsWithTheTag_WhenTagIsSet", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		news := test.FakeNews(t, func(news model.News) model.News {
+			news.PublishedAt = helper.Pointer(time.Now())
+			news.Tags = []string{"politics", "live"}
+			return news
+		})
+		otherNews := test.FakeNews(t, func(news model.News) model.News {
+			news.PublishedAt = helper.Pointer(time.Now())
+			news.Tags = []string{"sport"}
+			return news
+		})
+		untagged := test.FakeNews(t, func(news model.News) model.News {
+			news.PublishedAt = helper.Pointer(time.Now())
+			return news
+		})
+
+		stream := event.NewStream(10, 10)
+		stream.Push(event.New(model.EventNewsCreated, &otherNews))
+		stream.Push(event.New(model.EventNewsCreated, &news))
+		stream.Push(event.New(model.EventNewsCreated, &untagged))
+
+		appContainer := container.Container{}
+		appContainer.SetNewsStream(stream)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNews(&appContainer)
+		replay, _, unsubscribe, err := uc.Stream(context.TODO(), 0, nil, helper.Pointer("Live"))
+		require.NoError(t, err)
+		defer unsubscribe()
+		otherReplay, _, unsubscribeOther, err := uc.Stream(context.TODO(), 0, otherNews.UserId, helper.Pointer("live"))
+		require.NoError(t, err)
+		defer unsubscribeOther()
+
+		// EXPECTATION
+		require.Len(t, replay, 1)
+		require.Equal(t, int64(2), replay[0].Seq)
+		require.Empty(t, otherReplay)
+	})
+
+	t.Run("ShouldLeaveOutTheUnpublishedNews", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		draft := test.FakeNews(t, nil)
+		news := test.FakeNews(t, func(news model.News) model.News {
+			news.PublishedAt = helper.Pointer(time.Now())
+			return news
+		})
+
+		stream := event.NewStream(10, 10)
+		stream.Push(event.New(model.EventNewsUpdated, &draft))
+		stream.Push(event.New(model.EventNewsUpdated, &news))
+
+		appContainer := container.Container{}
+		appContainer.SetNewsStream(stream)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNews(&appContainer)
+		replay, _, unsubscribe, err := uc.Stream(context.TODO(), 0, nil, nil)
+		require.NoError(t, err)
+		defer unsubscribe()
+
+		// EXPECTATION
+		require.Len(t, replay, 1)
+		require.Equal(t, int64(2), replay[0].Seq)
 	})
 }
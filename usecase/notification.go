@@ -0,0 +1,246 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"tempo/container"
+	"tempo/event"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+)
+
+// notificationBatchSize is how many followers are notified per insert when an author publish
+const notificationBatchSize = 500
+
+type Notification struct {
+	repository.Notification
+	followRepo repository.Follow
+}
+
+func NewNotification(n *container.Container) *Notification {
+	return &Notification{
+		Notification: n.NotificationRepo(),
+		followRepo:   n.FollowRepo(),
+	}
+}
+
+// Subscribe register the notification producers on the event bus so notifications are generated in the background
+func (n *Notification) Subscribe(bus event.Bus) {
+	bus.Subscribe(model.EventNewsCreated, func(ctx context.Context, e model.Event) error {
+		news, ok := e.Data.(*model.News)
+		if !ok {
+			return fmt.Errorf("unexpected %s payload %T", e.Type, e.Data)
+		}
+		return n.NotifyFollowers(ctx, news)
+	})
+	bus.Subscribe(model.EventNewsCommented, func(ctx context.Context, e model.Event) error {
+		activity, ok := e.Data.(*model.NewsActivity)
+		if !ok {
+			return fmt.Errorf("unexpected %s payload %T", e.Type, e.Data)
+		}
+		return n.NotifyNewsCommented(ctx, activity.News, *activity.ActorId)
+	})
+	bus.Subscribe(model.EventNewsReacted, func(ctx context.Context, e model.Event) error {
+		activity, ok := e.Data.(*model.NewsActivity)
+		if !ok {
+			return fmt.Errorf("unexpected %s payload %T", e.Type, e.Data)
+		}
+		return n.NotifyNewsReacted(ctx, activity.News, *activity.ActorId)
+	})
+}
+
+// NotifyFollowers tell the followers of the news author that it was published
+func (n *Notification) NotifyFollowers(ctx context.Context, news *model.News) error {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Notification.NotifyFollowers")
+
+	followerIds, err := n.followRepo.GetFollowerIds(ctx, *news.UserId)
+	if err != nil {
+		logger.WithError(err).Warning("Failed get follower ids")
+		return err
+	}
+
+	for start := 0; start < len(followerIds); start += notificationBatchSize {
+		end := start + notificationBatchSize
+		if end > len(followerIds) {
+			end = len(followerIds)
+		}
+
+		err = n.notify(ctx, model.NotificationFollowedAuthorPublished, followerIds[start:end], news.UserId, news.Id)
+		if err != nil {
+			logger.WithError(err).Warning("Failed notify followers")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NotifyNewsCommented tell the news author that someone commented on it
+func (n *Notification) NotifyNewsCommented(ctx context.Context, news *model.News, actorId string) error {
+	return n.notifyAuthor(ctx, model.NotificationNewsCommented, news, actorId)
+}
+
+// NotifyNewsReacted tell the news author that someone reacted to it
+func (n *Notification) NotifyNewsReacted(ctx context.Context, news *model.News, actorId string) error {
+	return n.notifyAuthor(ctx, model.NotificationNewsReacted, news, actorId)
+}
+
+func (n *Notification) notifyAuthor(ctx context.Context, notificationType model.NotificationType, news *model.News, actorId string) error {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Notification.notifyAuthor")
+
+	// nobody needs to hear about their own activity
+	if *news.UserId == actorId {
+		return nil
+	}
+
+	if err := n.notify(ctx, notificationType, []string{*news.UserId}, &actorId, news.Id); err != nil {
+		logger.WithError(err).Warning("Failed notify author")
+		return err
+	}
+
+	return nil
+}
+
+func (n *Notification) notify(ctx context.Context, notificationType model.NotificationType, userIds []string, actorId *string, newsId *string) error {
+	optedOut, err := n.Notification.GetOptedOutUserIds(ctx, notificationType, userIds)
+	if err != nil {
+		return err
+	}
+	skip := make(map[string]bool, len(optedOut))
+	for _, v := range optedOut {
+		skip[v] = true
+	}
+
+	notifications := make([]model.Notification, 0, len(userIds))
+	for _, userId := range userIds {
+		if skip[userId] {
+			continue
+		}
+		notifications = append(notifications, model.Notification{
+			UserId:  helper.Pointer(userId),
+			Type:    helper.Pointer(notificationType),
+			ActorId: actorId,
+			NewsId:  newsId,
+		})
+	}
+
+	return n.Notification.AddBatch(ctx, notifications)
+}
+
+// List return a page of the user notifications, newest first, the cursor of the next page if there is one and the unread count
+func (n *Notification) List(ctx context.Context, filter repository.NotificationListFilter, cursor *string) ([]model.Notification, *string, int64, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Notification.List")
+
+	if filter.UserId == nil {
+		logger.Error("missing user id")
+		return nil, nil, 0, model.NewParameterError(helper.Pointer("missing user id"))
+	}
+
+	afterCreatedAt, afterId, err := decodeCursor(cursor)
+	if err != nil {
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, nil, 0, err
+	}
+	limit := pageLimit(filter.Limit)
+	filter.AfterCreatedAt = afterCreatedAt
+	filter.AfterId = afterId
+	filter.Limit = limit + 1
+
+	res, err := n.Notification.List(ctx, filter)
+	if err != nil {
+		logger.WithError(err).Warning("Failed list Notification")
+		return nil, nil, 0, err
+	}
+
+	unread, err := n.Notification.CountUnread(ctx, *filter.UserId)
+	if err != nil {
+		logger.WithError(err).Warning("Failed count unread Notification")
+		return nil, nil, 0, err
+	}
+
+	var next *string
+	if len(res) > limit {
+		res = res[:limit]
+		last := res[limit-1]
+		next = helper.Pointer(helper.EncodeCursor(*last.CreatedAt, *last.Id))
+	}
+
+	return res, next, unread, nil
+}
+
+// MarkRead mark the given notifications as read, all the unread ones when ids is empty
+func (n *Notification) MarkRead(ctx context.Context, userId *string, ids []string) (int64, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Notification.MarkRead")
+
+	if userId == nil {
+		logger.Error("missing user id")
+		return 0, model.NewParameterError(helper.Pointer("missing user id"))
+	}
+
+	count, err := n.Notification.MarkRead(ctx, *userId, ids)
+	if err != nil {
+		logger.WithError(err).Warning("Failed mark read Notification")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetPreferences return the preference of every notification type, the ones never set being enabled
+func (n *Notification) GetPreferences(ctx context.Context, userId *string) ([]model.NotificationPreference, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Notification.GetPreferences")
+
+	if userId == nil {
+		logger.Error("missing user id")
+		return nil, model.NewParameterError(helper.Pointer("missing user id"))
+	}
+
+	stored, err := n.Notification.GetPreferences(ctx, *userId)
+	if err != nil {
+		logger.WithError(err).Warning("Failed get Notification preferences")
+		return nil, err
+	}
+	enabled := make(map[model.NotificationType]bool, len(stored))
+	for _, v := range stored {
+		enabled[v.Type] = v.Enabled
+	}
+
+	res := make([]model.NotificationPreference, 0, len(model.NotificationTypes))
+	for _, t := range model.NotificationTypes {
+		isEnabled, found := enabled[t]
+		res = append(res, model.NotificationPreference{
+			UserId:  userId,
+			Type:    t,
+			Enabled: !found || isEnabled,
+		})
+	}
+
+	return res, nil
+}
+
+func (n *Notification) UpdatePreferences(ctx context.Context, userId *string, req []model.NotificationPreference) ([]model.NotificationPreference, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Notification.UpdatePreferences")
+
+	if userId == nil {
+		logger.Error("missing user id")
+		return nil, model.NewParameterError(helper.Pointer("missing user id"))
+	}
+
+	for i, v := range req {
+		if !v.Type.IsValid() {
+			err := model.NewParameterError(helper.Pointer(fmt.Sprintf("unknown notification type %q", v.Type)))
+			logger.WithError(err).Warning("Not Valid Request")
+			return nil, err
+		}
+		req[i].UserId = userId
+	}
+
+	if err := n.Notification.UpsertPreferences(ctx, req); err != nil {
+		logger.WithError(err).Warning("Failed upsert Notification preferences")
+		return nil, err
+	}
+
+	return n.GetPreferences(ctx, userId)
+}
@@ -0,0 +1,242 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"tempo/container"
+	"tempo/event"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+	"tempo/usecase"
+
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotification_NotifyFollowers(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldSkipFollowersThatOptedOut", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		news := model.News{
+			Id:     helper.Pointer(fake.CharactersN(7)),
+			UserId: helper.Pointer(fake.CharactersN(7)),
+		}
+
+		followMock := &mocks.Follow{}
+		followMock.On("GetFollowerIds", mock.Anything, *news.UserId).Return([]string{"a", "b"}, nil).Once()
+		notificationMock := &mocks.Notification{}
+		notificationMock.On("GetOptedOutUserIds", mock.Anything, model.NotificationFollowedAuthorPublished, []string{"a", "b"}).
+			Return([]string{"b"}, nil).Once()
+		notificationMock.On("AddBatch", mock.Anything, []model.Notification{{
+			UserId:  helper.Pointer("a"),
+			Type:    helper.Pointer(model.NotificationFollowedAuthorPublished),
+			ActorId: news.UserId,
+			NewsId:  news.Id,
+		}}).Return(nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetFollowRepo(followMock)
+		appContainer.SetNotificationRepo(notificationMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNotification(&appContainer)
+		err := uc.NotifyFollowers(context.Background(), &news)
+		require.NoError(t, err)
+
+		followMock.AssertExpectations(t)
+		notificationMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldNotifyInBatches", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		news := model.News{
+			Id:     helper.Pointer(fake.CharactersN(7)),
+			UserId: helper.Pointer(fake.CharactersN(7)),
+		}
+		var followerIds []string
+		for i := 0; i < 501; i++ {
+			followerIds = append(followerIds, fmt.Sprintf("follower-%d", i))
+		}
+
+		followMock := &mocks.Follow{}
+		followMock.On("GetFollowerIds", mock.Anything, *news.UserId).Return(followerIds, nil).Once()
+		notificationMock := &mocks.Notification{}
+		notificationMock.On("GetOptedOutUserIds", mock.Anything, model.NotificationFollowedAuthorPublished, followerIds[:500]).
+			Return([]string{}, nil).Once()
+		notificationMock.On("GetOptedOutUserIds", mock.Anything, model.NotificationFollowedAuthorPublished, followerIds[500:]).
+			Return([]string{}, nil).Once()
+		notificationMock.On("AddBatch", mock.Anything, mock.MatchedBy(func(n []model.Notification) bool {
+			return len(n) == 500
+		})).Return(nil).Once()
+		notificationMock.On("AddBatch", mock.Anything, mock.MatchedBy(func(n []model.Notification) bool {
+			return len(n) == 1
+		})).Return(nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetFollowRepo(followMock)
+		appContainer.SetNotificationRepo(notificationMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNotification(&appContainer)
+		err := uc.NotifyFollowers(context.Background(), &news)
+		require.NoError(t, err)
+
+		followMock.AssertExpectations(t)
+		notificationMock.AssertExpectations(t)
+	})
+}
+
+func TestNotification_Subscribe(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldNotifyTheAuthor_WhenTheNewsIsCommentedOrReacted", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		news := model.News{
+			Id:     helper.Pointer(fake.CharactersN(7)),
+			UserId: helper.Pointer(fake.CharactersN(7)),
+		}
+		actorId := helper.Pointer(fake.CharactersN(7))
+
+		notificationMock := &mocks.Notification{}
+		for _, v := range []model.NotificationType{model.NotificationNewsCommented, model.NotificationNewsReacted} {
+			notificationMock.On("GetOptedOutUserIds", mock.Anything, v, []string{*news.UserId}).Return([]string{}, nil).Once()
+			notificationMock.On("AddBatch", mock.Anything, []model.Notification{{
+				UserId:  news.UserId,
+				Type:    helper.Pointer(v),
+				ActorId: actorId,
+				NewsId:  news.Id,
+			}}).Return(nil).Once()
+		}
+
+		appContainer := container.Container{}
+		appContainer.SetNotificationRepo(notificationMock)
+		bus := event.NewAsyncBus(1, 10)
+		usecase.NewNotification(&appContainer).Subscribe(bus)
+		bus.Start()
+
+		// CODE UNDER TEST
+		activity := &model.NewsActivity{News: &news, ActorId: actorId}
+		bus.Publish(context.Background(), event.New(model.EventNewsCommented, activity))
+		bus.Publish(context.Background(), event.New(model.EventNewsReacted, activity))
+		bus.Close()
+
+		// EXPECTATION
+		notificationMock.AssertExpectations(t)
+	})
+}
+
+func TestNotification_NotifyNewsReacted(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldNotNotify_WhenAuthorReactedToTheirOwnNews", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		news := model.News{
+			Id:     helper.Pointer(fake.CharactersN(7)),
+			UserId: helper.Pointer(fake.CharactersN(7)),
+		}
+		notificationMock := &mocks.Notification{}
+
+		appContainer := container.Container{}
+		appContainer.SetNotificationRepo(notificationMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNotification(&appContainer)
+		err := uc.NotifyNewsReacted(context.Background(), &news, *news.UserId)
+		require.NoError(t, err)
+
+		notificationMock.AssertExpectations(t)
+	})
+}
+
+func TestNotification_List(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnNextCursorAndUnreadCount", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		userId := helper.Pointer(fake.CharactersN(7))
+		now := time.Now()
+		notifications := []model.Notification{
+			{Id: helper.Pointer("a"), CreatedAt: helper.Pointer(now)},
+			{Id: helper.Pointer("b"), CreatedAt: helper.Pointer(now.Add(-time.Minute))},
+		}
+
+		notificationMock := &mocks.Notification{}
+		notificationMock.On("List", mock.Anything, repository.NotificationListFilter{
+			UserId:     userId,
+			UnreadOnly: true,
+			Limit:      2,
+		}).Return(notifications, nil).Once()
+		notificationMock.On("CountUnread", mock.Anything, *userId).Return(int64(5), nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNotificationRepo(notificationMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNotification(&appContainer)
+		res, next, unread, err := uc.List(context.Background(), repository.NotificationListFilter{
+			UserId:     userId,
+			UnreadOnly: true,
+			Limit:      1,
+		}, nil)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.NotNil(t, next)
+		require.Equal(t, int64(5), unread)
+
+		notificationMock.AssertExpectations(t)
+	})
+}
+
+func TestNotification_GetPreferences(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldDefaultToEnabled_WhenPreferenceIsNotSet", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		userId := helper.Pointer(fake.CharactersN(7))
+
+		notificationMock := &mocks.Notification{}
+		notificationMock.On("GetPreferences", mock.Anything, *userId).Return([]model.NotificationPreference{
+			{UserId: userId, Type: model.NotificationNewsReacted, Enabled: false},
+		}, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetNotificationRepo(notificationMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewNotification(&appContainer)
+		res, err := uc.GetPreferences(context.Background(), userId)
+		require.NoError(t, err)
+		require.Len(t, res, len(model.NotificationTypes))
+		for _, v := range res {
+			require.Equal(t, v.Type != model.NotificationNewsReacted, v.Enabled)
+		}
+
+		notificationMock.AssertExpectations(t)
+	})
+}
+
+func TestNotification_UpdatePreferences(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnError_WhenTypeIsUnknown", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		appContainer := container.Container{}
+
+		// CODE UNDER TEST
+		uc := usecase.NewNotification(&appContainer)
+		res, err := uc.UpdatePreferences(context.Background(), helper.Pointer(fake.CharactersN(7)), []model.NotificationPreference{
+			{Type: "unknown", Enabled: false},
+		})
+		require.Error(t, err)
+		require.True(t, model.IsParameterError(err))
+		require.Nil(t, res)
+	})
+}
@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/outbox"
+	"tempo/repository"
+)
+
+var ErrOutboxRelayRunning = errors.New("another outbox relay is running")
+
+type Outbox struct {
+	repository.Outbox
+}
+
+func NewOutbox(n *container.Container) *Outbox {
+	return &Outbox{
+		Outbox: n.OutboxRepo(),
+	}
+}
+
+// RelayBatch publish the oldest pending events to the sink and mark them dispatched, it return how many were published.
+// An event published but not yet marked is published again on the next call.
+// Only the events older than commitLag are published: the offsets are allocated at insert, so a transaction still
+// running can commit a lower offset than an event already visible. commitLag must exceed the longest write transaction.
+func (o *Outbox) RelayBatch(ctx context.Context, sink outbox.Sink, batchSize int, commitLag time.Duration) (int, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Outbox.RelayBatch")
+
+	events, err := o.Outbox.List(ctx, repository.OutboxListFilter{
+		PendingOnly:    true,
+		OccurredBefore: helper.Pointer(time.Now().Add(-commitLag)),
+		Limit:          batchSize,
+	})
+	if err != nil {
+		logger.WithError(err).Warning("Failed list Outbox")
+		return 0, err
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	if err = sink.Publish(ctx, events); err != nil {
+		logger.WithError(err).Warning("Failed publish Outbox events")
+		return 0, err
+	}
+
+	offsets := make([]int64, 0, len(events))
+	for _, v := range events {
+		offsets = append(offsets, *v.Offset)
+	}
+	if err = o.Outbox.MarkDispatched(ctx, offsets); err != nil {
+		logger.WithError(err).Warning("Failed mark Outbox dispatched")
+		return 0, err
+	}
+
+	return len(events), nil
+}
+
+// Relay publish the pending events until the context is done, polling every interval once caught up.
+// Only one relay run at a time so the sink receive the events in order.
+func (o *Outbox) Relay(ctx context.Context, sink outbox.Sink, batchSize int, interval, commitLag time.Duration) error {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Outbox.Relay")
+
+	locked, err := o.Outbox.TryLock(ctx)
+	if err != nil {
+		logger.WithError(err).Warning("Failed lock Outbox")
+		return err
+	}
+	if !locked {
+		logger.WithError(ErrOutboxRelayRunning).Warning("Failed lock Outbox")
+		return ErrOutboxRelayRunning
+	}
+	defer func() {
+		if err := o.Outbox.Unlock(context.Background()); err != nil {
+			logger.WithError(err).Warning("Failed unlock Outbox")
+		}
+	}()
+
+	for {
+		count, err := o.RelayBatch(ctx, sink, batchSize, commitLag)
+		if err != nil && ctx.Err() == nil {
+			// keep going, the batch is retried after the interval
+			logger.WithError(err).Error("Failed relay Outbox batch")
+		}
+		if count == batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// Replay publish again every event from the offset, dispatched or not, and return how many were published
+func (o *Outbox) Replay(ctx context.Context, sink outbox.Sink, fromOffset int64, batchSize int) (int, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Outbox.Replay")
+
+	total := 0
+	for {
+		events, err := o.Outbox.List(ctx, repository.OutboxListFilter{
+			FromOffset: &fromOffset,
+			Limit:      batchSize,
+		})
+		if err != nil {
+			logger.WithError(err).Warning("Failed list Outbox")
+			return total, err
+		}
+		if len(events) == 0 {
+			return total, nil
+		}
+
+		if err = sink.Publish(ctx, events); err != nil {
+			logger.WithError(err).Warning("Failed publish Outbox events")
+			return total, err
+		}
+		total += len(events)
+		fromOffset = *events[len(events)-1].Offset + 1
+
+		if len(events) < batchSize {
+			return total, nil
+		}
+	}
+}
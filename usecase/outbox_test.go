@@ -0,0 +1,139 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+	"tempo/usecase"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSink struct {
+	published []model.OutboxEvent
+	err       error
+}
+
+func (f *fakeSink) Publish(ctx context.Context, events []model.OutboxEvent) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.published = append(f.published, events...)
+	return nil
+}
+
+func (f *fakeSink) Close() error {
+	return nil
+}
+
+func outboxEvents(offsets ...int64) []model.OutboxEvent {
+	res := make([]model.OutboxEvent, 0, len(offsets))
+	for _, v := range offsets {
+		res = append(res, model.OutboxEvent{Offset: helper.Pointer(v)})
+	}
+	return res
+}
+
+func TestOutbox_RelayBatch(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldMarkDispatched_WhenPublished", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		outboxMock := &mocks.Outbox{}
+		outboxMock.On("List", mock.Anything, mock.MatchedBy(func(filter repository.OutboxListFilter) bool {
+			return filter.PendingOnly && filter.Limit == 10 &&
+				filter.OccurredBefore != nil && time.Since(*filter.OccurredBefore) >= 5*time.Second
+		})).Return(outboxEvents(3, 4), nil).Once()
+		outboxMock.On("MarkDispatched", mock.Anything, []int64{3, 4}).Return(nil).Once()
+		sink := &fakeSink{}
+
+		appContainer := container.Container{}
+		appContainer.SetOutboxRepo(outboxMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewOutbox(&appContainer)
+		count, err := uc.RelayBatch(context.Background(), sink, 10, 5*time.Second)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+		require.Len(t, sink.published, 2)
+
+		outboxMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldNotMarkDispatched_WhenSinkFails", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		outboxMock := &mocks.Outbox{}
+		outboxMock.On("List", mock.Anything, mock.Anything).Return(outboxEvents(1), nil).Once()
+		sink := &fakeSink{err: errors.New("error publish")}
+
+		appContainer := container.Container{}
+		appContainer.SetOutboxRepo(outboxMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewOutbox(&appContainer)
+		count, err := uc.RelayBatch(context.Background(), sink, 10, 5*time.Second)
+		require.EqualError(t, err, "error publish")
+		require.Equal(t, 0, count)
+
+		outboxMock.AssertExpectations(t)
+	})
+}
+
+func TestOutbox_Relay(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnError_WhenAnotherRelayIsRunning", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		outboxMock := &mocks.Outbox{}
+		outboxMock.On("TryLock", mock.Anything).Return(false, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetOutboxRepo(outboxMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewOutbox(&appContainer)
+		err := uc.Relay(context.Background(), &fakeSink{}, 10, 0, 0)
+		require.ErrorIs(t, err, usecase.ErrOutboxRelayRunning)
+
+		outboxMock.AssertExpectations(t)
+	})
+}
+
+func TestOutbox_Replay(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldPublishFromOffsetUntilCaughtUp", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		outboxMock := &mocks.Outbox{}
+		outboxMock.On("List", mock.Anything, repository.OutboxListFilter{
+			FromOffset: helper.Pointer(int64(5)),
+			Limit:      2,
+		}).Return(outboxEvents(5, 6), nil).Once()
+		outboxMock.On("List", mock.Anything, repository.OutboxListFilter{
+			FromOffset: helper.Pointer(int64(7)),
+			Limit:      2,
+		}).Return(outboxEvents(7), nil).Once()
+		sink := &fakeSink{}
+
+		appContainer := container.Container{}
+		appContainer.SetOutboxRepo(outboxMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewOutbox(&appContainer)
+		count, err := uc.Replay(context.Background(), sink, 5, 2)
+		require.NoError(t, err)
+		require.Equal(t, 3, count)
+		require.Len(t, sink.published, 3)
+
+		outboxMock.AssertExpectations(t)
+	})
+}
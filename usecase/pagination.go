@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"time"
+
+	"tempo/helper"
+	"tempo/model"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+func pageLimit(limit int) int {
+	if limit <= 0 {
+		return defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		return maxPageLimit
+	}
+
+	return limit
+}
+
+func decodeCursor(cursor *string) (*time.Time, *string, error) {
+	if helper.Val(cursor) == "" {
+		return nil, nil, nil
+	}
+
+	t, id, err := helper.DecodeCursor(*cursor)
+	if err != nil {
+		return nil, nil, model.NewParameterError(helper.Pointer(err.Error()))
+	}
+
+	return &t, &id, nil
+}
@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"tempo/config"
+	"tempo/container"
+	"tempo/denylist"
+	"tempo/event"
+	"tempo/helper"
+	"tempo/mailer"
+	"tempo/model"
+	"tempo/password"
+	"tempo/ratelimit"
+	"tempo/repository"
+)
+
+type PasswordReset struct {
+	userRepo          repository.User
+	passwordResetRepo repository.PasswordReset
+	refreshTokenRepo  repository.RefreshToken
+	denylist          *denylist.Denylist
+	eventBus          event.Bus
+	hasher            password.Hasher
+	mailer            mailer.Mailer
+	site              config.SiteConfig
+	lifetime          time.Duration
+	path              string
+	emailLimiter      *ratelimit.Limiter
+	ipLimiter         *ratelimit.Limiter
+}
+
+func NewPasswordReset(p *container.Container) *PasswordReset {
+	cfg := p.Config()
+	window := time.Duration(cfg.PasswordReset.WindowSeconds) * time.Second
+
+	return &PasswordReset{
+		userRepo:          p.UserRepo(),
+		passwordResetRepo: p.PasswordResetRepo(),
+		refreshTokenRepo:  p.RefreshTokenRepo(),
+		denylist:          p.Denylist(),
+		eventBus:          p.EventBus(),
+		hasher:            p.PasswordHasher(),
+		mailer:            p.Mailer(),
+		site:              cfg.Site,
+		lifetime:          time.Duration(cfg.PasswordReset.TokenMinutes) * time.Minute,
+		path:              cfg.PasswordReset.Path,
+		emailLimiter:      ratelimit.New(p.RateLimitRepo(), "password-reset-email", cfg.PasswordReset.EmailLimit, window),
+		ipLimiter:         ratelimit.New(p.RateLimitRepo(), "password-reset-ip", cfg.PasswordReset.IpLimit, window),
+	}
+}
+
+// Subscribe register the sending of the reset emails on the event bus
+func (p *PasswordReset) Subscribe(bus event.Bus) {
+	bus.Subscribe(model.EventPasswordResetRequested, func(ctx context.Context, e model.Event) error {
+		email, ok := e.Data.(string)
+		if !ok {
+			return fmt.Errorf("unexpected %s payload %T", e.Type, e.Data)
+		}
+		return p.SendResetEmail(ctx, email)
+	})
+}
+
+// Forgot ask for a reset link to be emailed to the user of the email. Only the rate limits are checked here, the
+// user is looked up and mailed in the background: an unknown email, a suspended user or a failure to send get the
+// same response in the same time, so the reset can't list the registered emails
+func (p *PasswordReset) Forgot(ctx context.Context, email string, ip string) error {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.PasswordReset.Forgot")
+
+	if err := p.ipLimiter.Allow(ctx, ip); err != nil {
+		logger.WithError(err).Warning("Reset not allowed from the IP")
+		return err
+	}
+	if err := p.emailLimiter.Allow(ctx, strings.ToLower(email)); err != nil {
+		logger.WithError(err).Warning("Reset not allowed for the email")
+		return err
+	}
+
+	p.dispatchResetEmail(ctx, email)
+
+	return nil
+}
+
+// dispatchResetEmail send the reset email in the background, through the event bus when there is one
+func (p *PasswordReset) dispatchResetEmail(ctx context.Context, email string) {
+	if p.eventBus == nil {
+		go func(ctx context.Context) {
+			_ = p.SendResetEmail(ctx, email)
+		}(helper.DetachContext(ctx))
+		return
+	}
+	p.eventBus.Publish(ctx, event.New(model.EventPasswordResetRequested, email))
+}
+
+// SendResetEmail email a reset link to the user of the email, when there is one who is not suspended. The failures
+// are logged as nobody is waiting for them
+func (p *PasswordReset) SendResetEmail(ctx context.Context, email string) error {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.PasswordReset.SendResetEmail")
+
+	user, err := p.userRepo.Get(ctx, repository.UserGetFilter{Email: &email})
+	if err != nil {
+		if model.IsNotFoundError(err) {
+			return nil
+		}
+		logger.WithError(err).Warning("Failed get User")
+		return err
+	}
+	if user.IsSuspended() {
+		logger.Warning("Reset of a suspended user")
+		return nil
+	}
+	if p.mailer == nil {
+		err = errors.New("no mailer")
+		logger.WithError(err).Error("Failed send password reset email")
+		return err
+	}
+
+	token, err := helper.RandomToken(32)
+	if err != nil {
+		logger.WithError(err).Error("Failed generate password reset token")
+		return err
+	}
+	_, err = p.passwordResetRepo.Add(ctx, &model.PasswordReset{
+		UserId:    user.Id,
+		TokenHash: helper.Pointer(helper.Sha256(token)),
+		ExpiresAt: helper.Pointer(time.Now().Add(p.lifetime)),
+	})
+	if err != nil {
+		logger.WithError(err).Warning("Failed insert PasswordReset")
+		return err
+	}
+
+	err = p.mailer.Send(ctx, mailer.Message{
+		To:      *user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\nOpen the link below to set a new password, it expires in %d minutes and can "+
+			"only be used once:\n\n%s\n\nIf you did not ask for it and your password still works, ignore this email.\n",
+			helper.Val(user.FullName), int(p.lifetime.Minutes()), p.site.Url(fmt.Sprintf(p.path, url.QueryEscape(token)))),
+	})
+	if err != nil {
+		logger.WithError(err).Warning("Failed send password reset email")
+		return err
+	}
+
+	return nil
+}
+
+// Reset set the new password with the emailed token. The token and the other tokens of the user can't be used again,
+// and every session of the user is revoked: the tokens issued before are rejected and the refresh tokens revoked
+func (p *PasswordReset) Reset(ctx context.Context, token string, newPassword string) (*model.User, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.PasswordReset.Reset")
+
+	invalid := model.NewBadRequestError(helper.Pointer("invalid or expired token"))
+	reset, err := p.passwordResetRepo.GetByHash(ctx, helper.Sha256(token))
+	if err != nil {
+		if model.IsNotFoundError(err) {
+			logger.Warning("Unknown password reset token")
+			return nil, invalid
+		}
+		logger.WithError(err).Warning("Failed get PasswordReset")
+		return nil, err
+	}
+	if reset.UsedAt != nil || reset.IsExpired(time.Now()) {
+		logger.Warning("Used or expired password reset token")
+		return nil, invalid
+	}
+
+	hash, err := p.hasher.Hash(newPassword)
+	if err != nil {
+		logger.WithError(err).Error("Failed hash password")
+		return nil, err
+	}
+
+	if err = p.passwordResetRepo.Use(ctx, *reset.Id); err != nil {
+		if model.IsDuplicateError(err) {
+			logger.Warning("Password reset token used concurrently")
+			return nil, invalid
+		}
+		logger.WithError(err).Warning("Failed use PasswordReset")
+		return nil, err
+	}
+
+	res, err := p.userRepo.SetPassword(ctx, *reset.UserId, hash, nil)
+	if err != nil {
+		logger.WithError(err).Warning("Failed update User password")
+		return nil, err
+	}
+	reloadUser(ctx, p.denylist, *reset.UserId)
+
+	if err = p.refreshTokenRepo.RevokeUser(ctx, *reset.UserId); err != nil {
+		logger.WithError(err).Error("Failed revoke RefreshToken of User")
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,344 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"tempo/config"
+	"tempo/container"
+	"tempo/event"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+	"tempo/usecase"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type passwordResetMocks struct {
+	user          *mocks.User
+	passwordReset *mocks.PasswordReset
+	refreshToken  *mocks.RefreshToken
+	rateLimit     *mocks.RateLimit
+	mailer        *test.Mailer
+}
+
+func newPasswordResetMocks() passwordResetMocks {
+	return passwordResetMocks{
+		user:          &mocks.User{},
+		passwordReset: &mocks.PasswordReset{},
+		refreshToken:  &mocks.RefreshToken{},
+		rateLimit:     &mocks.RateLimit{},
+		mailer:        &test.Mailer{},
+	}
+}
+
+func (m passwordResetMocks) container() *container.Container {
+	cfg := config.Config{}
+	cfg.Site.BaseUrl = "https://tempo.dev"
+	cfg.PasswordReset.TokenMinutes = 30
+	cfg.PasswordReset.Path = "/reset-password?token=%s"
+	cfg.PasswordReset.EmailLimit = 3
+	cfg.PasswordReset.IpLimit = 20
+	cfg.PasswordReset.WindowSeconds = 3600
+
+	appContainer := container.Container{}
+	appContainer.SetConfig(cfg)
+	appContainer.SetUserRepo(m.user)
+	appContainer.SetPasswordResetRepo(m.passwordReset)
+	appContainer.SetRefreshTokenRepo(m.refreshToken)
+	appContainer.SetRateLimitRepo(m.rateLimit)
+	appContainer.SetPasswordHasher(testHasher)
+	appContainer.SetMailer(m.mailer)
+
+	return &appContainer
+}
+
+// forgot run Forgot with the reset emails sent by an event bus, which is drained before returning
+func (m passwordResetMocks) forgot(appContainer *container.Container, email string, ip string) error {
+	bus := event.NewAsyncBus(1, 10)
+	appContainer.SetEventBus(bus)
+	uc := usecase.NewPasswordReset(appContainer)
+	uc.Subscribe(bus)
+	bus.Start()
+	defer bus.Close()
+
+	return uc.Forgot(context.Background(), email, ip)
+}
+
+func (m passwordResetMocks) assertExpectations(t *testing.T) {
+	m.user.AssertExpectations(t)
+	m.passwordReset.AssertExpectations(t)
+	m.refreshToken.AssertExpectations(t)
+	m.rateLimit.AssertExpectations(t)
+}
+
+func TestPasswordReset_Forgot(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldMailTheTokenStoredHashed", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, nil)
+		m := newPasswordResetMocks()
+		m.rateLimit.On("Hit", mock.Anything, "password-reset-ip:"+helper.Sha256("10.0.0.1"), time.Hour).Return(1, nil).Once()
+		m.rateLimit.On("Hit", mock.Anything, "password-reset-email:"+helper.Sha256(strings.ToLower(*fakeUser.Email)), time.Hour).
+			Return(1, nil).Once()
+		m.user.On("Get", mock.Anything, repository.UserGetFilter{Email: fakeUser.Email}).Return(&fakeUser, nil).Once()
+		var stored model.PasswordReset
+		m.passwordReset.On("Add", mock.Anything, mock.MatchedBy(func(reset *model.PasswordReset) bool {
+			stored = *reset
+			return *reset.UserId == *fakeUser.Id
+		})).Return(&model.PasswordReset{}, nil).Once()
+
+		// CODE UNDER TEST
+		err := m.forgot(m.container(), *fakeUser.Email, "10.0.0.1")
+
+		// EXPECTATION
+		require.NoError(t, err)
+		messages := m.mailer.Messages()
+		require.Len(t, messages, 1)
+		require.Equal(t, *fakeUser.Email, messages[0].To)
+		_, link, found := strings.Cut(messages[0].Body, "https://tempo.dev/reset-password?token=")
+		require.True(t, found)
+		token, err := url.QueryUnescape(strings.Fields(link)[0])
+		require.NoError(t, err)
+		require.Equal(t, helper.Sha256(token), *stored.TokenHash)
+		require.WithinDuration(t, time.Now().Add(30*time.Minute), *stored.ExpiresAt, 5*time.Second)
+
+		m.assertExpectations(t)
+	})
+
+	t.Run("ShouldSucceedWithoutMail_WhenEmailIsUnknown", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		m := newPasswordResetMocks()
+		m.rateLimit.On("Hit", mock.Anything, mock.Anything, time.Hour).Return(1, nil).Twice()
+		m.user.On("Get", mock.Anything, repository.UserGetFilter{Email: helper.Pointer("unknown@gmail.com")}).
+			Return(nil, model.NewNotFoundError()).Once()
+
+		// CODE UNDER TEST
+		err := m.forgot(m.container(), "unknown@gmail.com", "10.0.0.1")
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Empty(t, m.mailer.Messages())
+
+		m.assertExpectations(t)
+	})
+
+	t.Run("ShouldSucceedWithoutMail_WhenTheTokenIsNotStored", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, nil)
+		m := newPasswordResetMocks()
+		m.rateLimit.On("Hit", mock.Anything, mock.Anything, time.Hour).Return(1, nil).Twice()
+		m.user.On("Get", mock.Anything, repository.UserGetFilter{Email: fakeUser.Email}).Return(&fakeUser, nil).Once()
+		m.passwordReset.On("Add", mock.Anything, mock.Anything).Return(nil, errors.New("db is down")).Once()
+
+		// CODE UNDER TEST
+		err := m.forgot(m.container(), *fakeUser.Email, "10.0.0.1")
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Empty(t, m.mailer.Messages())
+
+		m.assertExpectations(t)
+	})
+
+	t.Run("ShouldSucceed_WhenTheMailIsNotSent", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, nil)
+		m := newPasswordResetMocks()
+		m.mailer.Err = errors.New("smtp is down")
+		m.rateLimit.On("Hit", mock.Anything, mock.Anything, time.Hour).Return(1, nil).Twice()
+		m.user.On("Get", mock.Anything, repository.UserGetFilter{Email: fakeUser.Email}).Return(&fakeUser, nil).Once()
+		m.passwordReset.On("Add", mock.Anything, mock.Anything).Return(&model.PasswordReset{}, nil).Once()
+
+		// CODE UNDER TEST
+		err := m.forgot(m.container(), *fakeUser.Email, "10.0.0.1")
+
+		// EXPECTATION
+		require.NoError(t, err)
+
+		m.assertExpectations(t)
+	})
+
+	t.Run("ShouldSucceed_WhenThereIsNoMailer", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, nil)
+		m := newPasswordResetMocks()
+		m.rateLimit.On("Hit", mock.Anything, mock.Anything, time.Hour).Return(1, nil).Twice()
+		m.user.On("Get", mock.Anything, repository.UserGetFilter{Email: fakeUser.Email}).Return(&fakeUser, nil).Once()
+		appContainer := m.container()
+		appContainer.SetMailer(nil)
+
+		// CODE UNDER TEST
+		err := m.forgot(appContainer, *fakeUser.Email, "10.0.0.1")
+
+		// EXPECTATION
+		require.NoError(t, err)
+
+		m.assertExpectations(t)
+	})
+
+	t.Run("ShouldReturnErrorTooManyRequests_WhenTheIpLimitIsExceeded", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		m := newPasswordResetMocks()
+		m.rateLimit.On("Hit", mock.Anything, "password-reset-ip:"+helper.Sha256("10.0.0.1"), time.Hour).Return(21, nil).Once()
+
+		// CODE UNDER TEST
+		err := m.forgot(m.container(), "email@gmail.com", "10.0.0.1")
+
+		// EXPECTATION
+		var e model.Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, model.ErrorTooManyRequests, e.Code)
+		require.Empty(t, m.mailer.Messages())
+
+		m.assertExpectations(t)
+	})
+
+	t.Run("ShouldReturnErrorTooManyRequests_WhenTheEmailLimitIsExceeded", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		m := newPasswordResetMocks()
+		m.rateLimit.On("Hit", mock.Anything, "password-reset-ip:"+helper.Sha256("10.0.0.1"), time.Hour).Return(1, nil).Once()
+		m.rateLimit.On("Hit", mock.Anything, "password-reset-email:"+helper.Sha256("email@gmail.com"), time.Hour).
+			Return(4, nil).Once()
+
+		// CODE UNDER TEST
+		err := m.forgot(m.container(), "EMAIL@gmail.com", "10.0.0.1")
+
+		// EXPECTATION
+		var e model.Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, model.ErrorTooManyRequests, e.Code)
+		require.Empty(t, m.mailer.Messages())
+
+		m.assertExpectations(t)
+	})
+}
+
+func TestPasswordReset_Reset(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldSetTheNewPasswordAndRevokeTheSessions", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, nil)
+		m := newPasswordResetMocks()
+		m.passwordReset.On("GetByHash", mock.Anything, helper.Sha256("token")).Return(&model.PasswordReset{
+			Id:        helper.Pointer("reset"),
+			UserId:    fakeUser.Id,
+			ExpiresAt: helper.Pointer(time.Now().Add(time.Minute)),
+		}, nil).Once()
+		m.passwordReset.On("Use", mock.Anything, "reset").Return(nil).Once()
+		m.user.On("SetPassword", mock.Anything, *fakeUser.Id, mock.MatchedBy(func(hash string) bool {
+			ok, err := testHasher.Verify("new password", hash)
+			return err == nil && ok
+		}), (*string)(nil)).Return(&fakeUser, nil).Once()
+		m.refreshToken.On("RevokeUser", mock.Anything, *fakeUser.Id).Return(nil).Once()
+
+		// CODE UNDER TEST
+		uc := usecase.NewPasswordReset(m.container())
+		res, err := uc.Reset(context.Background(), "token", "new password")
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, *fakeUser.Id, *res.Id)
+
+		m.assertExpectations(t)
+	})
+
+	t.Run("ShouldReturnErrorBadRequest_WhenTokenIsUsed", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		m := newPasswordResetMocks()
+		m.passwordReset.On("GetByHash", mock.Anything, helper.Sha256("token")).Return(&model.PasswordReset{
+			Id:        helper.Pointer("reset"),
+			UserId:    helper.Pointer("user"),
+			ExpiresAt: helper.Pointer(time.Now().Add(time.Minute)),
+			UsedAt:    helper.Pointer(time.Now()),
+		}, nil).Once()
+
+		// CODE UNDER TEST
+		uc := usecase.NewPasswordReset(m.container())
+		res, err := uc.Reset(context.Background(), "token", "new password")
+
+		// EXPECTATION
+		require.True(t, model.IsBadRequestError(err))
+		require.Nil(t, res)
+
+		m.assertExpectations(t)
+	})
+
+	t.Run("ShouldReturnErrorBadRequest_WhenTokenIsExpired", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		m := newPasswordResetMocks()
+		m.passwordReset.On("GetByHash", mock.Anything, helper.Sha256("token")).Return(&model.PasswordReset{
+			Id:        helper.Pointer("reset"),
+			UserId:    helper.Pointer("user"),
+			ExpiresAt: helper.Pointer(time.Now().Add(-time.Minute)),
+		}, nil).Once()
+
+		// CODE UNDER TEST
+		uc := usecase.NewPasswordReset(m.container())
+		res, err := uc.Reset(context.Background(), "token", "new password")
+
+		// EXPECTATION
+		require.True(t, model.IsBadRequestError(err))
+		require.Nil(t, res)
+
+		m.assertExpectations(t)
+	})
+
+	t.Run("ShouldReturnErrorBadRequest_WhenTokenIsUsedConcurrently", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		m := newPasswordResetMocks()
+		m.passwordReset.On("GetByHash", mock.Anything, helper.Sha256("token")).Return(&model.PasswordReset{
+			Id:        helper.Pointer("reset"),
+			UserId:    helper.Pointer("user"),
+			ExpiresAt: helper.Pointer(time.Now().Add(time.Minute)),
+		}, nil).Once()
+		m.passwordReset.On("Use", mock.Anything, "reset").
+			Return(model.NewError("password reset is already used", model.ErrorDuplicate)).Once()
+
+		// CODE UNDER TEST
+		uc := usecase.NewPasswordReset(m.container())
+		res, err := uc.Reset(context.Background(), "token", "new password")
+
+		// EXPECTATION
+		require.True(t, model.IsBadRequestError(err))
+		require.Nil(t, res)
+
+		m.assertExpectations(t)
+	})
+
+	t.Run("ShouldReturnErrorBadRequest_WhenTokenIsUnknown", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		m := newPasswordResetMocks()
+		m.passwordReset.On("GetByHash", mock.Anything, helper.Sha256("token")).Return(nil, model.NewNotFoundError()).Once()
+
+		// CODE UNDER TEST
+		uc := usecase.NewPasswordReset(m.container())
+		res, err := uc.Reset(context.Background(), "token", "new password")
+
+		// EXPECTATION
+		require.True(t, model.IsBadRequestError(err))
+		require.Nil(t, res)
+
+		m.assertExpectations(t)
+	})
+}
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+)
+
+// refreshTokenBytes is the entropy of a refresh token, hex encoded for the user
+const refreshTokenBytes = 32
+
+type RefreshToken struct {
+	repository.RefreshToken
+	userRepo repository.User
+	lifetime time.Duration
+}
+
+func NewRefreshToken(r *container.Container) *RefreshToken {
+	return &RefreshToken{
+		RefreshToken: r.RefreshTokenRepo(),
+		userRepo:     r.UserRepo(),
+		lifetime:     time.Duration(r.Config().Token.RefreshSeconds) * time.Second,
+	}
+}
+
+// Issue return the first refresh token of a new family, at the login of the user
+func (r *RefreshToken) Issue(ctx context.Context, userId *string) (*string, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.RefreshToken.Issue")
+
+	if userId == nil {
+		logger.Error("missing user id")
+		return nil, model.NewParameterError(helper.Pointer("missing user id"))
+	}
+
+	token, err := helper.RandomToken(refreshTokenBytes)
+	if err != nil {
+		logger.WithError(err).Error("Failed generate refresh token")
+		return nil, err
+	}
+
+	_, err = r.RefreshToken.Add(ctx, &model.RefreshToken{
+		UserId:    userId,
+		TokenHash: helper.Pointer(helper.Sha256(token)),
+		ExpiresAt: helper.Pointer(time.Now().Add(r.lifetime)),
+	})
+	if err != nil {
+		logger.WithError(err).Warning("Failed insert RefreshToken")
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// Refresh exchange the refresh token for the next one of its family and return the user to issue the access token
+// to. A token is only used once: when a used token is replayed, the token was stolen from either the user or the
+// thief, and the whole family is revoked so both have to login again
+func (r *RefreshToken) Refresh(ctx context.Context, token string) (*model.User, *string, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.RefreshToken.Refresh")
+
+	invalidErr := model.NewError("invalid refresh token", model.ErrorUnauthenticated)
+
+	current, err := r.RefreshToken.GetByHash(ctx, helper.Sha256(token))
+	if err != nil {
+		if model.IsNotFoundError(err) {
+			logger.Warning("Unknown refresh token")
+			return nil, nil, invalidErr
+		}
+		logger.WithError(err).Warning("Failed get RefreshToken")
+		return nil, nil, err
+	}
+	if current.RevokedAt != nil || current.IsExpired(time.Now()) {
+		logger.Warning("Revoked or expired refresh token")
+		return nil, nil, invalidErr
+	}
+	if current.UsedAt != nil {
+		logger.WithField("family_id", *current.FamilyId).Warning("Refresh token reused, revoking its family")
+		if err := r.RefreshToken.RevokeFamily(ctx, *current.FamilyId); err != nil {
+			logger.WithError(err).Error("Failed revoke RefreshToken family")
+			return nil, nil, err
+		}
+		return nil, nil, invalidErr
+	}
+
+	user, err := r.userRepo.Get(ctx, repository.UserGetFilter{Id: current.UserId})
+	if err != nil {
+		if model.IsNotFoundError(err) {
+			return nil, nil, invalidErr
+		}
+		logger.WithError(err).Warning("Failed get User")
+		return nil, nil, err
+	}
+	if user.IsSuspended() {
+		err := model.NewError("user is suspended", model.ErrorUnauthorized)
+		logger.WithError(err).Warning("Suspended user")
+		return nil, nil, err
+	}
+	if user.TokensValidAfter != nil && current.CreatedAt != nil && !current.CreatedAt.After(*user.TokensValidAfter) {
+		logger.Warning("Refresh token issued before the password was reset")
+		return nil, nil, invalidErr
+	}
+
+	next, err := helper.RandomToken(refreshTokenBytes)
+	if err != nil {
+		logger.WithError(err).Error("Failed generate refresh token")
+		return nil, nil, err
+	}
+
+	_, err = r.RefreshToken.Rotate(ctx, *current.Id, &model.RefreshToken{
+		UserId:    current.UserId,
+		FamilyId:  current.FamilyId,
+		TokenHash: helper.Pointer(helper.Sha256(next)),
+		ExpiresAt: helper.Pointer(time.Now().Add(r.lifetime)),
+	})
+	if err != nil {
+		if model.IsDuplicateError(err) {
+			// the same token was used concurrently, which is a replay too
+			logger.WithField("family_id", *current.FamilyId).Warning("Refresh token reused, revoking its family")
+			if err := r.RefreshToken.RevokeFamily(ctx, *current.FamilyId); err != nil {
+				logger.WithError(err).Error("Failed revoke RefreshToken family")
+				return nil, nil, err
+			}
+			return nil, nil, invalidErr
+		}
+		logger.WithError(err).Warning("Failed rotate RefreshToken")
+		return nil, nil, err
+	}
+
+	return user, &next, nil
+}
@@ -0,0 +1,214 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tempo/config"
+	"tempo/container"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+	"tempo/usecase"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func refreshTokenContainer(refreshTokenMock *mocks.RefreshToken, userMock *mocks.User) *container.Container {
+	cfg := config.Config{}
+	cfg.Token.RefreshSeconds = 3600
+
+	appContainer := container.Container{}
+	appContainer.SetConfig(cfg)
+	appContainer.SetRefreshTokenRepo(refreshTokenMock)
+	appContainer.SetUserRepo(userMock)
+
+	return &appContainer
+}
+
+func TestRefreshToken_Issue(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldStoreOnlyTheHashOfTheToken", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		userId := helper.Pointer("user")
+		var stored *model.RefreshToken
+		refreshTokenMock := &mocks.RefreshToken{}
+		refreshTokenMock.On("Add", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			stored = args.Get(1).(*model.RefreshToken)
+		}).Return(&model.RefreshToken{}, nil).Once()
+
+		// CODE UNDER TEST
+		uc := usecase.NewRefreshToken(refreshTokenContainer(refreshTokenMock, &mocks.User{}))
+		res, err := uc.Issue(context.Background(), userId)
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Len(t, *res, 64)
+		require.Equal(t, helper.Sha256(*res), *stored.TokenHash)
+		require.Equal(t, *userId, *stored.UserId)
+		require.Nil(t, stored.FamilyId)
+		require.WithinDuration(t, time.Now().Add(time.Hour), *stored.ExpiresAt, time.Minute)
+
+		refreshTokenMock.AssertExpectations(t)
+	})
+}
+
+func TestRefreshToken_Refresh(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldRotateTheToken", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, nil)
+		current := model.RefreshToken{
+			Id:        helper.Pointer("current"),
+			UserId:    fakeUser.Id,
+			FamilyId:  helper.Pointer("family"),
+			ExpiresAt: helper.Pointer(time.Now().Add(time.Hour)),
+			CreatedAt: helper.Pointer(time.Now().Add(-time.Minute)),
+		}
+
+		refreshTokenMock := &mocks.RefreshToken{}
+		refreshTokenMock.On("GetByHash", mock.Anything, helper.Sha256("token")).Return(&current, nil).Once()
+		refreshTokenMock.On("Rotate", mock.Anything, "current", mock.MatchedBy(func(next *model.RefreshToken) bool {
+			return *next.FamilyId == "family" && *next.UserId == *fakeUser.Id && *next.TokenHash != helper.Sha256("token")
+		})).Return(&model.RefreshToken{}, nil).Once()
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Id: fakeUser.Id}).Return(&fakeUser, nil).Once()
+
+		// CODE UNDER TEST
+		uc := usecase.NewRefreshToken(refreshTokenContainer(refreshTokenMock, userMock))
+		user, next, err := uc.Refresh(context.Background(), "token")
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, *fakeUser.Id, *user.Id)
+		require.NotEqual(t, "token", *next)
+
+		refreshTokenMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldRevokeTheFamily_WhenTokenIsReused", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		used := model.RefreshToken{
+			Id:        helper.Pointer("used"),
+			UserId:    helper.Pointer("user"),
+			FamilyId:  helper.Pointer("family"),
+			ExpiresAt: helper.Pointer(time.Now().Add(time.Hour)),
+			UsedAt:    helper.Pointer(time.Now().Add(-time.Minute)),
+		}
+
+		refreshTokenMock := &mocks.RefreshToken{}
+		refreshTokenMock.On("GetByHash", mock.Anything, helper.Sha256("token")).Return(&used, nil).Once()
+		refreshTokenMock.On("RevokeFamily", mock.Anything, "family").Return(nil).Once()
+
+		// CODE UNDER TEST
+		uc := usecase.NewRefreshToken(refreshTokenContainer(refreshTokenMock, &mocks.User{}))
+		user, next, err := uc.Refresh(context.Background(), "token")
+
+		// EXPECTATION
+		var e model.Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, model.ErrorUnauthenticated, e.Code)
+		require.Nil(t, user)
+		require.Nil(t, next)
+
+		refreshTokenMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldRevokeTheFamily_WhenTokenIsRotatedConcurrently", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, nil)
+		current := model.RefreshToken{
+			Id:        helper.Pointer("current"),
+			UserId:    fakeUser.Id,
+			FamilyId:  helper.Pointer("family"),
+			ExpiresAt: helper.Pointer(time.Now().Add(time.Hour)),
+		}
+
+		refreshTokenMock := &mocks.RefreshToken{}
+		refreshTokenMock.On("GetByHash", mock.Anything, helper.Sha256("token")).Return(&current, nil).Once()
+		refreshTokenMock.On("Rotate", mock.Anything, "current", mock.Anything).
+			Return(nil, model.NewError("refresh token is already used", model.ErrorDuplicate)).Once()
+		refreshTokenMock.On("RevokeFamily", mock.Anything, "family").Return(nil).Once()
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Id: fakeUser.Id}).Return(&fakeUser, nil).Once()
+
+		// CODE UNDER TEST
+		uc := usecase.NewRefreshToken(refreshTokenContainer(refreshTokenMock, userMock))
+		_, _, err := uc.Refresh(context.Background(), "token")
+
+		// EXPECTATION
+		var e model.Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, model.ErrorUnauthenticated, e.Code)
+
+		refreshTokenMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnErrorUnauthenticated_WhenTokenIsExpired", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		expired := model.RefreshToken{
+			Id:        helper.Pointer("expired"),
+			UserId:    helper.Pointer("user"),
+			FamilyId:  helper.Pointer("family"),
+			ExpiresAt: helper.Pointer(time.Now().Add(-time.Second)),
+		}
+
+		refreshTokenMock := &mocks.RefreshToken{}
+		refreshTokenMock.On("GetByHash", mock.Anything, helper.Sha256("token")).Return(&expired, nil).Once()
+
+		// CODE UNDER TEST
+		uc := usecase.NewRefreshToken(refreshTokenContainer(refreshTokenMock, &mocks.User{}))
+		_, _, err := uc.Refresh(context.Background(), "token")
+
+		// EXPECTATION
+		var e model.Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, model.ErrorUnauthenticated, e.Code)
+
+		refreshTokenMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnErrorUnauthenticated_WhenPasswordWasResetAfterTheToken", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.TokensValidAfter = helper.Pointer(time.Now())
+			return user
+		})
+		current := model.RefreshToken{
+			Id:        helper.Pointer("current"),
+			UserId:    fakeUser.Id,
+			FamilyId:  helper.Pointer("family"),
+			ExpiresAt: helper.Pointer(time.Now().Add(time.Hour)),
+			CreatedAt: helper.Pointer(time.Now().Add(-time.Hour)),
+		}
+
+		refreshTokenMock := &mocks.RefreshToken{}
+		refreshTokenMock.On("GetByHash", mock.Anything, helper.Sha256("token")).Return(&current, nil).Once()
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Id: fakeUser.Id}).Return(&fakeUser, nil).Once()
+
+		// CODE UNDER TEST
+		uc := usecase.NewRefreshToken(refreshTokenContainer(refreshTokenMock, userMock))
+		_, _, err := uc.Refresh(context.Background(), "token")
+
+		// EXPECTATION
+		var e model.Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, model.ErrorUnauthenticated, e.Code)
+
+		refreshTokenMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+}
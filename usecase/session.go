@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+
+	"tempo/container"
+	"tempo/denylist"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+)
+
+type Session struct {
+	userRepo         repository.User
+	refreshTokenRepo repository.RefreshToken
+	denylist         *denylist.Denylist
+}
+
+func NewSession(s *container.Container) *Session {
+	return &Session{
+		userRepo:         s.UserRepo(),
+		refreshTokenRepo: s.RefreshTokenRepo(),
+		denylist:         s.Denylist(),
+	}
+}
+
+// Logout revoke the access token until it expires. When the refresh token of the login is given, its family is
+// revoked too, an unknown refresh token or one of another user being ignored
+func (s *Session) Logout(ctx context.Context, userId string, token model.RevokedToken, refreshToken *string) error {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Session.Logout")
+
+	if err := s.denylist.Revoke(ctx, token); err != nil {
+		logger.WithError(err).Warning("Failed revoke the access token")
+		return err
+	}
+
+	if refreshToken == nil {
+		return nil
+	}
+
+	current, err := s.refreshTokenRepo.GetByHash(ctx, helper.Sha256(*refreshToken))
+	if err != nil {
+		if model.IsNotFoundError(err) {
+			return nil
+		}
+		logger.WithError(err).Warning("Failed get RefreshToken")
+		return err
+	}
+	if *current.UserId != userId {
+		logger.Warning("Refresh token of another user")
+		return nil
+	}
+
+	if err = s.refreshTokenRepo.RevokeFamily(ctx, *current.FamilyId); err != nil {
+		logger.WithError(err).Error("Failed revoke RefreshToken family")
+		return err
+	}
+
+	return nil
+}
+
+// reloadUser apply the token state of the user changed here to the denylist at once. The other instances apply it at
+// their next refresh, and so this one when the reload fail
+func reloadUser(ctx context.Context, list *denylist.Denylist, userId string) {
+	if list == nil {
+		return
+	}
+
+	if err := list.Reload(ctx, userId); err != nil {
+		helper.GetLogger(ctx).WithError(err).WithField("userId", userId).Warning("Failed reload the User in the denylist")
+	}
+}
+
+// LogoutAll revoke every token of the user, on every device. The access tokens are rejected as issued before now,
+// the current one being denied too as it can be of the same second
+func (s *Session) LogoutAll(ctx context.Context, userId string, token model.RevokedToken) error {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Session.LogoutAll")
+
+	if err := s.userRepo.RevokeTokens(ctx, userId); err != nil {
+		logger.WithError(err).Warning("Failed revoke the tokens of the User")
+		return err
+	}
+	reloadUser(ctx, s.denylist, userId)
+
+	if err := s.refreshTokenRepo.RevokeUser(ctx, userId); err != nil {
+		logger.WithError(err).Warning("Failed revoke the RefreshTokens of the User")
+		return err
+	}
+
+	if err := s.denylist.Revoke(ctx, token); err != nil {
+		logger.WithError(err).Warning("Failed revoke the access token")
+		return err
+	}
+
+	return nil
+}
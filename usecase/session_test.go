@@ -0,0 +1,127 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tempo/container"
+	"tempo/denylist"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+	"tempo/usecase"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func sessionContainer(revokedTokenMock *mocks.RevokedToken, refreshTokenMock *mocks.RefreshToken, userMock *mocks.User) *container.Container {
+	appContainer := container.Container{}
+	appContainer.SetDenylist(denylist.New(revokedTokenMock, userMock, time.Hour, time.Hour))
+	appContainer.SetRefreshTokenRepo(refreshTokenMock)
+	appContainer.SetUserRepo(userMock)
+
+	return &appContainer
+}
+
+func TestSession_Logout(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldRevokeTheAccessTokenAndTheRefreshTokens", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token := model.RevokedToken{
+			Jti:       helper.Pointer("jti"),
+			UserId:    helper.Pointer("user"),
+			ExpiresAt: helper.Pointer(time.Now().Add(time.Minute)),
+		}
+		revokedTokenMock := &mocks.RevokedToken{}
+		revokedTokenMock.On("Add", mock.Anything, &token).Return(nil).Once()
+		refreshTokenMock := &mocks.RefreshToken{}
+		refreshTokenMock.On("GetByHash", mock.Anything, helper.Sha256("refresh")).Return(&model.RefreshToken{
+			UserId:   helper.Pointer("user"),
+			FamilyId: helper.Pointer("family"),
+		}, nil).Once()
+		refreshTokenMock.On("RevokeFamily", mock.Anything, "family").Return(nil).Once()
+
+		// CODE UNDER TEST
+		uc := usecase.NewSession(sessionContainer(revokedTokenMock, refreshTokenMock, &mocks.User{}))
+		err := uc.Logout(context.Background(), "user", token, helper.Pointer("refresh"))
+
+		// EXPECTATION
+		require.NoError(t, err)
+
+		revokedTokenMock.AssertExpectations(t)
+		refreshTokenMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldIgnoreTheRefreshToken_WhenItIsOfAnotherUser", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token := model.RevokedToken{
+			Jti:       helper.Pointer("jti"),
+			UserId:    helper.Pointer("user"),
+			ExpiresAt: helper.Pointer(time.Now().Add(time.Minute)),
+		}
+		revokedTokenMock := &mocks.RevokedToken{}
+		revokedTokenMock.On("Add", mock.Anything, &token).Return(nil).Once()
+		refreshTokenMock := &mocks.RefreshToken{}
+		refreshTokenMock.On("GetByHash", mock.Anything, helper.Sha256("refresh")).Return(&model.RefreshToken{
+			UserId:   helper.Pointer("another user"),
+			FamilyId: helper.Pointer("family"),
+		}, nil).Once()
+
+		// CODE UNDER TEST
+		uc := usecase.NewSession(sessionContainer(revokedTokenMock, refreshTokenMock, &mocks.User{}))
+		err := uc.Logout(context.Background(), "user", token, helper.Pointer("refresh"))
+
+		// EXPECTATION
+		require.NoError(t, err)
+
+		revokedTokenMock.AssertExpectations(t)
+		refreshTokenMock.AssertExpectations(t)
+	})
+}
+
+func TestSession_LogoutAll(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldRevokeEveryTokenOfTheUser", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		token := model.RevokedToken{
+			Jti:       helper.Pointer("jti"),
+			UserId:    helper.Pointer("user"),
+			ExpiresAt: helper.Pointer(time.Now().Add(time.Minute)),
+		}
+		revokedTokenMock := &mocks.RevokedToken{}
+		revokedTokenMock.On("Add", mock.Anything, &token).Return(nil).Once()
+		revokedTokenMock.On("List", mock.Anything, mock.Anything, (*time.Time)(nil)).Return([]model.RevokedToken{}, nil).Once()
+		revokedTokenMock.On("DeleteExpired", mock.Anything, mock.Anything).Return(nil).Once()
+		refreshTokenMock := &mocks.RefreshToken{}
+		refreshTokenMock.On("RevokeUser", mock.Anything, "user").Return(nil).Once()
+		revokedAt := time.Now()
+		userMock := &mocks.User{}
+		userMock.On("RevokeTokens", mock.Anything, "user").Return(nil).Once()
+		userMock.On("ListRestricted", mock.Anything, mock.Anything).Return([]model.User{}, nil).Once()
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Id: helper.Pointer("user")}).Return(&model.User{
+			Id:               helper.Pointer("user"),
+			TokensValidAfter: &revokedAt,
+		}, nil).Once()
+
+		// CODE UNDER TEST
+		appContainer := sessionContainer(revokedTokenMock, refreshTokenMock, userMock)
+		uc := usecase.NewSession(appContainer)
+		err := uc.LogoutAll(context.Background(), "user", token)
+
+		// EXPECTATION
+		require.NoError(t, err)
+		state, err := appContainer.Denylist().User(context.Background(), "user")
+		require.NoError(t, err)
+		require.Equal(t, &revokedAt, state.TokensValidAfter)
+
+		revokedTokenMock.AssertExpectations(t)
+		refreshTokenMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+}
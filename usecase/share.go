@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"tempo/config"
+	"tempo/container"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+)
+
+type Share struct {
+	newsRepo        repository.News
+	newsAuthorRepo  repository.NewsAuthor
+	site            config.SiteConfig
+	excerptLength   int
+	defaultImageUrl string
+}
+
+func NewShare(s *container.Container) *Share {
+	cfg := s.Config()
+	return &Share{
+		newsRepo:        s.NewsRepo(),
+		newsAuthorRepo:  s.NewsAuthorRepo(),
+		site:            cfg.Site,
+		excerptLength:   cfg.NewsReading.ExcerptLength,
+		defaultImageUrl: cfg.Share.DefaultImageUrl,
+	}
+}
+
+// Preview return the link preview of the news, only a published news has one
+func (s *Share) Preview(ctx context.Context, id string) (*model.NewsPreview, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Share.Preview")
+
+	news, err := s.newsRepo.Get(ctx, &id)
+	if err != nil {
+		logger.WithError(err).Warning("Failed get News")
+		return nil, err
+	}
+	if news.PublishedAt == nil || news.PublishedAt.After(time.Now()) {
+		return nil, model.NewNotFoundError()
+	}
+
+	res := &model.NewsPreview{
+		NewsId:      id,
+		Url:         s.site.NewsUrl(id),
+		Title:       helper.Val(news.Title),
+		Excerpt:     helper.Val(news.Excerpt),
+		ImageUrl:    helper.FirstImageUrl(helper.Val(news.Description)),
+		PublishedAt: news.PublishedAt,
+	}
+	if news.Excerpt == nil {
+		// written before the excerpts were computed and not backfilled yet
+		res.Excerpt = helper.Excerpt(helper.Val(news.Description), s.excerptLength)
+	}
+	if res.ImageUrl == nil && s.defaultImageUrl != "" {
+		res.ImageUrl = helper.Pointer(s.defaultImageUrl)
+	}
+
+	if s.newsAuthorRepo != nil {
+		authors, err := s.newsAuthorRepo.List(ctx, repository.NewsAuthorListFilter{
+			NewsIds: []string{id},
+			Roles:   []model.NewsAuthorRole{model.NewsAuthorOwner, model.NewsAuthorCoAuthor},
+		})
+		if err != nil {
+			logger.WithError(err).Warning("Failed list NewsAuthor")
+			return nil, err
+		}
+		for _, v := range authors {
+			if v.FullName != nil {
+				res.AuthorNames = append(res.AuthorNames, *v.FullName)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// PreviewUrl return the link preview of the news at the url of the public site, or of its share page
+func (s *Share) PreviewUrl(ctx context.Context, rawUrl string) (*model.NewsPreview, error) {
+	id, ok := s.site.NewsId(rawUrl)
+	if !ok {
+		helper.GetLogger(ctx).WithField("method", "usecase.Share.PreviewUrl").Warning("Url is not a news of the site")
+		return nil, model.NewNotFoundError()
+	}
+
+	return s.Preview(ctx, id)
+}
@@ -0,0 +1,81 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tempo/config"
+	"tempo/container"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+	"tempo/usecase"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShare_PreviewUrl(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnTheBylinesAndTheDefaultImage_WhenNewsHasNoImage", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeNews := test.FakeNews(t, func(news model.News) model.News {
+			news.Description = helper.Pointer("The council approved the budget.")
+			news.PublishedAt = helper.Pointer(time.Now().Add(-time.Hour))
+			return news
+		})
+		newsMock := &mocks.News{}
+		newsMock.On("Get", mock.Anything, fakeNews.Id).Return(&fakeNews, nil).Once()
+		newsAuthorMock := &mocks.NewsAuthor{}
+		newsAuthorMock.On("List", mock.Anything, repository.NewsAuthorListFilter{
+			NewsIds: []string{*fakeNews.Id},
+			Roles:   []model.NewsAuthorRole{model.NewsAuthorOwner, model.NewsAuthorCoAuthor},
+		}).Return([]model.NewsAuthor{
+			{FullName: helper.Pointer("Jane Doe")},
+			{FullName: helper.Pointer("John Doe")},
+		}, nil).Once()
+
+		cfg := config.Config{}
+		cfg.Site = config.SiteConfig{BaseUrl: "https://tempo.test", NewsPath: "/stories/%s.html"}
+		cfg.Share.DefaultImageUrl = "https://tempo.test/logo.png"
+		appContainer := container.Container{}
+		appContainer.SetConfig(cfg)
+		appContainer.SetNewsRepo(newsMock)
+		appContainer.SetNewsAuthorRepo(newsAuthorMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewShare(&appContainer)
+		res, err := uc.PreviewUrl(context.Background(), "https://tempo.test/stories/"+*fakeNews.Id+".html?utm_source=chat")
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, "https://tempo.test/stories/"+*fakeNews.Id+".html", res.Url)
+		require.Equal(t, "The council approved the budget.", res.Excerpt)
+		require.Equal(t, "https://tempo.test/logo.png", *res.ImageUrl)
+		require.Equal(t, []string{"Jane Doe", "John Doe"}, res.AuthorNames)
+
+		newsMock.AssertExpectations(t)
+		newsAuthorMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnNotFound_WhenUrlIsNotANewsOfTheSite", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		cfg := config.Config{}
+		cfg.Site = config.SiteConfig{BaseUrl: "https://tempo.test", NewsPath: "/news/%s"}
+		appContainer := container.Container{}
+		appContainer.SetConfig(cfg)
+
+		// CODE UNDER TEST
+		uc := usecase.NewShare(&appContainer)
+		res, err := uc.PreviewUrl(context.Background(), "https://tempo.test/about/team")
+
+		// EXPECTATION
+		require.True(t, model.IsNotFoundError(err))
+		require.Nil(t, res)
+	})
+}
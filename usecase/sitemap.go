@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+
+	"tempo/container"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/sitemap"
+)
+
+type Sitemap struct {
+	cache *sitemap.Cache
+}
+
+func NewSitemap(s *container.Container) *Sitemap {
+	return &Sitemap{
+		cache: s.Sitemap(),
+	}
+}
+
+// Index return the sitemap index, listing the chunks and the Google News sitemap
+func (s *Sitemap) Index(ctx context.Context) ([]byte, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Sitemap.Index")
+
+	if s.cache == nil {
+		return nil, model.NewNotFoundError()
+	}
+
+	res, err := s.cache.Index(ctx)
+	if err != nil {
+		logger.WithError(err).Warning("Failed render sitemap index")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Chunk return the sitemap of the published news in the chunk, numbered from 1
+func (s *Sitemap) Chunk(ctx context.Context, number int) ([]byte, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Sitemap.Chunk")
+
+	if s.cache == nil {
+		return nil, model.NewNotFoundError()
+	}
+
+	res, err := s.cache.Chunk(ctx, number)
+	if err != nil {
+		logger.WithError(err).Warning("Failed render sitemap chunk")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// News return the Google News sitemap of the recently published news
+func (s *Sitemap) News(ctx context.Context) ([]byte, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Sitemap.News")
+
+	if s.cache == nil {
+		return nil, model.NewNotFoundError()
+	}
+
+	res, err := s.cache.News(ctx)
+	if err != nil {
+		logger.WithError(err).Warning("Failed render news sitemap")
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -2,22 +2,38 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"tempo/container"
+	"tempo/denylist"
+	"tempo/event"
 	"tempo/helper"
 	"tempo/model"
+	"tempo/password"
 	"tempo/repository"
-
-	"github.com/segmentio/ksuid"
 )
 
 type User struct {
 	repository.User
+	eventBus event.Bus
+	hasher   password.Hasher
+	denylist *denylist.Denylist
+
+	refreshTokenRepo  repository.RefreshToken
+	emailVerification *EmailVerification
+	// verifiedEmailForLogin refuse the login of the users who did not verify their email
+	verifiedEmailForLogin bool
 }
 
 func NewUser(u *container.Container) *User {
 	return &User{
-		User: u.UserRepo(),
+		User:                  u.UserRepo(),
+		eventBus:              u.EventBus(),
+		hasher:                u.PasswordHasher(),
+		denylist:              u.Denylist(),
+		refreshTokenRepo:      u.RefreshTokenRepo(),
+		emailVerification:     NewEmailVerification(u),
+		verifiedEmailForLogin: u.Config().EmailVerification.RequiredForLogin,
 	}
 }
 
@@ -41,15 +57,25 @@ func (u *User) Register(ctx context.Context, req model.User) (*model.User, error
 		return nil, err
 	}
 
-	passwordSalt := ksuid.New().String()
-	req.Password = helper.Pointer(helper.Hash(passwordSalt, *req.Password))
-	req.PasswordSalt = helper.Pointer(passwordSalt)
+	hash, err := u.hasher.Hash(*req.Password)
+	if err != nil {
+		logger.WithError(err).Error("Failed hash password")
+		return nil, err
+	}
+	req.Password = &hash
+	req.PasswordSalt = nil
 
 	res, err := u.User.Add(ctx, &req)
 	if err != nil {
 		logger.WithError(err).Warning("Failed insert User")
 		return nil, err
 	}
+	publish(ctx, u.eventBus, model.EventUserRegistered, res)
+
+	// the user can ask for another verification email, the registration does not fail with it
+	if err = u.emailVerification.Send(ctx, res); err != nil {
+		logger.WithError(err).Warning("Failed send verification email")
+	}
 
 	return res, nil
 }
@@ -70,16 +96,146 @@ func (u *User) Login(ctx context.Context, req *model.User) (*model.User, error)
 		return nil, err
 	}
 
-	reqPassword := helper.Hash(*user.PasswordSalt, *req.Password)
-	if reqPassword != *user.Password {
+	ok, rehash, err := password.Verify(u.hasher, *req.Password, helper.Val(user.Password), helper.Val(user.PasswordSalt))
+	if err != nil {
+		logger.WithError(err).Error("Failed verify password")
+		return nil, err
+	}
+	if !ok {
 		err := model.NewBadRequestError(helper.Pointer("invalid password"))
 		logger.WithError(err).Warning("Invalid password")
 		return nil, err
 	}
+	if user.IsSuspended() {
+		err := model.NewError("user is suspended", model.ErrorUnauthorized)
+		logger.WithError(err).Warning("Suspended user")
+		return nil, err
+	}
+	if u.verifiedEmailForLogin && !user.IsEmailVerified() {
+		err := model.NewError("email is not verified", model.ErrorUnauthorized)
+		logger.WithError(err).Warning("Unverified email")
+		return nil, err
+	}
+	if rehash {
+		u.upgradePassword(ctx, user, *req.Password)
+	}
 
 	return user, nil
 }
 
+// ChangePassword replace the password of the user, who must give the current one. Tokens issued before the change are rejected
+func (u *User) ChangePassword(ctx context.Context, id *string, currentPassword string, newPassword string) (*model.User, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.User.ChangePassword")
+
+	if id == nil {
+		logger.Error("missing id")
+		return nil, model.NewParameterError(helper.Pointer("missing id"))
+	}
+
+	user, err := u.User.Get(ctx, repository.UserGetFilter{Id: id})
+	if err != nil {
+		logger.WithError(err).Warning("Failed get User")
+		return nil, err
+	}
+
+	ok, _, err := password.Verify(u.hasher, currentPassword, helper.Val(user.Password), helper.Val(user.PasswordSalt))
+	if err != nil {
+		logger.WithError(err).Error("Failed verify password")
+		return nil, err
+	}
+	if !ok {
+		err := model.NewBadRequestError(helper.Pointer("invalid password"))
+		logger.WithError(err).Warning("Invalid password")
+		return nil, err
+	}
+
+	hash, err := u.hasher.Hash(newPassword)
+	if err != nil {
+		logger.WithError(err).Error("Failed hash password")
+		return nil, err
+	}
+
+	res, err := u.User.SetPassword(ctx, *id, hash, nil)
+	if err != nil {
+		logger.WithError(err).Warning("Failed update User password")
+		return nil, err
+	}
+	reloadUser(ctx, u.denylist, *id)
+
+	if err = u.refreshTokenRepo.RevokeUser(ctx, *id); err != nil {
+		logger.WithError(err).Error("Failed revoke RefreshToken of User")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ValidateToken check the token of the user with CheckToken, then replace the role of the token with the current one,
+// so a demoted admin lose the access at once. Every transport authenticating with the jwt token call it
+func (u *User) ValidateToken(ctx context.Context, user *model.User, jti string, issuedAt time.Time) error {
+	if err := u.CheckToken(ctx, user.Id, jti, issuedAt); err != nil {
+		return err
+	}
+
+	role, err := u.CurrentRole(ctx, user.Id, user.Role)
+	if err != nil {
+		return err
+	}
+	user.Role = role
+
+	return nil
+}
+
+// CheckToken reject the token revoked at a logout, or of a user who is suspended, or whose tokens were revoked after
+// the token was issued. It only reads the denylist, which is kept in memory
+func (u *User) CheckToken(ctx context.Context, id *string, jti string, issuedAt time.Time) error {
+	if u.denylist == nil {
+		return nil
+	}
+
+	if jti != "" {
+		revoked, err := u.denylist.IsRevoked(ctx, jti)
+		if err != nil {
+			return err
+		}
+		if revoked {
+			return model.NewError("token is revoked", model.ErrorUnauthorized)
+		}
+	}
+
+	if id == nil {
+		return nil
+	}
+
+	user, err := u.denylist.User(ctx, *id)
+	if err != nil || user == nil {
+		return err
+	}
+	if user.IsSuspended() {
+		return model.NewError("user is suspended", model.ErrorUnauthorized)
+	}
+	if user.TokensValidAfter != nil && !issuedAt.After(*user.TokensValidAfter) {
+		return model.NewError("token is revoked", model.ErrorUnauthorized)
+	}
+
+	return nil
+}
+
+// CurrentRole return the role the user has now, the role of a token being the one at its issue. It only reads the
+// denylist, and return the role of the token when there is none
+func (u *User) CurrentRole(ctx context.Context, id *string, tokenRole *model.UserRole) (*model.UserRole, error) {
+	if u.denylist == nil || id == nil {
+		return tokenRole, nil
+	}
+
+	role, err := u.denylist.Role(ctx, *id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &role, nil
+}
+
 func (u *User) Update(ctx context.Context, email *string, req *model.User) (*model.User, error) {
 	logger := helper.GetLogger(ctx).WithField("method", "usecase.Update")
 
@@ -102,5 +258,30 @@ func (u *User) Update(ctx context.Context, email *string, req *model.User) (*mod
 		return nil, err
 	}
 
+	if req.Email != nil && *req.Email != *user.Email {
+		if err = u.emailVerification.Send(ctx, res); err != nil {
+			logger.WithError(err).Warning("Failed send verification email")
+		}
+	}
+
 	return res, nil
 }
+
+// upgradePassword replace the hash of the password, made by a legacy algorithm or with weaker parameters, while the
+// password is known. The login does not fail with it, the hash is upgraded at a next login
+func (u *User) upgradePassword(ctx context.Context, user *model.User, plain string) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.User.upgradePassword")
+
+	hash, err := u.hasher.Hash(plain)
+	if err != nil {
+		logger.WithError(err).Error("Failed hash password")
+		return
+	}
+
+	if err := u.User.UpgradePassword(ctx, *user.Id, *user.Password, hash); err != nil {
+		logger.WithError(err).Warning("Failed upgrade User password")
+		return
+	}
+	user.Password = &hash
+	user.PasswordSalt = nil
+}
@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+
+	"tempo/container"
+	"tempo/denylist"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/password"
+	"tempo/repository"
+)
+
+// maxUserAdminActions bound the actions returned with a user
+const maxUserAdminActions = 20
+
+type UserAdmin struct {
+	repository.User
+	hasher           password.Hasher
+	denylist         *denylist.Denylist
+	refreshTokenRepo repository.RefreshToken
+	passwordReset    *PasswordReset
+}
+
+func NewUserAdmin(u *container.Container) *UserAdmin {
+	return &UserAdmin{
+		User:             u.UserRepo(),
+		hasher:           u.PasswordHasher(),
+		denylist:         u.Denylist(),
+		refreshTokenRepo: u.RefreshTokenRepo(),
+		passwordReset:    NewPasswordReset(u),
+	}
+}
+
+// List return a page of the users, the newest first
+func (u *UserAdmin) List(ctx context.Context, filter repository.UserListFilter, cursor *string) ([]model.User, *string, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.UserAdmin.List")
+
+	beforeCreatedAt, beforeId, err := decodeCursor(cursor)
+	if err != nil {
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, nil, err
+	}
+	limit := pageLimit(filter.Limit)
+	filter.BeforeCreatedAt = beforeCreatedAt
+	filter.BeforeId = beforeId
+	filter.Limit = limit + 1
+
+	res, err := u.User.List(ctx, filter)
+	if err != nil {
+		logger.WithError(err).Warning("Failed list User")
+		return nil, nil, err
+	}
+
+	var next *string
+	if len(res) > limit {
+		res = res[:limit]
+		last := res[limit-1]
+		next = helper.Pointer(helper.EncodeCursor(*last.CreatedAt, *last.Id))
+	}
+
+	return res, next, nil
+}
+
+// Get return the user with the latest actions of the admins on the account
+func (u *UserAdmin) Get(ctx context.Context, id *string) (*model.UserDetail, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.UserAdmin.Get")
+
+	if id == nil {
+		logger.Error("missing id")
+		return nil, model.NewParameterError(helper.Pointer("missing id"))
+	}
+
+	user, err := u.User.Get(ctx, repository.UserGetFilter{Id: id})
+	if err != nil {
+		logger.WithError(err).Warning("Failed get User")
+		return nil, err
+	}
+
+	actions, err := u.User.ListAdminActions(ctx, *id, maxUserAdminActions)
+	if err != nil {
+		logger.WithError(err).Warning("Failed list UserAdminAction")
+		return nil, err
+	}
+
+	return &model.UserDetail{User: *user, Actions: actions}, nil
+}
+
+// Suspend the user, who can not login nor use their tokens until unsuspended
+func (u *UserAdmin) Suspend(ctx context.Context, id *string, adminId *string, reason string) (*model.User, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.UserAdmin.Suspend")
+
+	if id == nil || adminId == nil {
+		logger.Error("missing id")
+		return nil, model.NewParameterError(helper.Pointer("missing id"))
+	}
+	if *id == *adminId {
+		err := model.NewParameterError(helper.Pointer("an admin can not suspend themself"))
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, err
+	}
+
+	res, err := u.User.Suspend(ctx, *id, *adminId, reason)
+	if err != nil {
+		logger.WithError(err).Warning("Failed suspend User")
+		return nil, err
+	}
+	reloadUser(ctx, u.denylist, *id)
+
+	return res, nil
+}
+
+// Unsuspend the user, who can login again
+func (u *UserAdmin) Unsuspend(ctx context.Context, id *string, adminId *string, reason string) (*model.User, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.UserAdmin.Unsuspend")
+
+	if id == nil || adminId == nil {
+		logger.Error("missing id")
+		return nil, model.NewParameterError(helper.Pointer("missing id"))
+	}
+
+	res, err := u.User.Unsuspend(ctx, *id, *adminId, reason)
+	if err != nil {
+		logger.WithError(err).Warning("Failed unsuspend User")
+		return nil, err
+	}
+	reloadUser(ctx, u.denylist, *id)
+
+	return res, nil
+}
+
+// ResetPassword replace the password of the user by a random one nobody knows and revoke their sessions, then email
+// them a reset link to choose a new one. The admin never sees a password
+func (u *UserAdmin) ResetPassword(ctx context.Context, id *string, adminId *string) (*model.User, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.UserAdmin.ResetPassword")
+
+	if id == nil || adminId == nil {
+		logger.Error("missing id")
+		return nil, model.NewParameterError(helper.Pointer("missing id"))
+	}
+
+	password, err := helper.RandomToken(32)
+	if err != nil {
+		logger.WithError(err).Error("Failed generate password")
+		return nil, err
+	}
+
+	hash, err := u.hasher.Hash(password)
+	if err != nil {
+		logger.WithError(err).Error("Failed hash password")
+		return nil, err
+	}
+
+	res, err := u.User.SetPassword(ctx, *id, hash, adminId)
+	if err != nil {
+		logger.WithError(err).Warning("Failed reset User password")
+		return nil, err
+	}
+	reloadUser(ctx, u.denylist, *id)
+
+	if err = u.refreshTokenRepo.RevokeUser(ctx, *id); err != nil {
+		logger.WithError(err).Error("Failed revoke RefreshToken of User")
+		return nil, err
+	}
+
+	u.passwordReset.dispatchResetEmail(ctx, *res.Email)
+
+	return res, nil
+}
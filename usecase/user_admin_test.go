@@ -0,0 +1,163 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tempo/config"
+	"tempo/container"
+	"tempo/event"
+	"tempo/helper"
+	"tempo/helper/test"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+	"tempo/usecase"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserAdmin_List(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnNextCursor_WhenThereAreMoreUsers", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		now := time.Now()
+		users := make([]model.User, 3)
+		for i := range users {
+			users[i] = test.FakeUser(t, func(user model.User) model.User {
+				user.CreatedAt = helper.Pointer(now.Add(-time.Duration(i) * time.Minute))
+				return user
+			})
+		}
+		userMock := &mocks.User{}
+		userMock.On("List", mock.Anything, repository.UserListFilter{
+			Query: helper.Pointer("john"),
+			Limit: 3,
+		}).Return(users, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetUserRepo(userMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewUserAdmin(&appContainer)
+		res, next, err := uc.List(context.Background(), repository.UserListFilter{
+			Query: helper.Pointer("john"),
+			Limit: 2,
+		}, nil)
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		require.NotNil(t, next)
+		require.Equal(t, helper.EncodeCursor(*users[1].CreatedAt, *users[1].Id), *next)
+
+		userMock.AssertExpectations(t)
+	})
+}
+
+func TestUserAdmin_Suspend(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnParameterError_WhenAdminSuspendThemself", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		appContainer := container.Container{}
+
+		// CODE UNDER TEST
+		uc := usecase.NewUserAdmin(&appContainer)
+		res, err := uc.Suspend(context.Background(), helper.Pointer("admin"), helper.Pointer("admin"), "spam")
+
+		// EXPECTATION
+		require.Error(t, err)
+		require.True(t, model.IsParameterError(err))
+		require.Nil(t, res)
+	})
+
+	t.Run("ShouldSuspendTheUser", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.SuspendedAt = helper.Pointer(time.Now())
+			user.SuspendedReason = helper.Pointer("spam")
+			user.SuspendedBy = helper.Pointer("admin")
+			return user
+		})
+		userMock := &mocks.User{}
+		userMock.On("Suspend", mock.Anything, *fakeUser.Id, "admin", "spam").Return(&fakeUser, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetUserRepo(userMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewUserAdmin(&appContainer)
+		res, err := uc.Suspend(context.Background(), fakeUser.Id, helper.Pointer("admin"), "spam")
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.True(t, res.IsSuspended())
+
+		userMock.AssertExpectations(t)
+	})
+}
+
+func TestUserAdmin_ResetPassword(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReplaceThePasswordAndMailAResetLink", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, nil)
+		var hash string
+		userMock := &mocks.User{}
+		userMock.On("SetPassword", mock.Anything, *fakeUser.Id, mock.Anything, helper.Pointer("admin")).
+			Run(func(args mock.Arguments) {
+				hash = args.String(2)
+			}).
+			Return(&fakeUser, nil).Once()
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Email: fakeUser.Email}).Return(&fakeUser, nil).Once()
+		refreshTokenMock := &mocks.RefreshToken{}
+		refreshTokenMock.On("RevokeUser", mock.Anything, *fakeUser.Id).Return(nil).Once()
+		passwordResetMock := &mocks.PasswordReset{}
+		passwordResetMock.On("Add", mock.Anything, mock.Anything).Return(&model.PasswordReset{}, nil).Once()
+		mailer := &test.Mailer{}
+
+		cfg := config.Config{}
+		cfg.Site.BaseUrl = "https://tempo.dev"
+		cfg.PasswordReset.TokenMinutes = 30
+		cfg.PasswordReset.Path = "/reset-password?token=%s"
+
+		bus := event.NewAsyncBus(1, 10)
+		appContainer := container.Container{}
+		appContainer.SetConfig(cfg)
+		appContainer.SetUserRepo(userMock)
+		appContainer.SetRefreshTokenRepo(refreshTokenMock)
+		appContainer.SetPasswordResetRepo(passwordResetMock)
+		appContainer.SetPasswordHasher(testHasher)
+		appContainer.SetMailer(mailer)
+		appContainer.SetEventBus(bus)
+		usecase.NewPasswordReset(&appContainer).Subscribe(bus)
+		bus.Start()
+
+		// CODE UNDER TEST
+		uc := usecase.NewUserAdmin(&appContainer)
+		res, err := uc.ResetPassword(context.Background(), fakeUser.Id, helper.Pointer("admin"))
+		bus.Close()
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, *fakeUser.Id, *res.Id)
+		require.NotEmpty(t, hash)
+		ok, err := testHasher.Verify(*fakeUser.Password, hash)
+		require.NoError(t, err)
+		require.False(t, ok)
+		messages := mailer.Messages()
+		require.Len(t, messages, 1)
+		require.Equal(t, *fakeUser.Email, messages[0].To)
+		require.Contains(t, messages[0].Body, "https://tempo.dev/reset-password?token=")
+
+		userMock.AssertExpectations(t)
+		refreshTokenMock.AssertExpectations(t)
+		passwordResetMock.AssertExpectations(t)
+	})
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"tempo/config"
 	"tempo/container"
+	"tempo/denylist"
 	"tempo/helper"
 	"tempo/helper/test"
 	"tempo/model"
+	"tempo/password"
 	"tempo/repository"
 	"tempo/repository/mocks"
 	"tempo/usecase"
@@ -18,6 +22,9 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// testHasher keep the tests fast, with the smallest Argon2id parameters
+var testHasher = password.Argon2id{Memory: 1024, Time: 1, Threads: 1}
+
 func TestUser_Register(t *testing.T) {
 	t.Parallel()
 	t.Run("ShouldReturnError_WhenEmailIsMissing", func(t *testing.T) {
@@ -53,6 +60,7 @@ func TestUser_Register(t *testing.T) {
 
 		appContainer := container.Container{}
 		appContainer.SetUserRepo(userMock)
+		appContainer.SetPasswordHasher(testHasher)
 
 		// CODE UNDER TEST
 		uc := usecase.NewUser(&appContainer)
@@ -80,6 +88,7 @@ func TestUser_Register(t *testing.T) {
 
 		appContainer := container.Container{}
 		appContainer.SetUserRepo(userMock)
+		appContainer.SetPasswordHasher(testHasher)
 
 		// CODE UNDER TEST
 		uc := usecase.NewUser(&appContainer)
@@ -107,13 +116,16 @@ func TestUser_Register(t *testing.T) {
 			require.Equal(t, *fakeUser.Email, *u.Email)
 			require.Equal(t, *fakeUser.FullName, *u.FullName)
 
-			password := helper.Pointer(helper.Hash(*u.PasswordSalt, *fakeUser.Password))
-			require.Equal(t, *password, *u.Password)
+			ok, err := testHasher.Verify(*fakeUser.Password, *u.Password)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Nil(t, u.PasswordSalt)
 			return true
 		})).Return(nil, errors.New("error insert")).Once()
 
 		appContainer := container.Container{}
 		appContainer.SetUserRepo(userMock)
+		appContainer.SetPasswordHasher(testHasher)
 
 		// CODE UNDER TEST
 		uc := usecase.NewUser(&appContainer)
@@ -141,8 +153,10 @@ func TestUser_Register(t *testing.T) {
 			require.Equal(t, *fakeUser.Email, *u.Email)
 			require.Equal(t, *fakeUser.FullName, *u.FullName)
 
-			password := helper.Pointer(helper.Hash(*u.PasswordSalt, *fakeUser.Password))
-			require.Equal(t, *password, *u.Password)
+			ok, err := testHasher.Verify(*fakeUser.Password, *u.Password)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Nil(t, u.PasswordSalt)
 			return true
 		})).Return(&model.User{
 			Id:           helper.Pointer(fake.CharactersN(6)),
@@ -153,13 +167,21 @@ func TestUser_Register(t *testing.T) {
 			CreatedAt:    fakeUser.CreatedAt,
 		}, nil).Once()
 
+		mailer := &test.Mailer{}
+		cfg := config.Config{JwtSecret: "secret"}
+		cfg.EmailVerification.Secret = "email-verification-secret"
 		appContainer := container.Container{}
+		appContainer.SetConfig(cfg)
 		appContainer.SetUserRepo(userMock)
+		appContainer.SetPasswordHasher(testHasher)
+		appContainer.SetMailer(mailer)
 
 		// CODE UNDER TEST
 		uc := usecase.NewUser(&appContainer)
 		res, err := uc.Register(context.Background(), fakeUser)
 		require.NoError(t, err)
+		require.Len(t, mailer.Messages(), 1)
+		require.Equal(t, *fakeUser.Email, mailer.Messages()[0].To)
 		require.NotNil(t, res.Id)
 		require.Equal(t, *fakeUser.Email, *res.Email)
 		require.Equal(t, *fakeUser.FullName, *res.FullName)
@@ -249,11 +271,13 @@ func TestUser_Login(t *testing.T) {
 	t.Run("ShouldLoginSuccess_WhenEmailAndPasswordAreCorrect", func(t *testing.T) {
 		t.Parallel()
 		// INIT
-		password := fake.CharactersN(7)
+		plain := fake.CharactersN(7)
+		hash, err := testHasher.Hash(plain)
+		require.NoError(t, err)
 		fakeUser := test.FakeUser(t, func(user model.User) model.User {
 			user.Email = helper.Pointer("fakeemail@gmail.com")
-			user.PasswordSalt = helper.Pointer(fake.CharactersN(7))
-			user.Password = helper.Pointer(helper.Hash(*user.PasswordSalt, password))
+			user.PasswordSalt = nil
+			user.Password = &hash
 			return user
 		})
 
@@ -264,19 +288,172 @@ func TestUser_Login(t *testing.T) {
 
 		appContainer := container.Container{}
 		appContainer.SetUserRepo(userMock)
+		appContainer.SetPasswordHasher(testHasher)
 
 		// CODE UNDER TEST
 		uc := usecase.NewUser(&appContainer)
 		res, err := uc.Login(context.Background(), &model.User{
 			Email:    fakeUser.Email,
-			Password: &password,
+			Password: &plain,
 		})
 		require.NoError(t, err)
 		require.NotNil(t, res)
 		require.Equal(t, *fakeUser.Email, *res.Email)
 		require.Equal(t, *fakeUser.FullName, *res.FullName)
-		require.Equal(t, *fakeUser.Password, *res.Password)
-		require.Equal(t, *fakeUser.PasswordSalt, *res.PasswordSalt)
+		require.Equal(t, hash, *res.Password)
+
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldUpgradeTheHash_WhenItIsALegacyHash", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		plain := fake.CharactersN(7)
+		salt := fake.CharactersN(7)
+		legacyHash := helper.Hash(salt, plain)
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Email = helper.Pointer("legacy@gmail.com")
+			user.PasswordSalt = &salt
+			user.Password = &legacyHash
+			return user
+		})
+
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{
+			Email: fakeUser.Email,
+		}).Return(&fakeUser, nil).Once()
+		userMock.On("UpgradePassword", mock.Anything, *fakeUser.Id, legacyHash, mock.MatchedBy(func(hash string) bool {
+			ok, err := testHasher.Verify(plain, hash)
+			return err == nil && ok
+		})).Return(nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetUserRepo(userMock)
+		appContainer.SetPasswordHasher(testHasher)
+
+		// CODE UNDER TEST
+		uc := usecase.NewUser(&appContainer)
+		res, err := uc.Login(context.Background(), &model.User{
+			Email:    fakeUser.Email,
+			Password: &plain,
+		})
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.NotEqual(t, legacyHash, *res.Password)
+		require.Nil(t, res.PasswordSalt)
+
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldLogin_WhenUpgradingTheHashFailed", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		plain := fake.CharactersN(7)
+		bcryptHash, err := password.Bcrypt{Cost: 4}.Hash(plain)
+		require.NoError(t, err)
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Email = helper.Pointer("bcrypt@gmail.com")
+			user.PasswordSalt = nil
+			user.Password = &bcryptHash
+			return user
+		})
+
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{
+			Email: fakeUser.Email,
+		}).Return(&fakeUser, nil).Once()
+		userMock.On("UpgradePassword", mock.Anything, *fakeUser.Id, bcryptHash, mock.Anything).Return(errors.New("error update")).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetUserRepo(userMock)
+		appContainer.SetPasswordHasher(testHasher)
+
+		// CODE UNDER TEST
+		uc := usecase.NewUser(&appContainer)
+		res, err := uc.Login(context.Background(), &model.User{
+			Email:    fakeUser.Email,
+			Password: &plain,
+		})
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, bcryptHash, *res.Password)
+
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnErrorUnauthorized_WhenUserIsSuspended", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		password := fake.CharactersN(7)
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Email = helper.Pointer("suspended@gmail.com")
+			user.PasswordSalt = helper.Pointer(fake.CharactersN(7))
+			user.Password = helper.Pointer(helper.Hash(*user.PasswordSalt, password))
+			user.SuspendedAt = helper.Pointer(time.Now())
+			return user
+		})
+
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{
+			Email: fakeUser.Email,
+		}).Return(&fakeUser, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetUserRepo(userMock)
+		appContainer.SetPasswordHasher(testHasher)
+
+		// CODE UNDER TEST
+		uc := usecase.NewUser(&appContainer)
+		res, err := uc.Login(context.Background(), &model.User{
+			Email:    fakeUser.Email,
+			Password: &password,
+		})
+
+		// EXPECTATION
+		var e model.Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, model.ErrorUnauthorized, e.Code)
+		require.Nil(t, res)
+
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnErrorUnauthorized_WhenEmailIsNotVerifiedAndItIsRequired", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Email = helper.Pointer("unverified@gmail.com")
+			return user
+		})
+		hash, err := testHasher.Hash(*fakeUser.Password)
+		require.NoError(t, err)
+		password := *fakeUser.Password
+		fakeUser.Password = &hash
+
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{
+			Email: fakeUser.Email,
+		}).Return(&fakeUser, nil).Once()
+
+		cfg := config.Config{}
+		cfg.EmailVerification.RequiredForLogin = true
+		appContainer := container.Container{}
+		appContainer.SetConfig(cfg)
+		appContainer.SetUserRepo(userMock)
+		appContainer.SetPasswordHasher(testHasher)
+
+		// CODE UNDER TEST
+		uc := usecase.NewUser(&appContainer)
+		res, err := uc.Login(context.Background(), &model.User{
+			Email:    fakeUser.Email,
+			Password: &password,
+		})
+
+		// EXPECTATION
+		require.EqualError(t, err, "email is not verified")
+		require.Nil(t, res)
 
 		userMock.AssertExpectations(t)
 	})
@@ -389,3 +566,256 @@ func TestUser_Update(t *testing.T) {
 		userMock.AssertExpectations(t)
 	})
 }
+
+// checkTokenDenylist return the denylist loading the revoked tokens and the restricted users, the users are never read
+// one by one
+func checkTokenDenylist(tokens []model.RevokedToken, users []model.User) (*denylist.Denylist, *mocks.RevokedToken, *mocks.User) {
+	revokedTokenMock := &mocks.RevokedToken{}
+	revokedTokenMock.On("List", mock.Anything, mock.Anything, (*time.Time)(nil)).Return(tokens, nil).Once()
+	revokedTokenMock.On("DeleteExpired", mock.Anything, mock.Anything).Return(nil).Once()
+	userMock := &mocks.User{}
+	userMock.On("ListRestricted", mock.Anything, mock.Anything).Return(users, nil).Once()
+
+	return denylist.New(revokedTokenMock, userMock, time.Hour, time.Hour), revokedTokenMock, userMock
+}
+
+func TestUser_CheckToken(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldRejectTheToken_WhenUserIsSuspended", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.SuspendedAt = helper.Pointer(time.Now())
+			return user
+		})
+		list, revokedTokenMock, userMock := checkTokenDenylist([]model.RevokedToken{}, []model.User{fakeUser})
+
+		appContainer := container.Container{}
+		appContainer.SetUserRepo(userMock)
+		appContainer.SetDenylist(list)
+
+		// CODE UNDER TEST
+		uc := usecase.NewUser(&appContainer)
+		err := uc.CheckToken(context.Background(), fakeUser.Id, "jti", time.Now())
+
+		// EXPECTATION
+		require.EqualError(t, err, "user is suspended")
+
+		revokedTokenMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldRejectTheToken_WhenIssuedBeforeThePasswordReset", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		resetAt := time.Now()
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.TokensValidAfter = &resetAt
+			return user
+		})
+		list, revokedTokenMock, userMock := checkTokenDenylist([]model.RevokedToken{}, []model.User{fakeUser})
+
+		appContainer := container.Container{}
+		appContainer.SetUserRepo(userMock)
+		appContainer.SetDenylist(list)
+
+		// CODE UNDER TEST
+		uc := usecase.NewUser(&appContainer)
+		oldErr := uc.CheckToken(context.Background(), fakeUser.Id, "jti", resetAt.Add(-time.Millisecond))
+		sameErr := uc.CheckToken(context.Background(), fakeUser.Id, "jti", resetAt)
+		newErr := uc.CheckToken(context.Background(), fakeUser.Id, "jti", resetAt.Add(time.Microsecond))
+		otherErr := uc.CheckToken(context.Background(), helper.Pointer("other"), "jti", resetAt.Add(-time.Second))
+
+		// EXPECTATION
+		require.EqualError(t, oldErr, "token is revoked")
+		require.EqualError(t, sameErr, "token is revoked")
+		require.NoError(t, newErr)
+		require.NoError(t, otherErr)
+
+		revokedTokenMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldRejectTheToken_WhenItIsInTheDenylist", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, nil)
+		list, revokedTokenMock, userMock := checkTokenDenylist([]model.RevokedToken{
+			{Jti: helper.Pointer("revoked"), ExpiresAt: helper.Pointer(time.Now().Add(time.Minute))},
+		}, []model.User{})
+
+		appContainer := container.Container{}
+		appContainer.SetUserRepo(userMock)
+		appContainer.SetDenylist(list)
+
+		// CODE UNDER TEST
+		uc := usecase.NewUser(&appContainer)
+		revokedErr := uc.CheckToken(context.Background(), fakeUser.Id, "revoked", time.Now())
+		otherErr := uc.CheckToken(context.Background(), fakeUser.Id, "other", time.Now())
+
+		// EXPECTATION
+		require.EqualError(t, revokedErr, "token is revoked")
+		require.NoError(t, otherErr)
+
+		revokedTokenMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+}
+
+func TestUser_ValidateToken(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReplaceTheRoleOfTheToken_WhenTheUserWasDemoted", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		demoted := test.FakeUser(t, func(user model.User) model.User {
+			user.Role = helper.Pointer(model.UserRoleAdmin)
+			return user
+		})
+		list, revokedTokenMock, userMock := checkTokenDenylist([]model.RevokedToken{}, []model.User{})
+
+		appContainer := container.Container{}
+		appContainer.SetUserRepo(userMock)
+		appContainer.SetDenylist(list)
+
+		// CODE UNDER TEST
+		uc := usecase.NewUser(&appContainer)
+		err := uc.ValidateToken(context.Background(), &demoted, "jti", time.Now())
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, model.UserRoleUser, *demoted.Role)
+
+		revokedTokenMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldRejectTheToken_WhenUserIsSuspended", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.SuspendedAt = helper.Pointer(time.Now())
+			return user
+		})
+		list, revokedTokenMock, userMock := checkTokenDenylist([]model.RevokedToken{}, []model.User{fakeUser})
+
+		appContainer := container.Container{}
+		appContainer.SetUserRepo(userMock)
+		appContainer.SetDenylist(list)
+
+		// CODE UNDER TEST
+		uc := usecase.NewUser(&appContainer)
+		err := uc.ValidateToken(context.Background(), &fakeUser, "jti", time.Now())
+
+		// EXPECTATION
+		require.EqualError(t, err, "user is suspended")
+
+		revokedTokenMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+}
+
+func TestUser_CurrentRole(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnTheRoleOfTheDenylist_WhenTheTokenRoleChanged", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		admin := test.FakeUser(t, func(user model.User) model.User {
+			user.Role = helper.Pointer(model.UserRoleAdmin)
+			return user
+		})
+		demoted := test.FakeUser(t, nil)
+		list, revokedTokenMock, userMock := checkTokenDenylist([]model.RevokedToken{}, []model.User{admin})
+
+		appContainer := container.Container{}
+		appContainer.SetUserRepo(userMock)
+		appContainer.SetDenylist(list)
+
+		// CODE UNDER TEST
+		uc := usecase.NewUser(&appContainer)
+		adminRole, adminErr := uc.CurrentRole(context.Background(), admin.Id, nil)
+		demotedRole, demotedErr := uc.CurrentRole(context.Background(), demoted.Id, helper.Pointer(model.UserRoleAdmin))
+
+		// EXPECTATION
+		require.NoError(t, adminErr)
+		require.Equal(t, model.UserRoleAdmin, *adminRole)
+		require.NoError(t, demotedErr)
+		require.Equal(t, model.UserRoleUser, *demotedRole)
+
+		revokedTokenMock.AssertExpectations(t)
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnTheTokenRole_WhenThereIsNoDenylist", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, nil)
+
+		// CODE UNDER TEST
+		uc := usecase.NewUser(&container.Container{})
+		role, err := uc.CurrentRole(context.Background(), fakeUser.Id, helper.Pointer(model.UserRoleAdmin))
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.Equal(t, model.UserRoleAdmin, *role)
+	})
+}
+
+func TestUser_ChangePassword(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnError_WhenCurrentPasswordIsIncorrect", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, nil)
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Id: fakeUser.Id}).Return(&fakeUser, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetUserRepo(userMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewUser(&appContainer)
+		res, err := uc.ChangePassword(context.Background(), fakeUser.Id, "wrong password", "new password")
+
+		// EXPECTATION
+		require.EqualError(t, err, model.NewBadRequestError(helper.Pointer("invalid password")).Error())
+		require.Nil(t, res)
+
+		userMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldSetTheNewPassword_WhenCurrentPasswordIsCorrect", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		fakeUser := test.FakeUser(t, func(user model.User) model.User {
+			user.Password = helper.Pointer(helper.Hash(*user.PasswordSalt, "current password"))
+			return user
+		})
+		userMock := &mocks.User{}
+		userMock.On("Get", mock.Anything, repository.UserGetFilter{Id: fakeUser.Id}).Return(&fakeUser, nil).Once()
+		userMock.On("SetPassword", mock.Anything, *fakeUser.Id, mock.Anything, (*string)(nil)).
+			Run(func(args mock.Arguments) {
+				ok, err := testHasher.Verify("new password", args.String(2))
+				require.NoError(t, err)
+				require.True(t, ok)
+			}).
+			Return(&fakeUser, nil).Once()
+		refreshTokenMock := &mocks.RefreshToken{}
+		refreshTokenMock.On("RevokeUser", mock.Anything, *fakeUser.Id).Return(nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetUserRepo(userMock)
+		appContainer.SetRefreshTokenRepo(refreshTokenMock)
+		appContainer.SetPasswordHasher(testHasher)
+
+		// CODE UNDER TEST
+		uc := usecase.NewUser(&appContainer)
+		res, err := uc.ChangePassword(context.Background(), fakeUser.Id, "current password", "new password")
+
+		// EXPECTATION
+		require.NoError(t, err)
+		require.NotNil(t, res)
+
+		userMock.AssertExpectations(t)
+		refreshTokenMock.AssertExpectations(t)
+	})
+}
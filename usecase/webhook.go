@@ -0,0 +1,516 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"tempo/config"
+	"tempo/container"
+	"tempo/event"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+)
+
+const (
+	WebhookHeaderEvent     = "X-Tempo-Event"
+	WebhookHeaderDelivery  = "X-Tempo-Delivery"
+	WebhookHeaderTimestamp = "X-Tempo-Timestamp"
+	// WebhookHeaderSignature is "sha256=" followed by the hex HMAC-SHA256 of "<timestamp>.<body>" keyed with the webhook secret
+	WebhookHeaderSignature = "X-Tempo-Signature"
+
+	webhookSecretPrefix      = "whsec_"
+	webhookResponseBodyLimit = 1024
+	webhookRetryBatchSize    = 100
+)
+
+type Webhook struct {
+	repository.Webhook
+	config config.Config
+	client *http.Client
+	// wake tell Run that Dispatch recorded new deliveries, so they are sent without waiting for the next tick
+	wake chan struct{}
+}
+
+func NewWebhook(n *container.Container) *Webhook {
+	cfg := n.Config()
+	return &Webhook{
+		Webhook: n.WebhookRepo(),
+		config:  cfg,
+		client: &http.Client{
+			Timeout: time.Duration(cfg.Webhook.TimeoutSeconds) * time.Second,
+		},
+		wake: make(chan struct{}, 1),
+	}
+}
+
+func (w *Webhook) Add(ctx context.Context, req *model.Webhook) (*model.Webhook, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Webhook.Add")
+
+	if err := req.Validate(); err != nil {
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, model.NewParameterError(helper.Pointer(err.Error()))
+	}
+
+	if req.Secret == nil {
+		token, err := helper.RandomToken(24)
+		if err != nil {
+			logger.WithError(err).Warning("Failed generate secret")
+			return nil, err
+		}
+		req.Secret = helper.Pointer(webhookSecretPrefix + token)
+	}
+	req.Active = helper.Pointer(true)
+
+	res, err := w.Webhook.Add(ctx, req)
+	if err != nil {
+		logger.WithError(err).Warning("Failed insert Webhook")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (w *Webhook) Get(ctx context.Context, id *string) (*model.Webhook, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Webhook.Get")
+
+	if id == nil {
+		logger.Error("missing id")
+		return nil, model.NewParameterError(helper.Pointer("missing id"))
+	}
+
+	res, err := w.Webhook.Get(ctx, *id)
+	if err != nil {
+		logger.WithError(err).Warning("Failed get Webhook")
+		return nil, err
+	}
+	res.Secret = nil
+
+	return res, nil
+}
+
+func (w *Webhook) List(ctx context.Context) ([]model.Webhook, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Webhook.List")
+
+	res, err := w.Webhook.List(ctx, repository.WebhookListFilter{})
+	if err != nil {
+		logger.WithError(err).Warning("Failed list Webhook")
+		return nil, err
+	}
+	for i := range res {
+		res[i].Secret = nil
+	}
+
+	return res, nil
+}
+
+// Update change the webhook, enabling it again also clear its failure streak
+func (w *Webhook) Update(ctx context.Context, id *string, req *model.Webhook) (*model.Webhook, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Webhook.Update")
+
+	if id == nil {
+		logger.Error("missing id")
+		return nil, model.NewParameterError(helper.Pointer("missing id"))
+	}
+
+	if err := req.ValidateUpdate(); err != nil {
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, model.NewParameterError(helper.Pointer(err.Error()))
+	}
+
+	res, err := w.Webhook.Update(ctx, *id, req)
+	if err != nil {
+		logger.WithError(err).Warning("Failed update Webhook")
+		return nil, err
+	}
+	res.Secret = nil
+
+	return res, nil
+}
+
+func (w *Webhook) Delete(ctx context.Context, id *string) error {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Webhook.Delete")
+
+	if id == nil {
+		logger.Error("missing id")
+		return model.NewParameterError(helper.Pointer("missing id"))
+	}
+
+	if err := w.Webhook.Delete(ctx, *id); err != nil {
+		logger.WithError(err).Warning("Failed delete Webhook")
+		return err
+	}
+
+	return nil
+}
+
+// ListDeliveries return a page of the webhook delivery log, newest first, and the cursor of the next page if there is one
+func (w *Webhook) ListDeliveries(ctx context.Context, filter repository.WebhookDeliveryListFilter, cursor *string) ([]model.WebhookDelivery, *string, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Webhook.ListDeliveries")
+
+	if filter.WebhookId == nil {
+		logger.Error("missing webhook id")
+		return nil, nil, model.NewParameterError(helper.Pointer("missing webhook id"))
+	}
+
+	if _, err := w.Webhook.Get(ctx, *filter.WebhookId); err != nil {
+		logger.WithError(err).Warning("Failed get Webhook")
+		return nil, nil, err
+	}
+
+	afterCreatedAt, afterId, err := decodeCursor(cursor)
+	if err != nil {
+		logger.WithError(err).Warning("Not Valid Request")
+		return nil, nil, err
+	}
+	limit := pageLimit(filter.Limit)
+	filter.AfterCreatedAt = afterCreatedAt
+	filter.AfterId = afterId
+	filter.Limit = limit + 1
+
+	res, err := w.Webhook.ListDeliveries(ctx, filter)
+	if err != nil {
+		logger.WithError(err).Warning("Failed list WebhookDelivery")
+		return nil, nil, err
+	}
+
+	var next *string
+	if len(res) > limit {
+		res = res[:limit]
+		last := res[limit-1]
+		next = helper.Pointer(helper.EncodeCursor(*last.CreatedAt, *last.Id))
+	}
+
+	return res, next, nil
+}
+
+// Redeliver send the payload of a past delivery again as a new delivery, which is retried like any other if it fails
+func (w *Webhook) Redeliver(ctx context.Context, webhookId *string, deliveryId *string) (*model.WebhookDelivery, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Webhook.Redeliver")
+
+	if webhookId == nil || deliveryId == nil {
+		logger.Error("missing id")
+		return nil, model.NewParameterError(helper.Pointer("missing id"))
+	}
+
+	webhook, err := w.Webhook.Get(ctx, *webhookId)
+	if err != nil {
+		logger.WithError(err).Warning("Failed get Webhook")
+		return nil, err
+	}
+
+	delivery, err := w.Webhook.GetDelivery(ctx, *webhookId, *deliveryId)
+	if err != nil {
+		logger.WithError(err).Warning("Failed get WebhookDelivery")
+		return nil, err
+	}
+
+	res, err := w.deliver(ctx, webhook, delivery.EventId, delivery.EventType, delivery.Payload)
+	if err != nil {
+		logger.WithError(err).Warning("Failed redeliver")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Subscribe register the webhook dispatcher on the event bus for every event a webhook can subscribe to
+func (w *Webhook) Subscribe(bus event.Bus) {
+	for _, v := range model.WebhookEventTypes {
+		bus.Subscribe(v, w.Dispatch)
+	}
+}
+
+// Dispatch record a delivery of the event for every active webhook subscribed to it. The deliveries are due at once
+// and sent by the worker pool of Run, so a slow endpoint never hold the event bus workers.
+func (w *Webhook) Dispatch(ctx context.Context, e model.Event) error {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Webhook.Dispatch").WithField("eventType", e.Type)
+
+	webhooks, err := w.Webhook.List(ctx, repository.WebhookListFilter{
+		ActiveOnly: true,
+		EventType:  &e.Type,
+	})
+	if err != nil {
+		logger.WithError(err).Warning("Failed list Webhook")
+		return err
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(e)
+	if err != nil {
+		logger.WithError(err).Warning("Failed marshal event")
+		return err
+	}
+
+	for i := range webhooks {
+		// next_attempt_at is stored in seconds, truncating keep the delivery due instead of rounding it up
+		_, err = w.Webhook.AddDelivery(ctx, &model.WebhookDelivery{
+			WebhookId:     webhooks[i].Id,
+			EventId:       &e.Id,
+			EventType:     &e.Type,
+			Payload:       helper.Pointer(string(payload)),
+			Status:        helper.Pointer(model.WebhookDeliveryPending),
+			Attempts:      helper.Pointer(0),
+			NextAttemptAt: helper.Pointer(time.Now().Truncate(time.Second)),
+		})
+		if err != nil {
+			logger.WithError(err).WithField("webhookId", *webhooks[i].Id).Warning("Failed insert WebhookDelivery")
+		}
+	}
+
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// RetryDue attempt the deliveries that are due, new ones and those whose backoff elapsed, on the worker pool.
+// It is safe to run on several instances at once.
+func (w *Webhook) RetryDue(ctx context.Context) error {
+	_, err := w.retryDue(ctx)
+	return err
+}
+
+// retryDue return how many due deliveries were listed, a full batch mean more may be waiting
+func (w *Webhook) retryDue(ctx context.Context) (int, error) {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Webhook.RetryDue")
+
+	now := time.Now()
+	deliveries, err := w.Webhook.ListDueDeliveries(ctx, now, webhookRetryBatchSize)
+	if err != nil {
+		logger.WithError(err).Warning("Failed list due WebhookDelivery")
+		return 0, err
+	}
+
+	workers := w.config.Webhook.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+	queue := make(chan *model.WebhookDelivery)
+	errs := make(chan error, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var firstErr error
+			for delivery := range queue {
+				// a failing delivery must not hold back the others, it stays due and is retried later
+				if err := w.retry(ctx, delivery, now); err != nil && firstErr == nil {
+					firstErr = err
+				}
+			}
+			errs <- firstErr
+		}()
+	}
+	for i := range deliveries {
+		queue <- &deliveries[i]
+	}
+	close(queue)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			return len(deliveries), err
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+func (w *Webhook) retry(ctx context.Context, delivery *model.WebhookDelivery, now time.Time) error {
+	logger := helper.GetLogger(ctx).WithField("method", "usecase.Webhook.RetryDue").WithField("deliveryId", *delivery.Id)
+
+	claimed, err := w.Webhook.ClaimDelivery(ctx, *delivery.Id, now, w.leaseUntil())
+	if err != nil {
+		logger.WithError(err).Warning("Failed claim WebhookDelivery")
+		return err
+	}
+	if !claimed {
+		return nil
+	}
+
+	webhook, err := w.Webhook.Get(ctx, *delivery.WebhookId)
+	if err != nil && !model.IsNotFoundError(err) {
+		logger.WithError(err).Warning("Failed get Webhook")
+		return err
+	}
+	if webhook == nil || webhook.Active == nil || !*webhook.Active {
+		err = w.Webhook.UpdateDelivery(ctx, *delivery.Id, &model.WebhookDelivery{
+			Status:   helper.Pointer(model.WebhookDeliveryFailed),
+			Attempts: delivery.Attempts,
+			Error:    helper.Pointer("webhook is disabled"),
+		})
+		if err != nil {
+			logger.WithError(err).Warning("Failed update WebhookDelivery")
+			return err
+		}
+		return nil
+	}
+
+	if _, err = w.attempt(ctx, webhook, delivery); err != nil {
+		logger.WithError(err).Warning("Failed attempt WebhookDelivery")
+		return err
+	}
+
+	return nil
+}
+
+// Run send the due deliveries until the context is done, when Dispatch record new ones and periodically for the retries
+func (w *Webhook) Run(ctx context.Context) {
+	interval := time.Duration(w.config.Webhook.RetryIntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-w.wake:
+		}
+
+		for ctx.Err() == nil {
+			count, err := w.retryDue(ctx)
+			if err != nil || count < webhookRetryBatchSize {
+				break
+			}
+		}
+	}
+}
+
+func (w *Webhook) deliver(ctx context.Context, webhook *model.Webhook, eventId *string, eventType *model.EventType, payload *string) (*model.WebhookDelivery, error) {
+	// the delivery is created already claimed so the retry worker leave it alone while the first attempt run
+	delivery, err := w.Webhook.AddDelivery(ctx, &model.WebhookDelivery{
+		WebhookId:     webhook.Id,
+		EventId:       eventId,
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        helper.Pointer(model.WebhookDeliveryPending),
+		Attempts:      helper.Pointer(0),
+		NextAttemptAt: helper.Pointer(w.leaseUntil()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return w.attempt(ctx, webhook, delivery)
+}
+
+// attempt send the delivery once and record the outcome, scheduling the next attempt with an exponential backoff on failure
+func (w *Webhook) attempt(ctx context.Context, webhook *model.Webhook, delivery *model.WebhookDelivery) (*model.WebhookDelivery, error) {
+	attempts := 1
+	if delivery.Attempts != nil {
+		attempts = *delivery.Attempts + 1
+	}
+
+	start := time.Now()
+	statusCode, body, sendErr := w.send(ctx, webhook, delivery)
+	update := model.WebhookDelivery{
+		Attempts:     &attempts,
+		StatusCode:   statusCode,
+		ResponseBody: body,
+		DurationMs:   helper.Pointer(time.Since(start).Milliseconds()),
+	}
+
+	if sendErr == nil && *statusCode >= 200 && *statusCode < 300 {
+		update.Status = helper.Pointer(model.WebhookDeliverySucceeded)
+		if err := w.Webhook.RecordSuccess(ctx, *webhook.Id); err != nil {
+			return nil, err
+		}
+	} else {
+		if sendErr != nil {
+			update.Error = helper.Pointer(sendErr.Error())
+		} else {
+			update.Error = helper.Pointer("unexpected status code " + strconv.Itoa(*statusCode))
+		}
+
+		if attempts >= w.config.Webhook.MaxAttempts {
+			update.Status = helper.Pointer(model.WebhookDeliveryFailed)
+		} else {
+			update.Status = helper.Pointer(model.WebhookDeliveryPending)
+			update.NextAttemptAt = helper.Pointer(time.Now().Add(w.backoff(attempts)))
+		}
+
+		if err := w.Webhook.RecordFailure(ctx, *webhook.Id, w.config.Webhook.DisableAfterFailures); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Webhook.UpdateDelivery(ctx, *delivery.Id, &update); err != nil {
+		return nil, err
+	}
+
+	delivery.Status = update.Status
+	delivery.Attempts = update.Attempts
+	delivery.NextAttemptAt = update.NextAttemptAt
+	delivery.StatusCode = update.StatusCode
+	delivery.ResponseBody = update.ResponseBody
+	delivery.Error = update.Error
+	delivery.DurationMs = update.DurationMs
+
+	return delivery, nil
+}
+
+func (w *Webhook) send(ctx context.Context, webhook *model.Webhook, delivery *model.WebhookDelivery) (*int, *string, error) {
+	payload := []byte(*delivery.Payload)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	signature := helper.HmacSha256(*webhook.Secret, append([]byte(timestamp+"."), payload...))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, *webhook.Url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "tempo-webhook")
+	req.Header.Set(WebhookHeaderEvent, string(*delivery.EventType))
+	req.Header.Set(WebhookHeaderDelivery, *delivery.Id)
+	req.Header.Set(WebhookHeaderTimestamp, timestamp)
+	req.Header.Set(WebhookHeaderSignature, "sha256="+signature)
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, webhookResponseBodyLimit))
+	if err != nil {
+		return &resp.StatusCode, nil, err
+	}
+
+	return &resp.StatusCode, helper.Pointer(string(body)), nil
+}
+
+// backoff return the delay before the next attempt, doubling after each failed attempt up to the configured max
+func (w *Webhook) backoff(attempts int) time.Duration {
+	delay := time.Duration(w.config.Webhook.BackoffSeconds) * time.Second
+	maxDelay := time.Duration(w.config.Webhook.MaxBackoffSeconds) * time.Second
+	for i := 1; i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+
+	return delay
+}
+
+// leaseUntil is how long a claimed delivery is reserved, long enough for one attempt to time out
+func (w *Webhook) leaseUntil() time.Time {
+	return time.Now().Add(time.Duration(w.config.Webhook.TimeoutSeconds)*time.Second + time.Minute)
+}
@@ -0,0 +1,428 @@
+package usecase_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"tempo/config"
+	"tempo/container"
+	"tempo/event"
+	"tempo/helper"
+	"tempo/model"
+	"tempo/repository"
+	"tempo/repository/mocks"
+	"tempo/usecase"
+
+	"github.com/icrowley/fake"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func webhookConfig() config.Config {
+	cfg := config.Config{}
+	cfg.Webhook.TimeoutSeconds = 5
+	cfg.Webhook.MaxAttempts = 3
+	cfg.Webhook.BackoffSeconds = 30
+	cfg.Webhook.MaxBackoffSeconds = 3600
+	cfg.Webhook.DisableAfterFailures = 10
+	return cfg
+}
+
+func TestWebhook_Add(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnError_WhenEventTypeIsUnknown", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		appContainer := container.Container{}
+
+		// CODE UNDER TEST
+		uc := usecase.NewWebhook(&appContainer)
+		res, err := uc.Add(context.Background(), &model.Webhook{
+			Url:        helper.Pointer("https://example.com/hook"),
+			EventTypes: []model.EventType{"news.deleted"},
+		})
+		require.Error(t, err)
+		require.True(t, model.IsParameterError(err))
+		require.Nil(t, res)
+	})
+
+	t.Run("ShouldGenerateSecret_WhenSecretIsMissing", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		webhookMock := &mocks.Webhook{}
+		webhookMock.On("Add", mock.Anything, mock.MatchedBy(func(w *model.Webhook) bool {
+			return w.Secret != nil && strings.HasPrefix(*w.Secret, "whsec_") && *w.Active
+		})).Return(func(ctx context.Context, w *model.Webhook) *model.Webhook {
+			w.Id = helper.Pointer(fake.CharactersN(7))
+			return w
+		}, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetWebhookRepo(webhookMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewWebhook(&appContainer)
+		res, err := uc.Add(context.Background(), &model.Webhook{
+			Url:        helper.Pointer("https://example.com/hook"),
+			EventTypes: []model.EventType{model.EventNewsCreated},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, res.Secret)
+
+		webhookMock.AssertExpectations(t)
+	})
+}
+
+func TestWebhook_Dispatch(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldRecordDueDelivery_WithoutSendingIt", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		var hits int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&hits, 1)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		webhook := model.Webhook{
+			Id:         helper.Pointer(fake.CharactersN(7)),
+			Url:        helper.Pointer(server.URL),
+			Secret:     helper.Pointer(fake.CharactersN(20)),
+			EventTypes: []model.EventType{model.EventNewsCreated},
+			Active:     helper.Pointer(true),
+		}
+		e := event.New(model.EventNewsCreated, map[string]string{"id": "news"})
+
+		webhookMock := &mocks.Webhook{}
+		webhookMock.On("List", mock.Anything, repository.WebhookListFilter{
+			ActiveOnly: true,
+			EventType:  helper.Pointer(model.EventNewsCreated),
+		}).Return([]model.Webhook{webhook}, nil).Once()
+		webhookMock.On("AddDelivery", mock.Anything, mock.MatchedBy(func(d *model.WebhookDelivery) bool {
+			return *d.WebhookId == *webhook.Id && *d.EventId == e.Id && strings.Contains(*d.Payload, e.Id) &&
+				*d.Status == model.WebhookDeliveryPending && *d.Attempts == 0 && !d.NextAttemptAt.After(time.Now())
+		})).Return(func(ctx context.Context, d *model.WebhookDelivery) *model.WebhookDelivery {
+			return d
+		}, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetConfig(webhookConfig())
+		appContainer.SetWebhookRepo(webhookMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewWebhook(&appContainer)
+		err := uc.Dispatch(context.Background(), e)
+		require.NoError(t, err)
+
+		// EXPECTATION
+		require.Equal(t, int32(0), atomic.LoadInt32(&hits))
+
+		webhookMock.AssertExpectations(t)
+	})
+}
+
+func TestWebhook_RetryDue(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldSendSignedPayload", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		secret := "whsec_" + fake.CharactersN(20)
+		var received *http.Request
+		var receivedBody []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			receivedBody, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		webhook := model.Webhook{
+			Id:     helper.Pointer(fake.CharactersN(7)),
+			Url:    helper.Pointer(server.URL),
+			Secret: &secret,
+			Active: helper.Pointer(true),
+		}
+		eventId := fake.CharactersN(7)
+		delivery := model.WebhookDelivery{
+			Id:        helper.Pointer(fake.CharactersN(7)),
+			WebhookId: webhook.Id,
+			EventType: helper.Pointer(model.EventNewsCreated),
+			Payload:   helper.Pointer(`{"id":"` + eventId + `"}`),
+			Attempts:  helper.Pointer(0),
+		}
+
+		webhookMock := &mocks.Webhook{}
+		webhookMock.On("ListDueDeliveries", mock.Anything, mock.Anything, 100).Return([]model.WebhookDelivery{delivery}, nil).Once()
+		webhookMock.On("ClaimDelivery", mock.Anything, *delivery.Id, mock.Anything, mock.Anything).Return(true, nil).Once()
+		webhookMock.On("Get", mock.Anything, *webhook.Id).Return(&webhook, nil).Once()
+		webhookMock.On("RecordSuccess", mock.Anything, *webhook.Id).Return(nil).Once()
+		webhookMock.On("UpdateDelivery", mock.Anything, *delivery.Id, mock.MatchedBy(func(d *model.WebhookDelivery) bool {
+			return *d.Status == model.WebhookDeliverySucceeded && *d.Attempts == 1 && *d.StatusCode == http.StatusNoContent
+		})).Return(nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetConfig(webhookConfig())
+		appContainer.SetWebhookRepo(webhookMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewWebhook(&appContainer)
+		err := uc.RetryDue(context.Background())
+		require.NoError(t, err)
+
+		// EXPECTATION
+		require.NotNil(t, received)
+		require.Equal(t, string(model.EventNewsCreated), received.Header.Get(usecase.WebhookHeaderEvent))
+		require.Equal(t, *delivery.Id, received.Header.Get(usecase.WebhookHeaderDelivery))
+		timestamp := received.Header.Get(usecase.WebhookHeaderTimestamp)
+		expected := "sha256=" + helper.HmacSha256(secret, []byte(timestamp+"."+string(receivedBody)))
+		require.Equal(t, expected, received.Header.Get(usecase.WebhookHeaderSignature))
+		require.Contains(t, string(receivedBody), eventId)
+
+		webhookMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldScheduleRetryWithBackoff_WhenEndpointFails", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		webhook := model.Webhook{
+			Id:     helper.Pointer(fake.CharactersN(7)),
+			Url:    helper.Pointer(server.URL),
+			Secret: helper.Pointer(fake.CharactersN(20)),
+			Active: helper.Pointer(true),
+		}
+		delivery := model.WebhookDelivery{
+			Id:        helper.Pointer(fake.CharactersN(7)),
+			WebhookId: webhook.Id,
+			EventType: helper.Pointer(model.EventNewsCreated),
+			Payload:   helper.Pointer("{}"),
+			Attempts:  helper.Pointer(0),
+		}
+
+		webhookMock := &mocks.Webhook{}
+		webhookMock.On("ListDueDeliveries", mock.Anything, mock.Anything, 100).Return([]model.WebhookDelivery{delivery}, nil).Once()
+		webhookMock.On("ClaimDelivery", mock.Anything, *delivery.Id, mock.Anything, mock.Anything).Return(true, nil).Once()
+		webhookMock.On("Get", mock.Anything, *webhook.Id).Return(&webhook, nil).Once()
+		webhookMock.On("RecordFailure", mock.Anything, *webhook.Id, 10).Return(nil).Once()
+		webhookMock.On("UpdateDelivery", mock.Anything, *delivery.Id, mock.MatchedBy(func(d *model.WebhookDelivery) bool {
+			retryIn := time.Until(*d.NextAttemptAt)
+			return *d.Status == model.WebhookDeliveryPending && *d.Attempts == 1 &&
+				retryIn > 25*time.Second && retryIn <= 30*time.Second
+		})).Return(nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetConfig(webhookConfig())
+		appContainer.SetWebhookRepo(webhookMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewWebhook(&appContainer)
+		err := uc.RetryDue(context.Background())
+		require.NoError(t, err)
+
+		webhookMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldMarkFailed_WhenLastAttemptFails", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		webhook := model.Webhook{
+			Id:     helper.Pointer(fake.CharactersN(7)),
+			Url:    helper.Pointer(server.URL),
+			Secret: helper.Pointer(fake.CharactersN(20)),
+			Active: helper.Pointer(true),
+		}
+		delivery := model.WebhookDelivery{
+			Id:        helper.Pointer(fake.CharactersN(7)),
+			WebhookId: webhook.Id,
+			EventType: helper.Pointer(model.EventNewsUpdated),
+			Payload:   helper.Pointer("{}"),
+			Attempts:  helper.Pointer(2),
+		}
+
+		webhookMock := &mocks.Webhook{}
+		webhookMock.On("ListDueDeliveries", mock.Anything, mock.Anything, 100).Return([]model.WebhookDelivery{delivery}, nil).Once()
+		webhookMock.On("ClaimDelivery", mock.Anything, *delivery.Id, mock.Anything, mock.Anything).Return(true, nil).Once()
+		webhookMock.On("Get", mock.Anything, *webhook.Id).Return(&webhook, nil).Once()
+		webhookMock.On("RecordFailure", mock.Anything, *webhook.Id, 10).Return(nil).Once()
+		webhookMock.On("UpdateDelivery", mock.Anything, *delivery.Id, mock.MatchedBy(func(d *model.WebhookDelivery) bool {
+			return *d.Status == model.WebhookDeliveryFailed && *d.Attempts == 3 && d.NextAttemptAt == nil
+		})).Return(nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetConfig(webhookConfig())
+		appContainer.SetWebhookRepo(webhookMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewWebhook(&appContainer)
+		err := uc.RetryDue(context.Background())
+		require.NoError(t, err)
+
+		webhookMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldSkipDelivery_WhenClaimedByAnotherWorker", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		delivery := model.WebhookDelivery{
+			Id:        helper.Pointer(fake.CharactersN(7)),
+			WebhookId: helper.Pointer(fake.CharactersN(7)),
+		}
+
+		webhookMock := &mocks.Webhook{}
+		webhookMock.On("ListDueDeliveries", mock.Anything, mock.Anything, 100).Return([]model.WebhookDelivery{delivery}, nil).Once()
+		webhookMock.On("ClaimDelivery", mock.Anything, *delivery.Id, mock.Anything, mock.Anything).Return(false, nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetConfig(webhookConfig())
+		appContainer.SetWebhookRepo(webhookMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewWebhook(&appContainer)
+		err := uc.RetryDue(context.Background())
+		require.NoError(t, err)
+
+		webhookMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldMarkFailed_WhenWebhookIsDisabled", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		webhook := model.Webhook{
+			Id:     helper.Pointer(fake.CharactersN(7)),
+			Active: helper.Pointer(false),
+		}
+		delivery := model.WebhookDelivery{
+			Id:        helper.Pointer(fake.CharactersN(7)),
+			WebhookId: webhook.Id,
+			Attempts:  helper.Pointer(1),
+		}
+
+		webhookMock := &mocks.Webhook{}
+		webhookMock.On("ListDueDeliveries", mock.Anything, mock.Anything, 100).Return([]model.WebhookDelivery{delivery}, nil).Once()
+		webhookMock.On("ClaimDelivery", mock.Anything, *delivery.Id, mock.Anything, mock.Anything).Return(true, nil).Once()
+		webhookMock.On("Get", mock.Anything, *webhook.Id).Return(&webhook, nil).Once()
+		webhookMock.On("UpdateDelivery", mock.Anything, *delivery.Id, mock.MatchedBy(func(d *model.WebhookDelivery) bool {
+			return *d.Status == model.WebhookDeliveryFailed
+		})).Return(nil).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetConfig(webhookConfig())
+		appContainer.SetWebhookRepo(webhookMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewWebhook(&appContainer)
+		err := uc.RetryDue(context.Background())
+		require.NoError(t, err)
+
+		webhookMock.AssertExpectations(t)
+	})
+}
+
+func TestWebhook_Run(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldSendDispatchedDelivery_WithoutWaitingForTheInterval", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		webhook := model.Webhook{
+			Id:     helper.Pointer(fake.CharactersN(7)),
+			Url:    helper.Pointer(server.URL),
+			Secret: helper.Pointer(fake.CharactersN(20)),
+			Active: helper.Pointer(true),
+		}
+		var added *model.WebhookDelivery
+
+		webhookMock := &mocks.Webhook{}
+		webhookMock.On("List", mock.Anything, mock.Anything).Return([]model.Webhook{webhook}, nil).Once()
+		webhookMock.On("AddDelivery", mock.Anything, mock.Anything).Return(func(ctx context.Context, d *model.WebhookDelivery) *model.WebhookDelivery {
+			d.Id = helper.Pointer(fake.CharactersN(7))
+			added = d
+			return d
+		}, nil).Once()
+		webhookMock.On("ListDueDeliveries", mock.Anything, mock.Anything, 100).Return(func(ctx context.Context, now time.Time, limit int) []model.WebhookDelivery {
+			return []model.WebhookDelivery{*added}
+		}, nil).Once()
+		webhookMock.On("ClaimDelivery", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Once()
+		webhookMock.On("Get", mock.Anything, *webhook.Id).Return(&webhook, nil).Once()
+		webhookMock.On("RecordSuccess", mock.Anything, *webhook.Id).Return(nil).Once()
+		updated := make(chan struct{})
+		webhookMock.On("UpdateDelivery", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			close(updated)
+		}).Once()
+
+		cfg := webhookConfig()
+		cfg.Webhook.RetryIntervalSeconds = 3600
+		appContainer := container.Container{}
+		appContainer.SetConfig(cfg)
+		appContainer.SetWebhookRepo(webhookMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewWebhook(&appContainer)
+		err := uc.Dispatch(context.Background(), event.New(model.EventNewsCreated, nil))
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			uc.Run(ctx)
+		}()
+
+		// EXPECTATION
+		select {
+		case <-updated:
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "the delivery was not sent")
+		}
+		cancel()
+		<-done
+
+		webhookMock.AssertExpectations(t)
+	})
+}
+
+func TestWebhook_Redeliver(t *testing.T) {
+	t.Parallel()
+	t.Run("ShouldReturnNotFound_WhenDeliveryIsNotExist", func(t *testing.T) {
+		t.Parallel()
+		// INIT
+		webhookId := fake.CharactersN(7)
+		deliveryId := fake.CharactersN(7)
+
+		webhookMock := &mocks.Webhook{}
+		webhookMock.On("Get", mock.Anything, webhookId).Return(&model.Webhook{Id: &webhookId}, nil).Once()
+		webhookMock.On("GetDelivery", mock.Anything, webhookId, deliveryId).Return(nil, model.NewNotFoundError()).Once()
+
+		appContainer := container.Container{}
+		appContainer.SetWebhookRepo(webhookMock)
+
+		// CODE UNDER TEST
+		uc := usecase.NewWebhook(&appContainer)
+		res, err := uc.Redeliver(context.Background(), &webhookId, &deliveryId)
+		require.Error(t, err)
+		require.True(t, model.IsNotFoundError(err))
+		require.Nil(t, res)
+
+		webhookMock.AssertExpectations(t)
+	})
+}